@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"pinstack-relation-service/internal/application/service"
+	"pinstack-relation-service/internal/infrastructure/certs"
+	"pinstack-relation-service/internal/infrastructure/config"
+	"pinstack-relation-service/internal/infrastructure/inbound/middleware"
+	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	user_adapter "pinstack-relation-service/internal/infrastructure/outbound/client/user"
+	prometheus_metrics "pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Stores the profiles of follow graph users that follower search can't find yet or
+// that were last synced long ago. Meant to be run once after deploying the search and
+// then periodically, e.g. from a nightly cron job next to the server.
+func main() {
+	cfg := config.MustLoad()
+
+	log := infra_logger.New(cfg.Env)
+
+	refreshDays := flag.Int("refresh-days", cfg.Profiles.RefreshAfterDays, "Fetch again profiles synced more than this many days ago")
+	batchSize := flag.Int("batch-size", cfg.Profiles.BackfillBatchSize, "Number of users listed per page")
+	flag.Parse()
+
+	dsn := fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=disable",
+		cfg.Database.Username,
+		cfg.Database.Password,
+		cfg.Database.Host,
+		cfg.Database.Port,
+		cfg.Database.DbName)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		log.Error("Failed to create postgres pool", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer pool.Close()
+
+	userServiceCreds := insecure.NewCredentials()
+	if cfg.UserService.TLS.Enabled {
+		userServiceCerts, err := certs.NewReloader(cfg.UserService.TLS.CertFile, cfg.UserService.TLS.KeyFile, cfg.UserService.TLS.CAFile, log)
+		if err != nil {
+			log.Error("Failed to load user service TLS certificates", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		defer userServiceCerts.Close()
+		userServiceCreds = userServiceCerts.ClientCredentials(cfg.UserService.TLS.ServerName)
+	}
+
+	userServiceConn, err := grpc.NewClient(
+		fmt.Sprintf("%s:%d", cfg.UserService.Address, cfg.UserService.Port),
+		grpc.WithTransportCredentials(userServiceCreds),
+		grpc.WithUnaryInterceptor(middleware.UnaryClientRequestIDInterceptor()),
+	)
+	if err != nil {
+		log.Error("Failed to connect to user service", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer func() {
+		if err := userServiceConn.Close(); err != nil {
+			log.Error("Failed to close user service connection", slog.String("error", err.Error()))
+		}
+	}()
+
+	metricsProvider := prometheus_metrics.NewPrometheusMetricsProvider()
+	profileRepo := repository_postgres.NewProfileRepository(pool, log, metricsProvider)
+	userClient := user_adapter.NewUserClient(userServiceConn, log)
+	backfiller := service.NewProfileBackfiller(log, profileRepo, userClient, int32(*batchSize))
+
+	staleBefore := time.Now().Add(-time.Duration(*refreshDays) * 24 * time.Hour)
+	synced, failed, err := backfiller.Run(ctx, staleBefore)
+	if err != nil {
+		log.Error("Profile backfill aborted", slog.Int("synced", synced), slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if failed > 0 {
+		log.Warn("Profile backfill finished with failures", slog.Int("synced", synced), slog.Int("failed", failed))
+	}
+}
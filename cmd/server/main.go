@@ -2,28 +2,46 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
 	"pinstack-relation-service/internal/application/service"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/domain/ports/output/post_client"
+	ratelimit_port "pinstack-relation-service/internal/domain/ports/output/ratelimit"
+	"pinstack-relation-service/internal/infrastructure/auth"
+	"pinstack-relation-service/internal/infrastructure/certs"
 	"pinstack-relation-service/internal/infrastructure/config"
 	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
 	metrics_server "pinstack-relation-service/internal/infrastructure/inbound/metrics"
+	"pinstack-relation-service/internal/infrastructure/inbound/middleware"
+	"pinstack-relation-service/internal/infrastructure/inbound/rest"
 	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/aggregation"
+	memory_cache "pinstack-relation-service/internal/infrastructure/outbound/cache/memory"
+	"pinstack-relation-service/internal/infrastructure/outbound/cleanup"
+	post_adapter "pinstack-relation-service/internal/infrastructure/outbound/client/post"
 	user_adapter "pinstack-relation-service/internal/infrastructure/outbound/client/user"
 	kafka_adapter "pinstack-relation-service/internal/infrastructure/outbound/events/kafka"
 	prometheus_metrics "pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
 	outbox_adapter "pinstack-relation-service/internal/infrastructure/outbound/outbox"
+	memory_ratelimit "pinstack-relation-service/internal/infrastructure/outbound/ratelimit/memory"
+	redis_ratelimit "pinstack-relation-service/internal/infrastructure/outbound/ratelimit/redis"
 	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
 	uow_adapter "pinstack-relation-service/internal/infrastructure/outbound/uow"
+	"pinstack-relation-service/internal/infrastructure/tracing"
 	"syscall"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -38,11 +56,25 @@ func main() {
 	ctx := context.Background()
 	log := infra_logger.New(cfg.Env)
 
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing, log)
+	if err != nil {
+		log.Error("Failed to initialize tracing", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer func() {
+		tracingCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(tracingCtx); err != nil {
+			log.Error("Failed to shutdown tracing", slog.String("error", err.Error()))
+		}
+	}()
+
 	poolConfig, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		log.Error("Failed to parse postgres poolConfig", slog.String("error", err.Error()))
 		os.Exit(1)
 	}
+	poolConfig.ConnConfig.Tracer = tracing.NewPgxTracer()
 
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
@@ -76,10 +108,69 @@ func main() {
 
 	unitOfWork := uow_adapter.NewPostgresUOW(pool, log, metricsProvider)
 	followRepo := repository_postgres.NewFollowRepository(pool, log, metricsProvider)
+	followActionRepo := repository_postgres.NewFollowActionRepository(pool, log, metricsProvider)
+	suggestionRepo := repository_postgres.NewSuggestionRepository(pool, log, metricsProvider)
+	profileRepo := repository_postgres.NewProfileRepository(pool, log, metricsProvider)
+	historyRepo := repository_postgres.NewRelationHistoryRepository(pool, log, metricsProvider)
+	idempotencyRepo := repository_postgres.NewIdempotencyRepository(pool, log, metricsProvider)
+	muteRepo := repository_postgres.NewMuteRepository(pool, log, metricsProvider)
+	audienceRepo := repository_postgres.NewAudienceListRepository(pool, log, metricsProvider)
+	followStatsRepo := repository_postgres.NewFollowStatsRepository(pool, log, metricsProvider)
+	followerGrowthRepo := repository_postgres.NewFollowerGrowthRepository(pool, log, metricsProvider)
+
+	suggestionCache := memory_cache.NewSuggestionCache(cfg.Suggestions.CacheTTL(), cfg.Suggestions.CacheCleanupInterval())
+	defer suggestionCache.Close()
+
+	followLimits := model.FollowLimits{
+		MaxFollowsPerHour: cfg.FollowLimits.MaxFollowsPerHour,
+		MaxFollowsPerDay:  cfg.FollowLimits.MaxFollowsPerDay,
+		MaxFollowees:      cfg.FollowLimits.MaxFollowees,
+		RefollowCooldown:  cfg.FollowLimits.RefollowCooldown(),
+	}
+	audiencePolicy := model.AudienceListPolicy{
+		RequireFollowers: cfg.Audience.RequireFollowers,
+		MaxLists:         cfg.Audience.MaxLists,
+		MaxMembers:       cfg.Audience.MaxMembers,
+	}
+
+	if followLimits.Enabled() {
+		followActionsWorker := cleanup.NewFollowActionsWorker(followActionRepo, followLimits.Retention(), cfg.FollowLimits.CleanupInterval(), log)
+		followActionsWorker.Start(ctx)
+		defer followActionsWorker.Stop()
+	}
+
+	idempotencyKeysWorker := cleanup.NewIdempotencyKeysWorker(idempotencyRepo, cfg.Idempotency.CleanupInterval(), log)
+	idempotencyKeysWorker.Start(ctx)
+	defer idempotencyKeysWorker.Stop()
+
+	mutesWorker := cleanup.NewMutesWorker(muteRepo, cfg.Mutes.CleanupInterval(), log)
+	mutesWorker.Start(ctx)
+	defer mutesWorker.Stop()
+
+	followSourcesWorker := aggregation.NewFollowSourcesWorker(followStatsRepo, cfg.FollowStats.AggregateInterval(), log)
+	followSourcesWorker.Start(ctx)
+	defer followSourcesWorker.Stop()
+
+	followerGrowthWorker := aggregation.NewFollowerGrowthWorker(followerGrowthRepo, cfg.Growth.AggregateInterval(), log)
+	followerGrowthWorker.Start(ctx)
+	defer followerGrowthWorker.Stop()
+
+	userServiceCreds := insecure.NewCredentials()
+	if cfg.UserService.TLS.Enabled {
+		userServiceCerts, err := certs.NewReloader(cfg.UserService.TLS.CertFile, cfg.UserService.TLS.KeyFile, cfg.UserService.TLS.CAFile, log)
+		if err != nil {
+			log.Error("Failed to load user service TLS certificates", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		defer userServiceCerts.Close()
+		userServiceCreds = userServiceCerts.ClientCredentials(cfg.UserService.TLS.ServerName)
+	}
 
 	userServiceConn, err := grpc.NewClient(
 		fmt.Sprintf("%s:%d", cfg.UserService.Address, cfg.UserService.Port),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(userServiceCreds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithUnaryInterceptor(middleware.UnaryClientRequestIDInterceptor()),
 	)
 	if err != nil {
 		log.Error("Failed to connect to user service", slog.String("error", err.Error()))
@@ -92,16 +183,96 @@ func main() {
 		}
 	}(userServiceConn)
 
-	userClient := user_adapter.NewUserClient(userServiceConn, log)
+	// Every profile fetched from the user service is also stored for follower search
+	userClient := user_adapter.NewProfileSyncingClient(user_adapter.NewUserClient(userServiceConn, log), profileRepo, log, cfg.Profiles.SyncBatchSize, cfg.Profiles.SyncFlushInterval())
+	defer userClient.Close()
+
+	// The post service has no board or tag lookup RPCs yet, so without the dev fake the
+	// service refuses board and tag follows instead of following ids it can not check
+	var postClient post_client.Client
+	if cfg.PostService.FakeClient {
+		log.Warn("Using the fake post service client, board and tag follow targets are not verified")
+		postClient = post_adapter.NewFakeClient(log, true)
+	} else {
+		log.Warn("No post service client configured, board and tag follows are disabled")
+	}
 
-	followService := service.NewFollowService(log, followRepo, unitOfWork, userClient)
+	followService := service.NewFollowService(log, followRepo, suggestionRepo, profileRepo, historyRepo, idempotencyRepo, muteRepo, audienceRepo, followStatsRepo, followerGrowthRepo, unitOfWork, userClient, postClient, suggestionCache, followLimits, audiencePolicy, cfg.Idempotency.TTL())
 	followGRPCApi := follow_grpc.NewFollowGRPCService(followService, log)
-	grpcServer := follow_grpc.NewServer(followGRPCApi, cfg.GRPCServer.Address, cfg.GRPCServer.Port, log, metricsProvider)
+	relationExtGRPCApi := follow_grpc.NewRelationExtGRPCService(followService, log)
+
+	var interceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if cfg.Auth.Enabled {
+		authenticator, err := auth.NewAuthenticator(cfg.Auth, log)
+		if err != nil {
+			log.Error("Failed to initialize authenticator", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		interceptors = append(interceptors, middleware.UnaryAuthInterceptor(authenticator, log))
+		streamInterceptors = append(streamInterceptors, middleware.StreamAuthInterceptor(authenticator, log))
+	} else {
+		log.Warn("Authentication is disabled, caller identity will not be verified")
+	}
+
+	if cfg.RateLimit.Enabled {
+		var limiter ratelimit_port.Limiter
+		switch cfg.RateLimit.Backend {
+		case config.RateLimitBackendRedis:
+			redisClient := redis.NewClient(&redis.Options{
+				Addr:     fmt.Sprintf("%s:%d", cfg.Redis.Address, cfg.Redis.Port),
+				Password: cfg.Redis.Password,
+				DB:       cfg.Redis.DB,
+			})
+			defer func() {
+				if err := redisClient.Close(); err != nil {
+					log.Error("Failed to close redis client", slog.String("error", err.Error()))
+				}
+			}()
+			limiter = redis_ratelimit.NewLimiter(redisClient, cfg.RateLimit.KeyPrefix, log)
+		default:
+			memoryLimiter := memory_ratelimit.NewLimiter(cfg.RateLimit.CleanupInterval())
+			defer memoryLimiter.Close()
+			limiter = memoryLimiter
+		}
+		// Streams are not rate limited, the only one is restricted to service accounts
+		interceptors = append(interceptors, middleware.UnaryRateLimitInterceptor(limiter, cfg.RateLimit, log, metricsProvider))
+	}
+
+	var serverCreds credentials.TransportCredentials
+	if cfg.GRPCServer.TLS.Enabled {
+		serverCerts, err := certs.NewReloader(cfg.GRPCServer.TLS.CertFile, cfg.GRPCServer.TLS.KeyFile, cfg.GRPCServer.TLS.CAFile, log)
+		if err != nil {
+			log.Error("Failed to load gRPC server TLS certificates", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		defer serverCerts.Close()
+		serverCreds = serverCerts.ServerCredentials(cfg.GRPCServer.TLS.RequireClientCert)
+	}
+
+	grpcServer := follow_grpc.NewServer(followGRPCApi, relationExtGRPCApi, cfg.GRPCServer.Address, cfg.GRPCServer.Port, log, metricsProvider, serverCreds, streamInterceptors, interceptors...)
+
+	var restServer *rest.Server
+	if cfg.RESTServer.Enabled {
+		var restTLS *tls.Config
+		if cfg.RESTServer.TLS.Enabled {
+			restCerts, err := certs.NewReloader(cfg.RESTServer.TLS.CertFile, cfg.RESTServer.TLS.KeyFile, cfg.RESTServer.TLS.CAFile, log)
+			if err != nil {
+				log.Error("Failed to load REST gateway TLS certificates", slog.String("error", err.Error()))
+				os.Exit(1)
+			}
+			defer restCerts.Close()
+			restTLS = restCerts.ServerConfig(cfg.RESTServer.TLS.RequireClientCert)
+		}
+		restInterceptor := follow_grpc.UnaryInterceptorChain(log, metricsProvider, interceptors...)
+		restServer = rest.NewServer(followGRPCApi, restInterceptor, restTLS, cfg.RESTServer.Address, cfg.RESTServer.Port, log)
+	}
 
 	metricsServer := metrics_server.NewMetricsServer(cfg.Prometheus.Address, cfg.Prometheus.Port, log)
 
 	done := make(chan bool, 1)
 	metricsDone := make(chan bool, 1)
+	restDone := make(chan bool, 1)
 
 	go func() {
 		if err := grpcServer.Run(); err != nil {
@@ -110,6 +281,17 @@ func main() {
 		done <- true
 	}()
 
+	if restServer != nil {
+		go func() {
+			if err := restServer.Run(); err != nil {
+				log.Error("REST gateway error", slog.String("error", err.Error()))
+			}
+			restDone <- true
+		}()
+	} else {
+		restDone <- true
+	}
+
 	http.Handle("/metrics", promhttp.Handler())
 	go func() {
 		if err := metricsServer.Run(); err != nil {
@@ -131,12 +313,19 @@ func main() {
 		log.Error("gRPC server shutdown error", slog.String("error", err.Error()))
 	}
 
+	if restServer != nil {
+		if err := restServer.Shutdown(shutdownCtx); err != nil {
+			log.Error("REST gateway shutdown error", slog.String("error", err.Error()))
+		}
+	}
+
 	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
 		log.Error("Metrics server shutdown error", slog.String("error", err.Error()))
 	}
 
 	<-done
 	<-metricsDone
+	<-restDone
 
 	log.Info("Server exited")
 }
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"pinstack-relation-service/internal/application/service"
+	"pinstack-relation-service/internal/infrastructure/config"
+	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	prometheus_metrics "pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Precomputes follow suggestions for recently active users. Meant to be run
+// periodically, e.g. from a nightly cron job next to the server.
+func main() {
+	cfg := config.MustLoad()
+
+	log := infra_logger.New(cfg.Env)
+
+	activeDays := flag.Int("active-days", cfg.Suggestions.ActiveWindowDays, "Precompute for users that followed someone in this many days")
+	batchSize := flag.Int("batch-size", cfg.Suggestions.BatchSize, "Number of users listed per page")
+	flag.Parse()
+
+	dsn := fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=disable",
+		cfg.Database.Username,
+		cfg.Database.Password,
+		cfg.Database.Host,
+		cfg.Database.Port,
+		cfg.Database.DbName)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		log.Error("Failed to create postgres pool", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer pool.Close()
+
+	metricsProvider := prometheus_metrics.NewPrometheusMetricsProvider()
+	suggestionRepo := repository_postgres.NewSuggestionRepository(pool, log, metricsProvider)
+	precomputer := service.NewSuggestionPrecomputer(log, suggestionRepo, int32(*batchSize))
+
+	activeSince := time.Now().Add(-time.Duration(*activeDays) * 24 * time.Hour)
+	processed, failed, err := precomputer.Run(ctx, activeSince)
+	if err != nil {
+		log.Error("Suggestions precompute aborted", slog.Int("processed", processed), slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	if failed > 0 {
+		log.Warn("Suggestions precompute finished with failures", slog.Int("processed", processed), slog.Int("failed", failed))
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,4575 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: relation_ext/v1/relation_ext.proto
+
+package relationextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TargetType int32
+
+const (
+	TargetType_TARGET_TYPE_UNSPECIFIED TargetType = 0
+	TargetType_TARGET_TYPE_USER        TargetType = 1
+	TargetType_TARGET_TYPE_BOARD       TargetType = 2
+	TargetType_TARGET_TYPE_TAG         TargetType = 3
+)
+
+// Enum value maps for TargetType.
+var (
+	TargetType_name = map[int32]string{
+		0: "TARGET_TYPE_UNSPECIFIED",
+		1: "TARGET_TYPE_USER",
+		2: "TARGET_TYPE_BOARD",
+		3: "TARGET_TYPE_TAG",
+	}
+	TargetType_value = map[string]int32{
+		"TARGET_TYPE_UNSPECIFIED": 0,
+		"TARGET_TYPE_USER":        1,
+		"TARGET_TYPE_BOARD":       2,
+		"TARGET_TYPE_TAG":         3,
+	}
+)
+
+func (x TargetType) Enum() *TargetType {
+	p := new(TargetType)
+	*p = x
+	return p
+}
+
+func (x TargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_relation_ext_v1_relation_ext_proto_enumTypes[0].Descriptor()
+}
+
+func (TargetType) Type() protoreflect.EnumType {
+	return &file_relation_ext_v1_relation_ext_proto_enumTypes[0]
+}
+
+func (x TargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TargetType.Descriptor instead.
+func (TargetType) EnumDescriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{0}
+}
+
+type FollowListSort int32
+
+const (
+	FollowListSort_FOLLOW_LIST_SORT_UNSPECIFIED FollowListSort = 0
+	FollowListSort_FOLLOW_LIST_SORT_NEWEST      FollowListSort = 1
+	FollowListSort_FOLLOW_LIST_SORT_OLDEST      FollowListSort = 2
+)
+
+// Enum value maps for FollowListSort.
+var (
+	FollowListSort_name = map[int32]string{
+		0: "FOLLOW_LIST_SORT_UNSPECIFIED",
+		1: "FOLLOW_LIST_SORT_NEWEST",
+		2: "FOLLOW_LIST_SORT_OLDEST",
+	}
+	FollowListSort_value = map[string]int32{
+		"FOLLOW_LIST_SORT_UNSPECIFIED": 0,
+		"FOLLOW_LIST_SORT_NEWEST":      1,
+		"FOLLOW_LIST_SORT_OLDEST":      2,
+	}
+)
+
+func (x FollowListSort) Enum() *FollowListSort {
+	p := new(FollowListSort)
+	*p = x
+	return p
+}
+
+func (x FollowListSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FollowListSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_relation_ext_v1_relation_ext_proto_enumTypes[1].Descriptor()
+}
+
+func (FollowListSort) Type() protoreflect.EnumType {
+	return &file_relation_ext_v1_relation_ext_proto_enumTypes[1]
+}
+
+func (x FollowListSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FollowListSort.Descriptor instead.
+func (FollowListSort) EnumDescriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{1}
+}
+
+// FollowerGrowthGranularity is the bucket size of a follower growth series, weeks start on Monday
+type FollowerGrowthGranularity int32
+
+const (
+	FollowerGrowthGranularity_FOLLOWER_GROWTH_GRANULARITY_UNSPECIFIED FollowerGrowthGranularity = 0
+	FollowerGrowthGranularity_FOLLOWER_GROWTH_GRANULARITY_DAY         FollowerGrowthGranularity = 1
+	FollowerGrowthGranularity_FOLLOWER_GROWTH_GRANULARITY_WEEK        FollowerGrowthGranularity = 2
+	FollowerGrowthGranularity_FOLLOWER_GROWTH_GRANULARITY_MONTH       FollowerGrowthGranularity = 3
+)
+
+// Enum value maps for FollowerGrowthGranularity.
+var (
+	FollowerGrowthGranularity_name = map[int32]string{
+		0: "FOLLOWER_GROWTH_GRANULARITY_UNSPECIFIED",
+		1: "FOLLOWER_GROWTH_GRANULARITY_DAY",
+		2: "FOLLOWER_GROWTH_GRANULARITY_WEEK",
+		3: "FOLLOWER_GROWTH_GRANULARITY_MONTH",
+	}
+	FollowerGrowthGranularity_value = map[string]int32{
+		"FOLLOWER_GROWTH_GRANULARITY_UNSPECIFIED": 0,
+		"FOLLOWER_GROWTH_GRANULARITY_DAY":         1,
+		"FOLLOWER_GROWTH_GRANULARITY_WEEK":        2,
+		"FOLLOWER_GROWTH_GRANULARITY_MONTH":       3,
+	}
+)
+
+func (x FollowerGrowthGranularity) Enum() *FollowerGrowthGranularity {
+	p := new(FollowerGrowthGranularity)
+	*p = x
+	return p
+}
+
+func (x FollowerGrowthGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FollowerGrowthGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_relation_ext_v1_relation_ext_proto_enumTypes[2].Descriptor()
+}
+
+func (FollowerGrowthGranularity) Type() protoreflect.EnumType {
+	return &file_relation_ext_v1_relation_ext_proto_enumTypes[2]
+}
+
+func (x FollowerGrowthGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FollowerGrowthGranularity.Descriptor instead.
+func (FollowerGrowthGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{2}
+}
+
+type RelationAction int32
+
+const (
+	RelationAction_RELATION_ACTION_UNSPECIFIED RelationAction = 0
+	RelationAction_RELATION_ACTION_FOLLOW      RelationAction = 1
+	RelationAction_RELATION_ACTION_UNFOLLOW    RelationAction = 2
+	RelationAction_RELATION_ACTION_BLOCK       RelationAction = 3
+	RelationAction_RELATION_ACTION_REMOVE      RelationAction = 4
+)
+
+// Enum value maps for RelationAction.
+var (
+	RelationAction_name = map[int32]string{
+		0: "RELATION_ACTION_UNSPECIFIED",
+		1: "RELATION_ACTION_FOLLOW",
+		2: "RELATION_ACTION_UNFOLLOW",
+		3: "RELATION_ACTION_BLOCK",
+		4: "RELATION_ACTION_REMOVE",
+	}
+	RelationAction_value = map[string]int32{
+		"RELATION_ACTION_UNSPECIFIED": 0,
+		"RELATION_ACTION_FOLLOW":      1,
+		"RELATION_ACTION_UNFOLLOW":    2,
+		"RELATION_ACTION_BLOCK":       3,
+		"RELATION_ACTION_REMOVE":      4,
+	}
+)
+
+func (x RelationAction) Enum() *RelationAction {
+	p := new(RelationAction)
+	*p = x
+	return p
+}
+
+func (x RelationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_relation_ext_v1_relation_ext_proto_enumTypes[3].Descriptor()
+}
+
+func (RelationAction) Type() protoreflect.EnumType {
+	return &file_relation_ext_v1_relation_ext_proto_enumTypes[3]
+}
+
+func (x RelationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationAction.Descriptor instead.
+func (RelationAction) EnumDescriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{3}
+}
+
+type RelationReason int32
+
+const (
+	RelationReason_RELATION_REASON_UNSPECIFIED RelationReason = 0
+	RelationReason_RELATION_REASON_USER        RelationReason = 1
+	RelationReason_RELATION_REASON_ADMIN       RelationReason = 2
+	RelationReason_RELATION_REASON_CASCADE     RelationReason = 3
+	RelationReason_RELATION_REASON_ANTI_SPAM   RelationReason = 4
+)
+
+// Enum value maps for RelationReason.
+var (
+	RelationReason_name = map[int32]string{
+		0: "RELATION_REASON_UNSPECIFIED",
+		1: "RELATION_REASON_USER",
+		2: "RELATION_REASON_ADMIN",
+		3: "RELATION_REASON_CASCADE",
+		4: "RELATION_REASON_ANTI_SPAM",
+	}
+	RelationReason_value = map[string]int32{
+		"RELATION_REASON_UNSPECIFIED": 0,
+		"RELATION_REASON_USER":        1,
+		"RELATION_REASON_ADMIN":       2,
+		"RELATION_REASON_CASCADE":     3,
+		"RELATION_REASON_ANTI_SPAM":   4,
+	}
+)
+
+func (x RelationReason) Enum() *RelationReason {
+	p := new(RelationReason)
+	*p = x
+	return p
+}
+
+func (x RelationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_relation_ext_v1_relation_ext_proto_enumTypes[4].Descriptor()
+}
+
+func (RelationReason) Type() protoreflect.EnumType {
+	return &file_relation_ext_v1_relation_ext_proto_enumTypes[4]
+}
+
+func (x RelationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationReason.Descriptor instead.
+func (RelationReason) EnumDescriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{4}
+}
+
+type BulkOutcome int32
+
+const (
+	BulkOutcome_BULK_OUTCOME_UNSPECIFIED       BulkOutcome = 0
+	BulkOutcome_BULK_OUTCOME_CREATED           BulkOutcome = 1
+	BulkOutcome_BULK_OUTCOME_ALREADY_FOLLOWING BulkOutcome = 2
+	BulkOutcome_BULK_OUTCOME_NOT_FOUND         BulkOutcome = 3
+	// refused by the anti-spam rules
+	BulkOutcome_BULK_OUTCOME_BLOCKED       BulkOutcome = 4
+	BulkOutcome_BULK_OUTCOME_REMOVED       BulkOutcome = 5
+	BulkOutcome_BULK_OUTCOME_NOT_FOLLOWING BulkOutcome = 6
+)
+
+// Enum value maps for BulkOutcome.
+var (
+	BulkOutcome_name = map[int32]string{
+		0: "BULK_OUTCOME_UNSPECIFIED",
+		1: "BULK_OUTCOME_CREATED",
+		2: "BULK_OUTCOME_ALREADY_FOLLOWING",
+		3: "BULK_OUTCOME_NOT_FOUND",
+		4: "BULK_OUTCOME_BLOCKED",
+		5: "BULK_OUTCOME_REMOVED",
+		6: "BULK_OUTCOME_NOT_FOLLOWING",
+	}
+	BulkOutcome_value = map[string]int32{
+		"BULK_OUTCOME_UNSPECIFIED":       0,
+		"BULK_OUTCOME_CREATED":           1,
+		"BULK_OUTCOME_ALREADY_FOLLOWING": 2,
+		"BULK_OUTCOME_NOT_FOUND":         3,
+		"BULK_OUTCOME_BLOCKED":           4,
+		"BULK_OUTCOME_REMOVED":           5,
+		"BULK_OUTCOME_NOT_FOLLOWING":     6,
+	}
+)
+
+func (x BulkOutcome) Enum() *BulkOutcome {
+	p := new(BulkOutcome)
+	*p = x
+	return p
+}
+
+func (x BulkOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_relation_ext_v1_relation_ext_proto_enumTypes[5].Descriptor()
+}
+
+func (BulkOutcome) Type() protoreflect.EnumType {
+	return &file_relation_ext_v1_relation_ext_proto_enumTypes[5]
+}
+
+func (x BulkOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkOutcome.Descriptor instead.
+func (BulkOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{5}
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	AvatarUrl     *string                `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
+	}
+	return ""
+}
+
+type GetRelationshipsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId int64                  `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	// Up to 1000 distinct user ids, duplicates are collapsed
+	TargetIds     []int64 `protobuf:"varint,2,rep,packed,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationshipsRequest) Reset() {
+	*x = GetRelationshipsRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsRequest) ProtoMessage() {}
+
+func (x *GetRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetRelationshipsRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetRelationshipsRequest) GetTargetIds() []int64 {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+type Relationship struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TargetId int64                  `protobuf:"varint,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// viewer follows target
+	Following bool `protobuf:"varint,2,opt,name=following,proto3" json:"following,omitempty"`
+	// target follows viewer
+	FollowedBy    bool `protobuf:"varint,3,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Relationship) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *Relationship) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *Relationship) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
+
+type GetRelationshipsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One entry per distinct target, in request order
+	Relationships []*Relationship `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationshipsResponse) Reset() {
+	*x = GetRelationshipsResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsResponse) ProtoMessage() {}
+
+func (x *GetRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRelationshipsResponse) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type GetMutualFollowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutualFollowsRequest) Reset() {
+	*x = GetMutualFollowsRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutualFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutualFollowsRequest) ProtoMessage() {}
+
+func (x *GetMutualFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutualFollowsRequest.ProtoReflect.Descriptor instead.
+func (*GetMutualFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMutualFollowsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetMutualFollowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetMutualFollowsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type GetMutualFollowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutualFollowsResponse) Reset() {
+	*x = GetMutualFollowsResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutualFollowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutualFollowsResponse) ProtoMessage() {}
+
+func (x *GetMutualFollowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutualFollowsResponse.ProtoReflect.Descriptor instead.
+func (*GetMutualFollowsResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMutualFollowsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetMutualFollowsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type IsMutualRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtherUserId   int64                  `protobuf:"varint,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsMutualRequest) Reset() {
+	*x = IsMutualRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsMutualRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMutualRequest) ProtoMessage() {}
+
+func (x *IsMutualRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMutualRequest.ProtoReflect.Descriptor instead.
+func (*IsMutualRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *IsMutualRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *IsMutualRequest) GetOtherUserId() int64 {
+	if x != nil {
+		return x.OtherUserId
+	}
+	return 0
+}
+
+type IsMutualResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mutual        bool                   `protobuf:"varint,1,opt,name=mutual,proto3" json:"mutual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsMutualResponse) Reset() {
+	*x = IsMutualResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsMutualResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMutualResponse) ProtoMessage() {}
+
+func (x *IsMutualResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMutualResponse.ProtoReflect.Descriptor instead.
+func (*IsMutualResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IsMutualResponse) GetMutual() bool {
+	if x != nil {
+		return x.Mutual
+	}
+	return false
+}
+
+type GetFollowersYouKnowRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId int64                  `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	TargetId int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Size of the returned sample, at most 20
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowersYouKnowRequest) Reset() {
+	*x = GetFollowersYouKnowRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowersYouKnowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowersYouKnowRequest) ProtoMessage() {}
+
+func (x *GetFollowersYouKnowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowersYouKnowRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowersYouKnowRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetFollowersYouKnowRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *GetFollowersYouKnowRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *GetFollowersYouKnowRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetFollowersYouKnowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most recent followers first
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Size of the whole intersection
+	Total         int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowersYouKnowResponse) Reset() {
+	*x = GetFollowersYouKnowResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowersYouKnowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowersYouKnowResponse) ProtoMessage() {}
+
+func (x *GetFollowersYouKnowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowersYouKnowResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowersYouKnowResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetFollowersYouKnowResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetFollowersYouKnowResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetSuggestionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Number of suggestions to return, at most 50
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSuggestionsRequest) Reset() {
+	*x = GetSuggestionsRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuggestionsRequest) ProtoMessage() {}
+
+func (x *GetSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetSuggestionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetSuggestionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Suggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	User  *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// How many of the user's followees follow the candidate
+	MutualCount int64 `protobuf:"varint,2,opt,name=mutual_count,json=mutualCount,proto3" json:"mutual_count,omitempty"`
+	// Ranking score, higher is better
+	Score         float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Suggestion) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Suggestion) GetMutualCount() int64 {
+	if x != nil {
+		return x.MutualCount
+	}
+	return 0
+}
+
+func (x *Suggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetSuggestionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Best candidates first
+	Suggestions   []*Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSuggestionsResponse) Reset() {
+	*x = GetSuggestionsResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSuggestionsResponse) ProtoMessage() {}
+
+func (x *GetSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSuggestionsResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type FollowTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    int64                  `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	TargetType    TargetType             `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=relation_ext.v1.TargetType" json:"target_type,omitempty"`
+	TargetId      int64                  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowTargetRequest) Reset() {
+	*x = FollowTargetRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowTargetRequest) ProtoMessage() {}
+
+func (x *FollowTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowTargetRequest.ProtoReflect.Descriptor instead.
+func (*FollowTargetRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FollowTargetRequest) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+func (x *FollowTargetRequest) GetTargetType() TargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return TargetType_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *FollowTargetRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type FollowTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowTargetResponse) Reset() {
+	*x = FollowTargetResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowTargetResponse) ProtoMessage() {}
+
+func (x *FollowTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowTargetResponse.ProtoReflect.Descriptor instead.
+func (*FollowTargetResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{14}
+}
+
+type UnfollowTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    int64                  `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	TargetType    TargetType             `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=relation_ext.v1.TargetType" json:"target_type,omitempty"`
+	TargetId      int64                  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowTargetRequest) Reset() {
+	*x = UnfollowTargetRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowTargetRequest) ProtoMessage() {}
+
+func (x *UnfollowTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowTargetRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowTargetRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UnfollowTargetRequest) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+func (x *UnfollowTargetRequest) GetTargetType() TargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return TargetType_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *UnfollowTargetRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+type UnfollowTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowTargetResponse) Reset() {
+	*x = UnfollowTargetResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowTargetResponse) ProtoMessage() {}
+
+func (x *UnfollowTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowTargetResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowTargetResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{16}
+}
+
+type ListFollowedTargetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    int64                  `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	TargetType    TargetType             `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=relation_ext.v1.TargetType" json:"target_type,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowedTargetsRequest) Reset() {
+	*x = ListFollowedTargetsRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowedTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowedTargetsRequest) ProtoMessage() {}
+
+func (x *ListFollowedTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowedTargetsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowedTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListFollowedTargetsRequest) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+func (x *ListFollowedTargetsRequest) GetTargetType() TargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return TargetType_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ListFollowedTargetsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFollowedTargetsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListFollowedTargetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetIds     []int64                `protobuf:"varint,1,rep,packed,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowedTargetsResponse) Reset() {
+	*x = ListFollowedTargetsResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowedTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowedTargetsResponse) ProtoMessage() {}
+
+func (x *ListFollowedTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowedTargetsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowedTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListFollowedTargetsResponse) GetTargetIds() []int64 {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+func (x *ListFollowedTargetsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type StreamFollowerIDsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// cursor is the last follower id already received, 0 starts from the beginning
+	Cursor int64 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// chunk_size is the number of ids per message, 0 uses the server default
+	ChunkSize     int32 `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamFollowerIDsRequest) Reset() {
+	*x = StreamFollowerIDsRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamFollowerIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFollowerIDsRequest) ProtoMessage() {}
+
+func (x *StreamFollowerIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFollowerIDsRequest.ProtoReflect.Descriptor instead.
+func (*StreamFollowerIDsRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StreamFollowerIDsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StreamFollowerIDsRequest) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *StreamFollowerIDsRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type StreamFollowerIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerIds   []int64                `protobuf:"varint,1,rep,packed,name=follower_ids,json=followerIds,proto3" json:"follower_ids,omitempty"`
+	NextCursor    int64                  `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamFollowerIDsResponse) Reset() {
+	*x = StreamFollowerIDsResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamFollowerIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFollowerIDsResponse) ProtoMessage() {}
+
+func (x *StreamFollowerIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFollowerIDsResponse.ProtoReflect.Descriptor instead.
+func (*StreamFollowerIDsResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StreamFollowerIDsResponse) GetFollowerIds() []int64 {
+	if x != nil {
+		return x.FollowerIds
+	}
+	return nil
+}
+
+func (x *StreamFollowerIDsResponse) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+type FollowListOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sort orders by follow time, unspecified means newest first
+	Sort FollowListSort `protobuf:"varint,1,opt,name=sort,proto3,enum=relation_ext.v1.FollowListSort" json:"sort,omitempty"`
+	// since keeps follows made at or after this time
+	Since *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	// until keeps follows made before this time
+	Until *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	// mutual_only keeps users the follow goes both ways with
+	MutualOnly bool `protobuf:"varint,4,opt,name=mutual_only,json=mutualOnly,proto3" json:"mutual_only,omitempty"`
+	// ids_only returns user_ids instead of enriched users
+	IdsOnly       bool `protobuf:"varint,5,opt,name=ids_only,json=idsOnly,proto3" json:"ids_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowListOptions) Reset() {
+	*x = FollowListOptions{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowListOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowListOptions) ProtoMessage() {}
+
+func (x *FollowListOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowListOptions.ProtoReflect.Descriptor instead.
+func (*FollowListOptions) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FollowListOptions) GetSort() FollowListSort {
+	if x != nil {
+		return x.Sort
+	}
+	return FollowListSort_FOLLOW_LIST_SORT_UNSPECIFIED
+}
+
+func (x *FollowListOptions) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *FollowListOptions) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *FollowListOptions) GetMutualOnly() bool {
+	if x != nil {
+		return x.MutualOnly
+	}
+	return false
+}
+
+func (x *FollowListOptions) GetIdsOnly() bool {
+	if x != nil {
+		return x.IdsOnly
+	}
+	return false
+}
+
+type ListFollowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Options       *FollowListOptions     `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowsRequest) Reset() {
+	*x = ListFollowsRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowsRequest) ProtoMessage() {}
+
+func (x *ListFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListFollowsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListFollowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFollowsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFollowsRequest) GetOptions() *FollowListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListFollowsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// users is empty when ids_only was requested
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// user_ids is only filled when ids_only was requested
+	UserIds       []int64 `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Total         int64   `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowsResponse) Reset() {
+	*x = ListFollowsResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowsResponse) ProtoMessage() {}
+
+func (x *ListFollowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowsResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListFollowsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListFollowsResponse) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *ListFollowsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SearchFollowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFollowsRequest) Reset() {
+	*x = SearchFollowsRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFollowsRequest) ProtoMessage() {}
+
+func (x *SearchFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFollowsRequest.ProtoReflect.Descriptor instead.
+func (*SearchFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SearchFollowsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchFollowsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchFollowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ProfileMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	FullName      *string                `protobuf:"bytes,2,opt,name=full_name,json=fullName,proto3,oneof" json:"full_name,omitempty"`
+	Score         float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileMatch) Reset() {
+	*x = ProfileMatch{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileMatch) ProtoMessage() {}
+
+func (x *ProfileMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileMatch.ProtoReflect.Descriptor instead.
+func (*ProfileMatch) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ProfileMatch) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ProfileMatch) GetFullName() string {
+	if x != nil && x.FullName != nil {
+		return *x.FullName
+	}
+	return ""
+}
+
+func (x *ProfileMatch) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchFollowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*ProfileMatch        `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFollowsResponse) Reset() {
+	*x = SearchFollowsResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFollowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFollowsResponse) ProtoMessage() {}
+
+func (x *SearchFollowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFollowsResponse.ProtoReflect.Descriptor instead.
+func (*SearchFollowsResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SearchFollowsResponse) GetMatches() []*ProfileMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type GetRelationHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtherUserId   *int64                 `protobuf:"varint,2,opt,name=other_user_id,json=otherUserId,proto3,oneof" json:"other_user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationHistoryRequest) Reset() {
+	*x = GetRelationHistoryRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationHistoryRequest) ProtoMessage() {}
+
+func (x *GetRelationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetRelationHistoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetRelationHistoryRequest) GetOtherUserId() int64 {
+	if x != nil && x.OtherUserId != nil {
+		return *x.OtherUserId
+	}
+	return 0
+}
+
+func (x *GetRelationHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRelationHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type RelationHistoryEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FollowerId int64                  `protobuf:"varint,2,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeId int64                  `protobuf:"varint,3,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	Action     RelationAction         `protobuf:"varint,4,opt,name=action,proto3,enum=relation_ext.v1.RelationAction" json:"action,omitempty"`
+	// actor_id is the user or service account that made the change, unset for system changes
+	ActorId       *int64                 `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	Reason        RelationReason         `protobuf:"varint,6,opt,name=reason,proto3,enum=relation_ext.v1.RelationReason" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationHistoryEntry) Reset() {
+	*x = RelationHistoryEntry{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationHistoryEntry) ProtoMessage() {}
+
+func (x *RelationHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationHistoryEntry.ProtoReflect.Descriptor instead.
+func (*RelationHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RelationHistoryEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RelationHistoryEntry) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+func (x *RelationHistoryEntry) GetFolloweeId() int64 {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return 0
+}
+
+func (x *RelationHistoryEntry) GetAction() RelationAction {
+	if x != nil {
+		return x.Action
+	}
+	return RelationAction_RELATION_ACTION_UNSPECIFIED
+}
+
+func (x *RelationHistoryEntry) GetActorId() int64 {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return 0
+}
+
+func (x *RelationHistoryEntry) GetReason() RelationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RelationReason_RELATION_REASON_UNSPECIFIED
+}
+
+func (x *RelationHistoryEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetRelationHistoryResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entries       []*RelationHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int64                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationHistoryResponse) Reset() {
+	*x = GetRelationHistoryResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationHistoryResponse) ProtoMessage() {}
+
+func (x *GetRelationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetRelationHistoryResponse) GetEntries() []*RelationHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetRelationHistoryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RemoveFollowerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	FollowerId    int64                  `protobuf:"varint,2,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFollowerRequest) Reset() {
+	*x = RemoveFollowerRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFollowerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFollowerRequest) ProtoMessage() {}
+
+func (x *RemoveFollowerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFollowerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFollowerRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RemoveFollowerRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *RemoveFollowerRequest) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+type RemoveFollowerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFollowerResponse) Reset() {
+	*x = RemoveFollowerResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFollowerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFollowerResponse) ProtoMessage() {}
+
+func (x *RemoveFollowerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFollowerResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFollowerResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{31}
+}
+
+type RemoveFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	FollowerIds   []int64                `protobuf:"varint,2,rep,packed,name=follower_ids,json=followerIds,proto3" json:"follower_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFollowersRequest) Reset() {
+	*x = RemoveFollowersRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFollowersRequest) ProtoMessage() {}
+
+func (x *RemoveFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFollowersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RemoveFollowersRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *RemoveFollowersRequest) GetFollowerIds() []int64 {
+	if x != nil {
+		return x.FollowerIds
+	}
+	return nil
+}
+
+type RemoveFollowersResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RemovedFollowerIds []int64                `protobuf:"varint,1,rep,packed,name=removed_follower_ids,json=removedFollowerIds,proto3" json:"removed_follower_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RemoveFollowersResponse) Reset() {
+	*x = RemoveFollowersResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFollowersResponse) ProtoMessage() {}
+
+func (x *RemoveFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFollowersResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RemoveFollowersResponse) GetRemovedFollowerIds() []int64 {
+	if x != nil {
+		return x.RemovedFollowerIds
+	}
+	return nil
+}
+
+type BulkFollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    int64                  `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeIds   []int64                `protobuf:"varint,2,rep,packed,name=followee_ids,json=followeeIds,proto3" json:"followee_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkFollowRequest) Reset() {
+	*x = BulkFollowRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkFollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkFollowRequest) ProtoMessage() {}
+
+func (x *BulkFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkFollowRequest.ProtoReflect.Descriptor instead.
+func (*BulkFollowRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BulkFollowRequest) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+func (x *BulkFollowRequest) GetFolloweeIds() []int64 {
+	if x != nil {
+		return x.FolloweeIds
+	}
+	return nil
+}
+
+type BulkFollowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FolloweeId    int64                  `protobuf:"varint,1,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	Outcome       BulkOutcome            `protobuf:"varint,2,opt,name=outcome,proto3,enum=relation_ext.v1.BulkOutcome" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkFollowResult) Reset() {
+	*x = BulkFollowResult{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkFollowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkFollowResult) ProtoMessage() {}
+
+func (x *BulkFollowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkFollowResult.ProtoReflect.Descriptor instead.
+func (*BulkFollowResult) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BulkFollowResult) GetFolloweeId() int64 {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return 0
+}
+
+func (x *BulkFollowResult) GetOutcome() BulkOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return BulkOutcome_BULK_OUTCOME_UNSPECIFIED
+}
+
+type BulkFollowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// in the order of followee_ids, duplicates removed
+	Results       []*BulkFollowResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkFollowResponse) Reset() {
+	*x = BulkFollowResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkFollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkFollowResponse) ProtoMessage() {}
+
+func (x *BulkFollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkFollowResponse.ProtoReflect.Descriptor instead.
+func (*BulkFollowResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BulkFollowResponse) GetResults() []*BulkFollowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type Mute struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId int64                  `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeId int64                  `protobuf:"varint,2,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// expires_at is unset for a mute that lasts until unmuted
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mute) Reset() {
+	*x = Mute{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mute) ProtoMessage() {}
+
+func (x *Mute) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mute.ProtoReflect.Descriptor instead.
+func (*Mute) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Mute) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+func (x *Mute) GetFolloweeId() int64 {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return 0
+}
+
+func (x *Mute) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Mute) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type MuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    int64                  `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeId    int64                  `protobuf:"varint,2,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteRequest) Reset() {
+	*x = MuteRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteRequest) ProtoMessage() {}
+
+func (x *MuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteRequest.ProtoReflect.Descriptor instead.
+func (*MuteRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MuteRequest) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+func (x *MuteRequest) GetFolloweeId() int64 {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return 0
+}
+
+func (x *MuteRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type MuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mute          *Mute                  `protobuf:"bytes,1,opt,name=mute,proto3" json:"mute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteResponse) Reset() {
+	*x = MuteResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteResponse) ProtoMessage() {}
+
+func (x *MuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteResponse.ProtoReflect.Descriptor instead.
+func (*MuteResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MuteResponse) GetMute() *Mute {
+	if x != nil {
+		return x.Mute
+	}
+	return nil
+}
+
+type UnmuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    int64                  `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeId    int64                  `protobuf:"varint,2,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteRequest) Reset() {
+	*x = UnmuteRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteRequest) ProtoMessage() {}
+
+func (x *UnmuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnmuteRequest) GetFollowerId() int64 {
+	if x != nil {
+		return x.FollowerId
+	}
+	return 0
+}
+
+func (x *UnmuteRequest) GetFolloweeId() int64 {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return 0
+}
+
+type UnmuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteResponse) Reset() {
+	*x = UnmuteResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteResponse) ProtoMessage() {}
+
+func (x *UnmuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{41}
+}
+
+type ListMutedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMutedRequest) Reset() {
+	*x = ListMutedRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedRequest) ProtoMessage() {}
+
+func (x *ListMutedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedRequest.ProtoReflect.Descriptor instead.
+func (*ListMutedRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListMutedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListMutedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMutedRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListMutedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mutes         []*Mute                `protobuf:"bytes,1,rep,name=mutes,proto3" json:"mutes,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMutedResponse) Reset() {
+	*x = ListMutedResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedResponse) ProtoMessage() {}
+
+func (x *ListMutedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedResponse.ProtoReflect.Descriptor instead.
+func (*ListMutedResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMutedResponse) GetMutes() []*Mute {
+	if x != nil {
+		return x.Mutes
+	}
+	return nil
+}
+
+func (x *ListMutedResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type FilterMutedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      int64                  `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	AuthorIds     []int64                `protobuf:"varint,2,rep,packed,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterMutedRequest) Reset() {
+	*x = FilterMutedRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterMutedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterMutedRequest) ProtoMessage() {}
+
+func (x *FilterMutedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterMutedRequest.ProtoReflect.Descriptor instead.
+func (*FilterMutedRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FilterMutedRequest) GetViewerId() int64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *FilterMutedRequest) GetAuthorIds() []int64 {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
+}
+
+type FilterMutedResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MutedAuthorIds []int64                `protobuf:"varint,1,rep,packed,name=muted_author_ids,json=mutedAuthorIds,proto3" json:"muted_author_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FilterMutedResponse) Reset() {
+	*x = FilterMutedResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterMutedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterMutedResponse) ProtoMessage() {}
+
+func (x *FilterMutedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterMutedResponse.ProtoReflect.Descriptor instead.
+func (*FilterMutedResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *FilterMutedResponse) GetMutedAuthorIds() []int64 {
+	if x != nil {
+		return x.MutedAuthorIds
+	}
+	return nil
+}
+
+type AudienceList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MemberCount   int64                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudienceList) Reset() {
+	*x = AudienceList{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceList) ProtoMessage() {}
+
+func (x *AudienceList) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceList.ProtoReflect.Descriptor instead.
+func (*AudienceList) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AudienceList) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AudienceList) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *AudienceList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AudienceList) GetMemberCount() int64 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *AudienceList) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AudienceList) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateAudienceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAudienceListRequest) Reset() {
+	*x = CreateAudienceListRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAudienceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAudienceListRequest) ProtoMessage() {}
+
+func (x *CreateAudienceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAudienceListRequest.ProtoReflect.Descriptor instead.
+func (*CreateAudienceListRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateAudienceListRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CreateAudienceListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateAudienceListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *AudienceList          `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAudienceListResponse) Reset() {
+	*x = CreateAudienceListResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAudienceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAudienceListResponse) ProtoMessage() {}
+
+func (x *CreateAudienceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAudienceListResponse.ProtoReflect.Descriptor instead.
+func (*CreateAudienceListResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateAudienceListResponse) GetList() *AudienceList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type RenameAudienceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ListId        int64                  `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameAudienceListRequest) Reset() {
+	*x = RenameAudienceListRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameAudienceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameAudienceListRequest) ProtoMessage() {}
+
+func (x *RenameAudienceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameAudienceListRequest.ProtoReflect.Descriptor instead.
+func (*RenameAudienceListRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RenameAudienceListRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *RenameAudienceListRequest) GetListId() int64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *RenameAudienceListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameAudienceListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          *AudienceList          `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameAudienceListResponse) Reset() {
+	*x = RenameAudienceListResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameAudienceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameAudienceListResponse) ProtoMessage() {}
+
+func (x *RenameAudienceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameAudienceListResponse.ProtoReflect.Descriptor instead.
+func (*RenameAudienceListResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RenameAudienceListResponse) GetList() *AudienceList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type DeleteAudienceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ListId        int64                  `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAudienceListRequest) Reset() {
+	*x = DeleteAudienceListRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAudienceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAudienceListRequest) ProtoMessage() {}
+
+func (x *DeleteAudienceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAudienceListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAudienceListRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteAudienceListRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *DeleteAudienceListRequest) GetListId() int64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+type DeleteAudienceListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAudienceListResponse) Reset() {
+	*x = DeleteAudienceListResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAudienceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAudienceListResponse) ProtoMessage() {}
+
+func (x *DeleteAudienceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAudienceListResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAudienceListResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{52}
+}
+
+type ListAudienceListsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAudienceListsRequest) Reset() {
+	*x = ListAudienceListsRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAudienceListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAudienceListsRequest) ProtoMessage() {}
+
+func (x *ListAudienceListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAudienceListsRequest.ProtoReflect.Descriptor instead.
+func (*ListAudienceListsRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListAudienceListsRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+type ListAudienceListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lists         []*AudienceList        `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAudienceListsResponse) Reset() {
+	*x = ListAudienceListsResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAudienceListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAudienceListsResponse) ProtoMessage() {}
+
+func (x *ListAudienceListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAudienceListsResponse.ProtoReflect.Descriptor instead.
+func (*ListAudienceListsResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListAudienceListsResponse) GetLists() []*AudienceList {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+type AudienceMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ListId        int64                  `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	MemberIds     []int64                `protobuf:"varint,3,rep,packed,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudienceMembersRequest) Reset() {
+	*x = AudienceMembersRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceMembersRequest) ProtoMessage() {}
+
+func (x *AudienceMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceMembersRequest.ProtoReflect.Descriptor instead.
+func (*AudienceMembersRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AudienceMembersRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *AudienceMembersRequest) GetListId() int64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *AudienceMembersRequest) GetMemberIds() []int64 {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type AudienceMembersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// changed_member_ids are the members that were actually added or removed
+	ChangedMemberIds []int64 `protobuf:"varint,1,rep,packed,name=changed_member_ids,json=changedMemberIds,proto3" json:"changed_member_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AudienceMembersResponse) Reset() {
+	*x = AudienceMembersResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceMembersResponse) ProtoMessage() {}
+
+func (x *AudienceMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceMembersResponse.ProtoReflect.Descriptor instead.
+func (*AudienceMembersResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AudienceMembersResponse) GetChangedMemberIds() []int64 {
+	if x != nil {
+		return x.ChangedMemberIds
+	}
+	return nil
+}
+
+type ListAudienceMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ListId        int64                  `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAudienceMembersRequest) Reset() {
+	*x = ListAudienceMembersRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAudienceMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAudienceMembersRequest) ProtoMessage() {}
+
+func (x *ListAudienceMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAudienceMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListAudienceMembersRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListAudienceMembersRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *ListAudienceMembersRequest) GetListId() int64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *ListAudienceMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAudienceMembersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+type ListAudienceMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberIds     []int64                `protobuf:"varint,1,rep,packed,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAudienceMembersResponse) Reset() {
+	*x = ListAudienceMembersResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAudienceMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAudienceMembersResponse) ProtoMessage() {}
+
+func (x *ListAudienceMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAudienceMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListAudienceMembersResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListAudienceMembersResponse) GetMemberIds() []int64 {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+func (x *ListAudienceMembersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type IsAudienceMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ListId        int64                  `protobuf:"varint,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsAudienceMemberRequest) Reset() {
+	*x = IsAudienceMemberRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsAudienceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsAudienceMemberRequest) ProtoMessage() {}
+
+func (x *IsAudienceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAudienceMemberRequest.ProtoReflect.Descriptor instead.
+func (*IsAudienceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *IsAudienceMemberRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *IsAudienceMemberRequest) GetListId() int64 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *IsAudienceMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type IsAudienceMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsMember      bool                   `protobuf:"varint,1,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsAudienceMemberResponse) Reset() {
+	*x = IsAudienceMemberResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsAudienceMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsAudienceMemberResponse) ProtoMessage() {}
+
+func (x *IsAudienceMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsAudienceMemberResponse.ProtoReflect.Descriptor instead.
+func (*IsAudienceMemberResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *IsAudienceMemberResponse) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
+type FollowSourceStat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// day is the start of a UTC day
+	Day           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Follows       int64                  `protobuf:"varint,3,opt,name=follows,proto3" json:"follows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowSourceStat) Reset() {
+	*x = FollowSourceStat{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowSourceStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowSourceStat) ProtoMessage() {}
+
+func (x *FollowSourceStat) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowSourceStat.ProtoReflect.Descriptor instead.
+func (*FollowSourceStat) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FollowSourceStat) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *FollowSourceStat) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FollowSourceStat) GetFollows() int64 {
+	if x != nil {
+		return x.Follows
+	}
+	return 0
+}
+
+type GetFollowSourceStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// from and to select UTC days, both inclusive, at most 366 days apart
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowSourceStatsRequest) Reset() {
+	*x = GetFollowSourceStatsRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowSourceStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowSourceStatsRequest) ProtoMessage() {}
+
+func (x *GetFollowSourceStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowSourceStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowSourceStatsRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetFollowSourceStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetFollowSourceStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetFollowSourceStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*FollowSourceStat    `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowSourceStatsResponse) Reset() {
+	*x = GetFollowSourceStatsResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowSourceStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowSourceStatsResponse) ProtoMessage() {}
+
+func (x *GetFollowSourceStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowSourceStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowSourceStatsResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetFollowSourceStatsResponse) GetStats() []*FollowSourceStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type FollowerGrowthBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// start is the start of the UTC day, week or month
+	Start  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Gained int64                  `protobuf:"varint,2,opt,name=gained,proto3" json:"gained,omitempty"`
+	Lost   int64                  `protobuf:"varint,3,opt,name=lost,proto3" json:"lost,omitempty"`
+	Net    int64                  `protobuf:"varint,4,opt,name=net,proto3" json:"net,omitempty"`
+	// total is the follower count at the end of the bucket
+	Total         int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowerGrowthBucket) Reset() {
+	*x = FollowerGrowthBucket{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowerGrowthBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowerGrowthBucket) ProtoMessage() {}
+
+func (x *FollowerGrowthBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowerGrowthBucket.ProtoReflect.Descriptor instead.
+func (*FollowerGrowthBucket) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FollowerGrowthBucket) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *FollowerGrowthBucket) GetGained() int64 {
+	if x != nil {
+		return x.Gained
+	}
+	return 0
+}
+
+func (x *FollowerGrowthBucket) GetLost() int64 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *FollowerGrowthBucket) GetNet() int64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+func (x *FollowerGrowthBucket) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetFollowerGrowthRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// from and to select UTC days, both inclusive, at most 731 days apart
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// granularity defaults to days
+	Granularity   FollowerGrowthGranularity `protobuf:"varint,4,opt,name=granularity,proto3,enum=relation_ext.v1.FollowerGrowthGranularity" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowerGrowthRequest) Reset() {
+	*x = GetFollowerGrowthRequest{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowerGrowthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerGrowthRequest) ProtoMessage() {}
+
+func (x *GetFollowerGrowthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowerGrowthRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowerGrowthRequest) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetFollowerGrowthRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetFollowerGrowthRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetFollowerGrowthRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetFollowerGrowthRequest) GetGranularity() FollowerGrowthGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return FollowerGrowthGranularity_FOLLOWER_GROWTH_GRANULARITY_UNSPECIFIED
+}
+
+type GetFollowerGrowthResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Buckets       []*FollowerGrowthBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowerGrowthResponse) Reset() {
+	*x = GetFollowerGrowthResponse{}
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowerGrowthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowerGrowthResponse) ProtoMessage() {}
+
+func (x *GetFollowerGrowthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_ext_v1_relation_ext_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowerGrowthResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowerGrowthResponse) Descriptor() ([]byte, []int) {
+	return file_relation_ext_v1_relation_ext_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetFollowerGrowthResponse) GetBuckets() []*FollowerGrowthBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+var File_relation_ext_v1_relation_ext_proto protoreflect.FileDescriptor
+
+const file_relation_ext_v1_relation_ext_proto_rawDesc = "" +
+	"\n" +
+	"\"relation_ext/v1/relation_ext.proto\x12\x0frelation_ext.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"n\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tH\x00R\tavatarUrl\x88\x01\x01B\r\n" +
+	"\v_avatar_url\"U\n" +
+	"\x17GetRelationshipsRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\x03R\bviewerId\x12\x1d\n" +
+	"\n" +
+	"target_ids\x18\x02 \x03(\x03R\ttargetIds\"\x91\x01\n" +
+	"\fRelationship\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\x03R\btargetId\x12\x1c\n" +
+	"\tfollowing\x18\x02 \x01(\bR\tfollowing\x12\x1f\n" +
+	"\vfollowed_by\x18\x03 \x01(\bR\n" +
+	"followedByJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\x10follow_requestedR\ablocked\"_\n" +
+	"\x18GetRelationshipsResponse\x12C\n" +
+	"\rrelationships\x18\x01 \x03(\v2\x1d.relation_ext.v1.RelationshipR\rrelationships\"\\\n" +
+	"\x17GetMutualFollowsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\"]\n" +
+	"\x18GetMutualFollowsResponse\x12+\n" +
+	"\x05users\x18\x01 \x03(\v2\x15.relation_ext.v1.UserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"N\n" +
+	"\x0fIsMutualRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
+	"\rother_user_id\x18\x02 \x01(\x03R\votherUserId\"*\n" +
+	"\x10IsMutualResponse\x12\x16\n" +
+	"\x06mutual\x18\x01 \x01(\bR\x06mutual\"l\n" +
+	"\x1aGetFollowersYouKnowRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\x03R\bviewerId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"`\n" +
+	"\x1bGetFollowersYouKnowResponse\x12+\n" +
+	"\x05users\x18\x01 \x03(\v2\x15.relation_ext.v1.UserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"F\n" +
+	"\x15GetSuggestionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"p\n" +
+	"\n" +
+	"Suggestion\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.relation_ext.v1.UserR\x04user\x12!\n" +
+	"\fmutual_count\x18\x02 \x01(\x03R\vmutualCount\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\"W\n" +
+	"\x16GetSuggestionsResponse\x12=\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1b.relation_ext.v1.SuggestionR\vsuggestions\"\x91\x01\n" +
+	"\x13FollowTargetRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\x03R\n" +
+	"followerId\x12<\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2\x1b.relation_ext.v1.TargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x03R\btargetId\"\x16\n" +
+	"\x14FollowTargetResponse\"\x93\x01\n" +
+	"\x15UnfollowTargetRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\x03R\n" +
+	"followerId\x12<\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2\x1b.relation_ext.v1.TargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x03R\btargetId\"\x18\n" +
+	"\x16UnfollowTargetResponse\"\xa5\x01\n" +
+	"\x1aListFollowedTargetsRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\x03R\n" +
+	"followerId\x12<\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2\x1b.relation_ext.v1.TargetTypeR\n" +
+	"targetType\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\"R\n" +
+	"\x1bListFollowedTargetsResponse\x12\x1d\n" +
+	"\n" +
+	"target_ids\x18\x01 \x03(\x03R\ttargetIds\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"j\n" +
+	"\x18StreamFollowerIDsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\x03R\x06cursor\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x03 \x01(\x05R\tchunkSize\"_\n" +
+	"\x19StreamFollowerIDsResponse\x12!\n" +
+	"\ffollower_ids\x18\x01 \x03(\x03R\vfollowerIds\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x03R\n" +
+	"nextCursor\"\xe8\x01\n" +
+	"\x11FollowListOptions\x123\n" +
+	"\x04sort\x18\x01 \x01(\x0e2\x1f.relation_ext.v1.FollowListSortR\x04sort\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12\x1f\n" +
+	"\vmutual_only\x18\x04 \x01(\bR\n" +
+	"mutualOnly\x12\x19\n" +
+	"\bids_only\x18\x05 \x01(\bR\aidsOnly\"\x95\x01\n" +
+	"\x12ListFollowsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12<\n" +
+	"\aoptions\x18\x04 \x01(\v2\".relation_ext.v1.FollowListOptionsR\aoptions\"s\n" +
+	"\x13ListFollowsResponse\x12+\n" +
+	"\x05users\x18\x01 \x03(\v2\x15.relation_ext.v1.UserR\x05users\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x03R\auserIds\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\"[\n" +
+	"\x14SearchFollowsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x7f\n" +
+	"\fProfileMatch\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.relation_ext.v1.UserR\x04user\x12 \n" +
+	"\tfull_name\x18\x02 \x01(\tH\x00R\bfullName\x88\x01\x01\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05scoreB\f\n" +
+	"\n" +
+	"_full_name\"P\n" +
+	"\x15SearchFollowsResponse\x127\n" +
+	"\amatches\x18\x01 \x03(\v2\x1d.relation_ext.v1.ProfileMatchR\amatches\"\x99\x01\n" +
+	"\x19GetRelationHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\rother_user_id\x18\x02 \x01(\x03H\x00R\votherUserId\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04pageB\x10\n" +
+	"\x0e_other_user_id\"\xc2\x02\n" +
+	"\x14RelationHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vfollower_id\x18\x02 \x01(\x03R\n" +
+	"followerId\x12\x1f\n" +
+	"\vfollowee_id\x18\x03 \x01(\x03R\n" +
+	"followeeId\x127\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x1f.relation_ext.v1.RelationActionR\x06action\x12\x1e\n" +
+	"\bactor_id\x18\x05 \x01(\x03H\x00R\aactorId\x88\x01\x01\x127\n" +
+	"\x06reason\x18\x06 \x01(\x0e2\x1f.relation_ext.v1.RelationReasonR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\v\n" +
+	"\t_actor_id\"s\n" +
+	"\x1aGetRelationHistoryResponse\x12?\n" +
+	"\aentries\x18\x01 \x03(\v2%.relation_ext.v1.RelationHistoryEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"S\n" +
+	"\x15RemoveFollowerRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x1f\n" +
+	"\vfollower_id\x18\x02 \x01(\x03R\n" +
+	"followerId\"\x18\n" +
+	"\x16RemoveFollowerResponse\"V\n" +
+	"\x16RemoveFollowersRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12!\n" +
+	"\ffollower_ids\x18\x02 \x03(\x03R\vfollowerIds\"K\n" +
+	"\x17RemoveFollowersResponse\x120\n" +
+	"\x14removed_follower_ids\x18\x01 \x03(\x03R\x12removedFollowerIds\"W\n" +
+	"\x11BulkFollowRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\x03R\n" +
+	"followerId\x12!\n" +
+	"\ffollowee_ids\x18\x02 \x03(\x03R\vfolloweeIds\"k\n" +
+	"\x10BulkFollowResult\x12\x1f\n" +
+	"\vfollowee_id\x18\x01 \x01(\x03R\n" +
+	"followeeId\x126\n" +
+	"\aoutcome\x18\x02 \x01(\x0e2\x1c.relation_ext.v1.BulkOutcomeR\aoutcome\"Q\n" +
+	"\x12BulkFollowResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.relation_ext.v1.BulkFollowResultR\aresults\"\xd2\x01\n" +
+	"\x04Mute\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\x03R\n" +
+	"followerId\x12\x1f\n" +
+	"\vfollowee_id\x18\x02 \x01(\x03R\n" +
+	"followeeId\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"\x9e\x01\n" +
+	"\vMuteRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\x03R\n" +
+	"followerId\x12\x1f\n" +
+	"\vfollowee_id\x18\x02 \x01(\x03R\n" +
+	"followeeId\x12>\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"9\n" +
+	"\fMuteResponse\x12)\n" +
+	"\x04mute\x18\x01 \x01(\v2\x15.relation_ext.v1.MuteR\x04mute\"Q\n" +
+	"\rUnmuteRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\x03R\n" +
+	"followerId\x12\x1f\n" +
+	"\vfollowee_id\x18\x02 \x01(\x03R\n" +
+	"followeeId\"\x10\n" +
+	"\x0eUnmuteResponse\"U\n" +
+	"\x10ListMutedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\"V\n" +
+	"\x11ListMutedResponse\x12+\n" +
+	"\x05mutes\x18\x01 \x03(\v2\x15.relation_ext.v1.MuteR\x05mutes\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"P\n" +
+	"\x12FilterMutedRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\x03R\bviewerId\x12\x1d\n" +
+	"\n" +
+	"author_ids\x18\x02 \x03(\x03R\tauthorIds\"?\n" +
+	"\x13FilterMutedResponse\x12(\n" +
+	"\x10muted_author_ids\x18\x01 \x03(\x03R\x0emutedAuthorIds\"\xe6\x01\n" +
+	"\fAudienceList\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\fmember_count\x18\x04 \x01(\x03R\vmemberCount\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"J\n" +
+	"\x19CreateAudienceListRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"O\n" +
+	"\x1aCreateAudienceListResponse\x121\n" +
+	"\x04list\x18\x01 \x01(\v2\x1d.relation_ext.v1.AudienceListR\x04list\"c\n" +
+	"\x19RenameAudienceListRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\x03R\x06listId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"O\n" +
+	"\x1aRenameAudienceListResponse\x121\n" +
+	"\x04list\x18\x01 \x01(\v2\x1d.relation_ext.v1.AudienceListR\x04list\"O\n" +
+	"\x19DeleteAudienceListRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\x03R\x06listId\"\x1c\n" +
+	"\x1aDeleteAudienceListResponse\"5\n" +
+	"\x18ListAudienceListsRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\"P\n" +
+	"\x19ListAudienceListsResponse\x123\n" +
+	"\x05lists\x18\x01 \x03(\v2\x1d.relation_ext.v1.AudienceListR\x05lists\"k\n" +
+	"\x16AudienceMembersRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\x03R\x06listId\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x03 \x03(\x03R\tmemberIds\"G\n" +
+	"\x17AudienceMembersResponse\x12,\n" +
+	"\x12changed_member_ids\x18\x01 \x03(\x03R\x10changedMemberIds\"z\n" +
+	"\x1aListAudienceMembersRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\x03R\x06listId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\"R\n" +
+	"\x1bListAudienceMembersResponse\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x01 \x03(\x03R\tmemberIds\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"f\n" +
+	"\x17IsAudienceMemberRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\x03R\x06listId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\"7\n" +
+	"\x18IsAudienceMemberResponse\x12\x1b\n" +
+	"\tis_member\x18\x01 \x01(\bR\bisMember\"r\n" +
+	"\x10FollowSourceStat\x12,\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x18\n" +
+	"\afollows\x18\x03 \x01(\x03R\afollows\"y\n" +
+	"\x1bGetFollowSourceStatsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"W\n" +
+	"\x1cGetFollowSourceStatsResponse\x127\n" +
+	"\x05stats\x18\x01 \x03(\v2!.relation_ext.v1.FollowSourceStatR\x05stats\"\x9c\x01\n" +
+	"\x14FollowerGrowthBucket\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12\x16\n" +
+	"\x06gained\x18\x02 \x01(\x03R\x06gained\x12\x12\n" +
+	"\x04lost\x18\x03 \x01(\x03R\x04lost\x12\x10\n" +
+	"\x03net\x18\x04 \x01(\x03R\x03net\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x03R\x05total\"\xdd\x01\n" +
+	"\x18GetFollowerGrowthRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12L\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2*.relation_ext.v1.FollowerGrowthGranularityR\vgranularity\"\\\n" +
+	"\x19GetFollowerGrowthResponse\x12?\n" +
+	"\abuckets\x18\x01 \x03(\v2%.relation_ext.v1.FollowerGrowthBucketR\abuckets*k\n" +
+	"\n" +
+	"TargetType\x12\x1b\n" +
+	"\x17TARGET_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TARGET_TYPE_USER\x10\x01\x12\x15\n" +
+	"\x11TARGET_TYPE_BOARD\x10\x02\x12\x13\n" +
+	"\x0fTARGET_TYPE_TAG\x10\x03*l\n" +
+	"\x0eFollowListSort\x12 \n" +
+	"\x1cFOLLOW_LIST_SORT_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17FOLLOW_LIST_SORT_NEWEST\x10\x01\x12\x1b\n" +
+	"\x17FOLLOW_LIST_SORT_OLDEST\x10\x02*\xba\x01\n" +
+	"\x19FollowerGrowthGranularity\x12+\n" +
+	"'FOLLOWER_GROWTH_GRANULARITY_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fFOLLOWER_GROWTH_GRANULARITY_DAY\x10\x01\x12$\n" +
+	" FOLLOWER_GROWTH_GRANULARITY_WEEK\x10\x02\x12%\n" +
+	"!FOLLOWER_GROWTH_GRANULARITY_MONTH\x10\x03*\xa2\x01\n" +
+	"\x0eRelationAction\x12\x1f\n" +
+	"\x1bRELATION_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16RELATION_ACTION_FOLLOW\x10\x01\x12\x1c\n" +
+	"\x18RELATION_ACTION_UNFOLLOW\x10\x02\x12\x19\n" +
+	"\x15RELATION_ACTION_BLOCK\x10\x03\x12\x1a\n" +
+	"\x16RELATION_ACTION_REMOVE\x10\x04*\xa2\x01\n" +
+	"\x0eRelationReason\x12\x1f\n" +
+	"\x1bRELATION_REASON_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14RELATION_REASON_USER\x10\x01\x12\x19\n" +
+	"\x15RELATION_REASON_ADMIN\x10\x02\x12\x1b\n" +
+	"\x17RELATION_REASON_CASCADE\x10\x03\x12\x1d\n" +
+	"\x19RELATION_REASON_ANTI_SPAM\x10\x04*\xd9\x01\n" +
+	"\vBulkOutcome\x12\x1c\n" +
+	"\x18BULK_OUTCOME_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14BULK_OUTCOME_CREATED\x10\x01\x12\"\n" +
+	"\x1eBULK_OUTCOME_ALREADY_FOLLOWING\x10\x02\x12\x1a\n" +
+	"\x16BULK_OUTCOME_NOT_FOUND\x10\x03\x12\x18\n" +
+	"\x14BULK_OUTCOME_BLOCKED\x10\x04\x12\x18\n" +
+	"\x14BULK_OUTCOME_REMOVED\x10\x05\x12\x1e\n" +
+	"\x1aBULK_OUTCOME_NOT_FOLLOWING\x10\x062\x98\x19\n" +
+	"\x12RelationExtService\x12g\n" +
+	"\x10GetRelationships\x12(.relation_ext.v1.GetRelationshipsRequest\x1a).relation_ext.v1.GetRelationshipsResponse\x12g\n" +
+	"\x10GetMutualFollows\x12(.relation_ext.v1.GetMutualFollowsRequest\x1a).relation_ext.v1.GetMutualFollowsResponse\x12O\n" +
+	"\bIsMutual\x12 .relation_ext.v1.IsMutualRequest\x1a!.relation_ext.v1.IsMutualResponse\x12p\n" +
+	"\x13GetFollowersYouKnow\x12+.relation_ext.v1.GetFollowersYouKnowRequest\x1a,.relation_ext.v1.GetFollowersYouKnowResponse\x12a\n" +
+	"\x0eGetSuggestions\x12&.relation_ext.v1.GetSuggestionsRequest\x1a'.relation_ext.v1.GetSuggestionsResponse\x12[\n" +
+	"\fFollowTarget\x12$.relation_ext.v1.FollowTargetRequest\x1a%.relation_ext.v1.FollowTargetResponse\x12a\n" +
+	"\x0eUnfollowTarget\x12&.relation_ext.v1.UnfollowTargetRequest\x1a'.relation_ext.v1.UnfollowTargetResponse\x12p\n" +
+	"\x13ListFollowedTargets\x12+.relation_ext.v1.ListFollowedTargetsRequest\x1a,.relation_ext.v1.ListFollowedTargetsResponse\x12l\n" +
+	"\x11StreamFollowerIDs\x12).relation_ext.v1.StreamFollowerIDsRequest\x1a*.relation_ext.v1.StreamFollowerIDsResponse0\x01\x12Z\n" +
+	"\rListFollowers\x12#.relation_ext.v1.ListFollowsRequest\x1a$.relation_ext.v1.ListFollowsResponse\x12Z\n" +
+	"\rListFollowees\x12#.relation_ext.v1.ListFollowsRequest\x1a$.relation_ext.v1.ListFollowsResponse\x12`\n" +
+	"\x0fSearchFollowers\x12%.relation_ext.v1.SearchFollowsRequest\x1a&.relation_ext.v1.SearchFollowsResponse\x12`\n" +
+	"\x0fSearchFollowees\x12%.relation_ext.v1.SearchFollowsRequest\x1a&.relation_ext.v1.SearchFollowsResponse\x12m\n" +
+	"\x12GetRelationHistory\x12*.relation_ext.v1.GetRelationHistoryRequest\x1a+.relation_ext.v1.GetRelationHistoryResponse\x12a\n" +
+	"\x0eRemoveFollower\x12&.relation_ext.v1.RemoveFollowerRequest\x1a'.relation_ext.v1.RemoveFollowerResponse\x12d\n" +
+	"\x0fRemoveFollowers\x12'.relation_ext.v1.RemoveFollowersRequest\x1a(.relation_ext.v1.RemoveFollowersResponse\x12U\n" +
+	"\n" +
+	"BulkFollow\x12\".relation_ext.v1.BulkFollowRequest\x1a#.relation_ext.v1.BulkFollowResponse\x12W\n" +
+	"\fBulkUnfollow\x12\".relation_ext.v1.BulkFollowRequest\x1a#.relation_ext.v1.BulkFollowResponse\x12C\n" +
+	"\x04Mute\x12\x1c.relation_ext.v1.MuteRequest\x1a\x1d.relation_ext.v1.MuteResponse\x12I\n" +
+	"\x06Unmute\x12\x1e.relation_ext.v1.UnmuteRequest\x1a\x1f.relation_ext.v1.UnmuteResponse\x12R\n" +
+	"\tListMuted\x12!.relation_ext.v1.ListMutedRequest\x1a\".relation_ext.v1.ListMutedResponse\x12X\n" +
+	"\vFilterMuted\x12#.relation_ext.v1.FilterMutedRequest\x1a$.relation_ext.v1.FilterMutedResponse\x12m\n" +
+	"\x12CreateAudienceList\x12*.relation_ext.v1.CreateAudienceListRequest\x1a+.relation_ext.v1.CreateAudienceListResponse\x12m\n" +
+	"\x12RenameAudienceList\x12*.relation_ext.v1.RenameAudienceListRequest\x1a+.relation_ext.v1.RenameAudienceListResponse\x12m\n" +
+	"\x12DeleteAudienceList\x12*.relation_ext.v1.DeleteAudienceListRequest\x1a+.relation_ext.v1.DeleteAudienceListResponse\x12j\n" +
+	"\x11ListAudienceLists\x12).relation_ext.v1.ListAudienceListsRequest\x1a*.relation_ext.v1.ListAudienceListsResponse\x12g\n" +
+	"\x12AddAudienceMembers\x12'.relation_ext.v1.AudienceMembersRequest\x1a(.relation_ext.v1.AudienceMembersResponse\x12j\n" +
+	"\x15RemoveAudienceMembers\x12'.relation_ext.v1.AudienceMembersRequest\x1a(.relation_ext.v1.AudienceMembersResponse\x12p\n" +
+	"\x13ListAudienceMembers\x12+.relation_ext.v1.ListAudienceMembersRequest\x1a,.relation_ext.v1.ListAudienceMembersResponse\x12g\n" +
+	"\x10IsAudienceMember\x12(.relation_ext.v1.IsAudienceMemberRequest\x1a).relation_ext.v1.IsAudienceMemberResponse\x12s\n" +
+	"\x14GetFollowSourceStats\x12,.relation_ext.v1.GetFollowSourceStatsRequest\x1a-.relation_ext.v1.GetFollowSourceStatsResponse\x12j\n" +
+	"\x11GetFollowerGrowth\x12).relation_ext.v1.GetFollowerGrowthRequest\x1a*.relation_ext.v1.GetFollowerGrowthResponseB@Z>pinstack-relation-service/gen/go/relation_ext/v1;relationextv1b\x06proto3"
+
+var (
+	file_relation_ext_v1_relation_ext_proto_rawDescOnce sync.Once
+	file_relation_ext_v1_relation_ext_proto_rawDescData []byte
+)
+
+func file_relation_ext_v1_relation_ext_proto_rawDescGZIP() []byte {
+	file_relation_ext_v1_relation_ext_proto_rawDescOnce.Do(func() {
+		file_relation_ext_v1_relation_ext_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_relation_ext_v1_relation_ext_proto_rawDesc), len(file_relation_ext_v1_relation_ext_proto_rawDesc)))
+	})
+	return file_relation_ext_v1_relation_ext_proto_rawDescData
+}
+
+var file_relation_ext_v1_relation_ext_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_relation_ext_v1_relation_ext_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_relation_ext_v1_relation_ext_proto_goTypes = []any{
+	(TargetType)(0),                      // 0: relation_ext.v1.TargetType
+	(FollowListSort)(0),                  // 1: relation_ext.v1.FollowListSort
+	(FollowerGrowthGranularity)(0),       // 2: relation_ext.v1.FollowerGrowthGranularity
+	(RelationAction)(0),                  // 3: relation_ext.v1.RelationAction
+	(RelationReason)(0),                  // 4: relation_ext.v1.RelationReason
+	(BulkOutcome)(0),                     // 5: relation_ext.v1.BulkOutcome
+	(*User)(nil),                         // 6: relation_ext.v1.User
+	(*GetRelationshipsRequest)(nil),      // 7: relation_ext.v1.GetRelationshipsRequest
+	(*Relationship)(nil),                 // 8: relation_ext.v1.Relationship
+	(*GetRelationshipsResponse)(nil),     // 9: relation_ext.v1.GetRelationshipsResponse
+	(*GetMutualFollowsRequest)(nil),      // 10: relation_ext.v1.GetMutualFollowsRequest
+	(*GetMutualFollowsResponse)(nil),     // 11: relation_ext.v1.GetMutualFollowsResponse
+	(*IsMutualRequest)(nil),              // 12: relation_ext.v1.IsMutualRequest
+	(*IsMutualResponse)(nil),             // 13: relation_ext.v1.IsMutualResponse
+	(*GetFollowersYouKnowRequest)(nil),   // 14: relation_ext.v1.GetFollowersYouKnowRequest
+	(*GetFollowersYouKnowResponse)(nil),  // 15: relation_ext.v1.GetFollowersYouKnowResponse
+	(*GetSuggestionsRequest)(nil),        // 16: relation_ext.v1.GetSuggestionsRequest
+	(*Suggestion)(nil),                   // 17: relation_ext.v1.Suggestion
+	(*GetSuggestionsResponse)(nil),       // 18: relation_ext.v1.GetSuggestionsResponse
+	(*FollowTargetRequest)(nil),          // 19: relation_ext.v1.FollowTargetRequest
+	(*FollowTargetResponse)(nil),         // 20: relation_ext.v1.FollowTargetResponse
+	(*UnfollowTargetRequest)(nil),        // 21: relation_ext.v1.UnfollowTargetRequest
+	(*UnfollowTargetResponse)(nil),       // 22: relation_ext.v1.UnfollowTargetResponse
+	(*ListFollowedTargetsRequest)(nil),   // 23: relation_ext.v1.ListFollowedTargetsRequest
+	(*ListFollowedTargetsResponse)(nil),  // 24: relation_ext.v1.ListFollowedTargetsResponse
+	(*StreamFollowerIDsRequest)(nil),     // 25: relation_ext.v1.StreamFollowerIDsRequest
+	(*StreamFollowerIDsResponse)(nil),    // 26: relation_ext.v1.StreamFollowerIDsResponse
+	(*FollowListOptions)(nil),            // 27: relation_ext.v1.FollowListOptions
+	(*ListFollowsRequest)(nil),           // 28: relation_ext.v1.ListFollowsRequest
+	(*ListFollowsResponse)(nil),          // 29: relation_ext.v1.ListFollowsResponse
+	(*SearchFollowsRequest)(nil),         // 30: relation_ext.v1.SearchFollowsRequest
+	(*ProfileMatch)(nil),                 // 31: relation_ext.v1.ProfileMatch
+	(*SearchFollowsResponse)(nil),        // 32: relation_ext.v1.SearchFollowsResponse
+	(*GetRelationHistoryRequest)(nil),    // 33: relation_ext.v1.GetRelationHistoryRequest
+	(*RelationHistoryEntry)(nil),         // 34: relation_ext.v1.RelationHistoryEntry
+	(*GetRelationHistoryResponse)(nil),   // 35: relation_ext.v1.GetRelationHistoryResponse
+	(*RemoveFollowerRequest)(nil),        // 36: relation_ext.v1.RemoveFollowerRequest
+	(*RemoveFollowerResponse)(nil),       // 37: relation_ext.v1.RemoveFollowerResponse
+	(*RemoveFollowersRequest)(nil),       // 38: relation_ext.v1.RemoveFollowersRequest
+	(*RemoveFollowersResponse)(nil),      // 39: relation_ext.v1.RemoveFollowersResponse
+	(*BulkFollowRequest)(nil),            // 40: relation_ext.v1.BulkFollowRequest
+	(*BulkFollowResult)(nil),             // 41: relation_ext.v1.BulkFollowResult
+	(*BulkFollowResponse)(nil),           // 42: relation_ext.v1.BulkFollowResponse
+	(*Mute)(nil),                         // 43: relation_ext.v1.Mute
+	(*MuteRequest)(nil),                  // 44: relation_ext.v1.MuteRequest
+	(*MuteResponse)(nil),                 // 45: relation_ext.v1.MuteResponse
+	(*UnmuteRequest)(nil),                // 46: relation_ext.v1.UnmuteRequest
+	(*UnmuteResponse)(nil),               // 47: relation_ext.v1.UnmuteResponse
+	(*ListMutedRequest)(nil),             // 48: relation_ext.v1.ListMutedRequest
+	(*ListMutedResponse)(nil),            // 49: relation_ext.v1.ListMutedResponse
+	(*FilterMutedRequest)(nil),           // 50: relation_ext.v1.FilterMutedRequest
+	(*FilterMutedResponse)(nil),          // 51: relation_ext.v1.FilterMutedResponse
+	(*AudienceList)(nil),                 // 52: relation_ext.v1.AudienceList
+	(*CreateAudienceListRequest)(nil),    // 53: relation_ext.v1.CreateAudienceListRequest
+	(*CreateAudienceListResponse)(nil),   // 54: relation_ext.v1.CreateAudienceListResponse
+	(*RenameAudienceListRequest)(nil),    // 55: relation_ext.v1.RenameAudienceListRequest
+	(*RenameAudienceListResponse)(nil),   // 56: relation_ext.v1.RenameAudienceListResponse
+	(*DeleteAudienceListRequest)(nil),    // 57: relation_ext.v1.DeleteAudienceListRequest
+	(*DeleteAudienceListResponse)(nil),   // 58: relation_ext.v1.DeleteAudienceListResponse
+	(*ListAudienceListsRequest)(nil),     // 59: relation_ext.v1.ListAudienceListsRequest
+	(*ListAudienceListsResponse)(nil),    // 60: relation_ext.v1.ListAudienceListsResponse
+	(*AudienceMembersRequest)(nil),       // 61: relation_ext.v1.AudienceMembersRequest
+	(*AudienceMembersResponse)(nil),      // 62: relation_ext.v1.AudienceMembersResponse
+	(*ListAudienceMembersRequest)(nil),   // 63: relation_ext.v1.ListAudienceMembersRequest
+	(*ListAudienceMembersResponse)(nil),  // 64: relation_ext.v1.ListAudienceMembersResponse
+	(*IsAudienceMemberRequest)(nil),      // 65: relation_ext.v1.IsAudienceMemberRequest
+	(*IsAudienceMemberResponse)(nil),     // 66: relation_ext.v1.IsAudienceMemberResponse
+	(*FollowSourceStat)(nil),             // 67: relation_ext.v1.FollowSourceStat
+	(*GetFollowSourceStatsRequest)(nil),  // 68: relation_ext.v1.GetFollowSourceStatsRequest
+	(*GetFollowSourceStatsResponse)(nil), // 69: relation_ext.v1.GetFollowSourceStatsResponse
+	(*FollowerGrowthBucket)(nil),         // 70: relation_ext.v1.FollowerGrowthBucket
+	(*GetFollowerGrowthRequest)(nil),     // 71: relation_ext.v1.GetFollowerGrowthRequest
+	(*GetFollowerGrowthResponse)(nil),    // 72: relation_ext.v1.GetFollowerGrowthResponse
+	(*timestamppb.Timestamp)(nil),        // 73: google.protobuf.Timestamp
+}
+var file_relation_ext_v1_relation_ext_proto_depIdxs = []int32{
+	8,  // 0: relation_ext.v1.GetRelationshipsResponse.relationships:type_name -> relation_ext.v1.Relationship
+	6,  // 1: relation_ext.v1.GetMutualFollowsResponse.users:type_name -> relation_ext.v1.User
+	6,  // 2: relation_ext.v1.GetFollowersYouKnowResponse.users:type_name -> relation_ext.v1.User
+	6,  // 3: relation_ext.v1.Suggestion.user:type_name -> relation_ext.v1.User
+	17, // 4: relation_ext.v1.GetSuggestionsResponse.suggestions:type_name -> relation_ext.v1.Suggestion
+	0,  // 5: relation_ext.v1.FollowTargetRequest.target_type:type_name -> relation_ext.v1.TargetType
+	0,  // 6: relation_ext.v1.UnfollowTargetRequest.target_type:type_name -> relation_ext.v1.TargetType
+	0,  // 7: relation_ext.v1.ListFollowedTargetsRequest.target_type:type_name -> relation_ext.v1.TargetType
+	1,  // 8: relation_ext.v1.FollowListOptions.sort:type_name -> relation_ext.v1.FollowListSort
+	73, // 9: relation_ext.v1.FollowListOptions.since:type_name -> google.protobuf.Timestamp
+	73, // 10: relation_ext.v1.FollowListOptions.until:type_name -> google.protobuf.Timestamp
+	27, // 11: relation_ext.v1.ListFollowsRequest.options:type_name -> relation_ext.v1.FollowListOptions
+	6,  // 12: relation_ext.v1.ListFollowsResponse.users:type_name -> relation_ext.v1.User
+	6,  // 13: relation_ext.v1.ProfileMatch.user:type_name -> relation_ext.v1.User
+	31, // 14: relation_ext.v1.SearchFollowsResponse.matches:type_name -> relation_ext.v1.ProfileMatch
+	3,  // 15: relation_ext.v1.RelationHistoryEntry.action:type_name -> relation_ext.v1.RelationAction
+	4,  // 16: relation_ext.v1.RelationHistoryEntry.reason:type_name -> relation_ext.v1.RelationReason
+	73, // 17: relation_ext.v1.RelationHistoryEntry.created_at:type_name -> google.protobuf.Timestamp
+	34, // 18: relation_ext.v1.GetRelationHistoryResponse.entries:type_name -> relation_ext.v1.RelationHistoryEntry
+	5,  // 19: relation_ext.v1.BulkFollowResult.outcome:type_name -> relation_ext.v1.BulkOutcome
+	41, // 20: relation_ext.v1.BulkFollowResponse.results:type_name -> relation_ext.v1.BulkFollowResult
+	73, // 21: relation_ext.v1.Mute.created_at:type_name -> google.protobuf.Timestamp
+	73, // 22: relation_ext.v1.Mute.expires_at:type_name -> google.protobuf.Timestamp
+	73, // 23: relation_ext.v1.MuteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	43, // 24: relation_ext.v1.MuteResponse.mute:type_name -> relation_ext.v1.Mute
+	43, // 25: relation_ext.v1.ListMutedResponse.mutes:type_name -> relation_ext.v1.Mute
+	73, // 26: relation_ext.v1.AudienceList.created_at:type_name -> google.protobuf.Timestamp
+	73, // 27: relation_ext.v1.AudienceList.updated_at:type_name -> google.protobuf.Timestamp
+	52, // 28: relation_ext.v1.CreateAudienceListResponse.list:type_name -> relation_ext.v1.AudienceList
+	52, // 29: relation_ext.v1.RenameAudienceListResponse.list:type_name -> relation_ext.v1.AudienceList
+	52, // 30: relation_ext.v1.ListAudienceListsResponse.lists:type_name -> relation_ext.v1.AudienceList
+	73, // 31: relation_ext.v1.FollowSourceStat.day:type_name -> google.protobuf.Timestamp
+	73, // 32: relation_ext.v1.GetFollowSourceStatsRequest.from:type_name -> google.protobuf.Timestamp
+	73, // 33: relation_ext.v1.GetFollowSourceStatsRequest.to:type_name -> google.protobuf.Timestamp
+	67, // 34: relation_ext.v1.GetFollowSourceStatsResponse.stats:type_name -> relation_ext.v1.FollowSourceStat
+	73, // 35: relation_ext.v1.FollowerGrowthBucket.start:type_name -> google.protobuf.Timestamp
+	73, // 36: relation_ext.v1.GetFollowerGrowthRequest.from:type_name -> google.protobuf.Timestamp
+	73, // 37: relation_ext.v1.GetFollowerGrowthRequest.to:type_name -> google.protobuf.Timestamp
+	2,  // 38: relation_ext.v1.GetFollowerGrowthRequest.granularity:type_name -> relation_ext.v1.FollowerGrowthGranularity
+	70, // 39: relation_ext.v1.GetFollowerGrowthResponse.buckets:type_name -> relation_ext.v1.FollowerGrowthBucket
+	7,  // 40: relation_ext.v1.RelationExtService.GetRelationships:input_type -> relation_ext.v1.GetRelationshipsRequest
+	10, // 41: relation_ext.v1.RelationExtService.GetMutualFollows:input_type -> relation_ext.v1.GetMutualFollowsRequest
+	12, // 42: relation_ext.v1.RelationExtService.IsMutual:input_type -> relation_ext.v1.IsMutualRequest
+	14, // 43: relation_ext.v1.RelationExtService.GetFollowersYouKnow:input_type -> relation_ext.v1.GetFollowersYouKnowRequest
+	16, // 44: relation_ext.v1.RelationExtService.GetSuggestions:input_type -> relation_ext.v1.GetSuggestionsRequest
+	19, // 45: relation_ext.v1.RelationExtService.FollowTarget:input_type -> relation_ext.v1.FollowTargetRequest
+	21, // 46: relation_ext.v1.RelationExtService.UnfollowTarget:input_type -> relation_ext.v1.UnfollowTargetRequest
+	23, // 47: relation_ext.v1.RelationExtService.ListFollowedTargets:input_type -> relation_ext.v1.ListFollowedTargetsRequest
+	25, // 48: relation_ext.v1.RelationExtService.StreamFollowerIDs:input_type -> relation_ext.v1.StreamFollowerIDsRequest
+	28, // 49: relation_ext.v1.RelationExtService.ListFollowers:input_type -> relation_ext.v1.ListFollowsRequest
+	28, // 50: relation_ext.v1.RelationExtService.ListFollowees:input_type -> relation_ext.v1.ListFollowsRequest
+	30, // 51: relation_ext.v1.RelationExtService.SearchFollowers:input_type -> relation_ext.v1.SearchFollowsRequest
+	30, // 52: relation_ext.v1.RelationExtService.SearchFollowees:input_type -> relation_ext.v1.SearchFollowsRequest
+	33, // 53: relation_ext.v1.RelationExtService.GetRelationHistory:input_type -> relation_ext.v1.GetRelationHistoryRequest
+	36, // 54: relation_ext.v1.RelationExtService.RemoveFollower:input_type -> relation_ext.v1.RemoveFollowerRequest
+	38, // 55: relation_ext.v1.RelationExtService.RemoveFollowers:input_type -> relation_ext.v1.RemoveFollowersRequest
+	40, // 56: relation_ext.v1.RelationExtService.BulkFollow:input_type -> relation_ext.v1.BulkFollowRequest
+	40, // 57: relation_ext.v1.RelationExtService.BulkUnfollow:input_type -> relation_ext.v1.BulkFollowRequest
+	44, // 58: relation_ext.v1.RelationExtService.Mute:input_type -> relation_ext.v1.MuteRequest
+	46, // 59: relation_ext.v1.RelationExtService.Unmute:input_type -> relation_ext.v1.UnmuteRequest
+	48, // 60: relation_ext.v1.RelationExtService.ListMuted:input_type -> relation_ext.v1.ListMutedRequest
+	50, // 61: relation_ext.v1.RelationExtService.FilterMuted:input_type -> relation_ext.v1.FilterMutedRequest
+	53, // 62: relation_ext.v1.RelationExtService.CreateAudienceList:input_type -> relation_ext.v1.CreateAudienceListRequest
+	55, // 63: relation_ext.v1.RelationExtService.RenameAudienceList:input_type -> relation_ext.v1.RenameAudienceListRequest
+	57, // 64: relation_ext.v1.RelationExtService.DeleteAudienceList:input_type -> relation_ext.v1.DeleteAudienceListRequest
+	59, // 65: relation_ext.v1.RelationExtService.ListAudienceLists:input_type -> relation_ext.v1.ListAudienceListsRequest
+	61, // 66: relation_ext.v1.RelationExtService.AddAudienceMembers:input_type -> relation_ext.v1.AudienceMembersRequest
+	61, // 67: relation_ext.v1.RelationExtService.RemoveAudienceMembers:input_type -> relation_ext.v1.AudienceMembersRequest
+	63, // 68: relation_ext.v1.RelationExtService.ListAudienceMembers:input_type -> relation_ext.v1.ListAudienceMembersRequest
+	65, // 69: relation_ext.v1.RelationExtService.IsAudienceMember:input_type -> relation_ext.v1.IsAudienceMemberRequest
+	68, // 70: relation_ext.v1.RelationExtService.GetFollowSourceStats:input_type -> relation_ext.v1.GetFollowSourceStatsRequest
+	71, // 71: relation_ext.v1.RelationExtService.GetFollowerGrowth:input_type -> relation_ext.v1.GetFollowerGrowthRequest
+	9,  // 72: relation_ext.v1.RelationExtService.GetRelationships:output_type -> relation_ext.v1.GetRelationshipsResponse
+	11, // 73: relation_ext.v1.RelationExtService.GetMutualFollows:output_type -> relation_ext.v1.GetMutualFollowsResponse
+	13, // 74: relation_ext.v1.RelationExtService.IsMutual:output_type -> relation_ext.v1.IsMutualResponse
+	15, // 75: relation_ext.v1.RelationExtService.GetFollowersYouKnow:output_type -> relation_ext.v1.GetFollowersYouKnowResponse
+	18, // 76: relation_ext.v1.RelationExtService.GetSuggestions:output_type -> relation_ext.v1.GetSuggestionsResponse
+	20, // 77: relation_ext.v1.RelationExtService.FollowTarget:output_type -> relation_ext.v1.FollowTargetResponse
+	22, // 78: relation_ext.v1.RelationExtService.UnfollowTarget:output_type -> relation_ext.v1.UnfollowTargetResponse
+	24, // 79: relation_ext.v1.RelationExtService.ListFollowedTargets:output_type -> relation_ext.v1.ListFollowedTargetsResponse
+	26, // 80: relation_ext.v1.RelationExtService.StreamFollowerIDs:output_type -> relation_ext.v1.StreamFollowerIDsResponse
+	29, // 81: relation_ext.v1.RelationExtService.ListFollowers:output_type -> relation_ext.v1.ListFollowsResponse
+	29, // 82: relation_ext.v1.RelationExtService.ListFollowees:output_type -> relation_ext.v1.ListFollowsResponse
+	32, // 83: relation_ext.v1.RelationExtService.SearchFollowers:output_type -> relation_ext.v1.SearchFollowsResponse
+	32, // 84: relation_ext.v1.RelationExtService.SearchFollowees:output_type -> relation_ext.v1.SearchFollowsResponse
+	35, // 85: relation_ext.v1.RelationExtService.GetRelationHistory:output_type -> relation_ext.v1.GetRelationHistoryResponse
+	37, // 86: relation_ext.v1.RelationExtService.RemoveFollower:output_type -> relation_ext.v1.RemoveFollowerResponse
+	39, // 87: relation_ext.v1.RelationExtService.RemoveFollowers:output_type -> relation_ext.v1.RemoveFollowersResponse
+	42, // 88: relation_ext.v1.RelationExtService.BulkFollow:output_type -> relation_ext.v1.BulkFollowResponse
+	42, // 89: relation_ext.v1.RelationExtService.BulkUnfollow:output_type -> relation_ext.v1.BulkFollowResponse
+	45, // 90: relation_ext.v1.RelationExtService.Mute:output_type -> relation_ext.v1.MuteResponse
+	47, // 91: relation_ext.v1.RelationExtService.Unmute:output_type -> relation_ext.v1.UnmuteResponse
+	49, // 92: relation_ext.v1.RelationExtService.ListMuted:output_type -> relation_ext.v1.ListMutedResponse
+	51, // 93: relation_ext.v1.RelationExtService.FilterMuted:output_type -> relation_ext.v1.FilterMutedResponse
+	54, // 94: relation_ext.v1.RelationExtService.CreateAudienceList:output_type -> relation_ext.v1.CreateAudienceListResponse
+	56, // 95: relation_ext.v1.RelationExtService.RenameAudienceList:output_type -> relation_ext.v1.RenameAudienceListResponse
+	58, // 96: relation_ext.v1.RelationExtService.DeleteAudienceList:output_type -> relation_ext.v1.DeleteAudienceListResponse
+	60, // 97: relation_ext.v1.RelationExtService.ListAudienceLists:output_type -> relation_ext.v1.ListAudienceListsResponse
+	62, // 98: relation_ext.v1.RelationExtService.AddAudienceMembers:output_type -> relation_ext.v1.AudienceMembersResponse
+	62, // 99: relation_ext.v1.RelationExtService.RemoveAudienceMembers:output_type -> relation_ext.v1.AudienceMembersResponse
+	64, // 100: relation_ext.v1.RelationExtService.ListAudienceMembers:output_type -> relation_ext.v1.ListAudienceMembersResponse
+	66, // 101: relation_ext.v1.RelationExtService.IsAudienceMember:output_type -> relation_ext.v1.IsAudienceMemberResponse
+	69, // 102: relation_ext.v1.RelationExtService.GetFollowSourceStats:output_type -> relation_ext.v1.GetFollowSourceStatsResponse
+	72, // 103: relation_ext.v1.RelationExtService.GetFollowerGrowth:output_type -> relation_ext.v1.GetFollowerGrowthResponse
+	72, // [72:104] is the sub-list for method output_type
+	40, // [40:72] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
+}
+
+func init() { file_relation_ext_v1_relation_ext_proto_init() }
+func file_relation_ext_v1_relation_ext_proto_init() {
+	if File_relation_ext_v1_relation_ext_proto != nil {
+		return
+	}
+	file_relation_ext_v1_relation_ext_proto_msgTypes[0].OneofWrappers = []any{}
+	file_relation_ext_v1_relation_ext_proto_msgTypes[25].OneofWrappers = []any{}
+	file_relation_ext_v1_relation_ext_proto_msgTypes[27].OneofWrappers = []any{}
+	file_relation_ext_v1_relation_ext_proto_msgTypes[28].OneofWrappers = []any{}
+	file_relation_ext_v1_relation_ext_proto_msgTypes[37].OneofWrappers = []any{}
+	file_relation_ext_v1_relation_ext_proto_msgTypes[38].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_relation_ext_v1_relation_ext_proto_rawDesc), len(file_relation_ext_v1_relation_ext_proto_rawDesc)),
+			NumEnums:      6,
+			NumMessages:   67,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_relation_ext_v1_relation_ext_proto_goTypes,
+		DependencyIndexes: file_relation_ext_v1_relation_ext_proto_depIdxs,
+		EnumInfos:         file_relation_ext_v1_relation_ext_proto_enumTypes,
+		MessageInfos:      file_relation_ext_v1_relation_ext_proto_msgTypes,
+	}.Build()
+	File_relation_ext_v1_relation_ext_proto = out.File
+	file_relation_ext_v1_relation_ext_proto_goTypes = nil
+	file_relation_ext_v1_relation_ext_proto_depIdxs = nil
+}
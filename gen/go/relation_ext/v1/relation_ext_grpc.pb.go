@@ -0,0 +1,1381 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: relation_ext/v1/relation_ext.proto
+
+package relationextv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RelationExtService_GetRelationships_FullMethodName      = "/relation_ext.v1.RelationExtService/GetRelationships"
+	RelationExtService_GetMutualFollows_FullMethodName      = "/relation_ext.v1.RelationExtService/GetMutualFollows"
+	RelationExtService_IsMutual_FullMethodName              = "/relation_ext.v1.RelationExtService/IsMutual"
+	RelationExtService_GetFollowersYouKnow_FullMethodName   = "/relation_ext.v1.RelationExtService/GetFollowersYouKnow"
+	RelationExtService_GetSuggestions_FullMethodName        = "/relation_ext.v1.RelationExtService/GetSuggestions"
+	RelationExtService_FollowTarget_FullMethodName          = "/relation_ext.v1.RelationExtService/FollowTarget"
+	RelationExtService_UnfollowTarget_FullMethodName        = "/relation_ext.v1.RelationExtService/UnfollowTarget"
+	RelationExtService_ListFollowedTargets_FullMethodName   = "/relation_ext.v1.RelationExtService/ListFollowedTargets"
+	RelationExtService_StreamFollowerIDs_FullMethodName     = "/relation_ext.v1.RelationExtService/StreamFollowerIDs"
+	RelationExtService_ListFollowers_FullMethodName         = "/relation_ext.v1.RelationExtService/ListFollowers"
+	RelationExtService_ListFollowees_FullMethodName         = "/relation_ext.v1.RelationExtService/ListFollowees"
+	RelationExtService_SearchFollowers_FullMethodName       = "/relation_ext.v1.RelationExtService/SearchFollowers"
+	RelationExtService_SearchFollowees_FullMethodName       = "/relation_ext.v1.RelationExtService/SearchFollowees"
+	RelationExtService_GetRelationHistory_FullMethodName    = "/relation_ext.v1.RelationExtService/GetRelationHistory"
+	RelationExtService_RemoveFollower_FullMethodName        = "/relation_ext.v1.RelationExtService/RemoveFollower"
+	RelationExtService_RemoveFollowers_FullMethodName       = "/relation_ext.v1.RelationExtService/RemoveFollowers"
+	RelationExtService_BulkFollow_FullMethodName            = "/relation_ext.v1.RelationExtService/BulkFollow"
+	RelationExtService_BulkUnfollow_FullMethodName          = "/relation_ext.v1.RelationExtService/BulkUnfollow"
+	RelationExtService_Mute_FullMethodName                  = "/relation_ext.v1.RelationExtService/Mute"
+	RelationExtService_Unmute_FullMethodName                = "/relation_ext.v1.RelationExtService/Unmute"
+	RelationExtService_ListMuted_FullMethodName             = "/relation_ext.v1.RelationExtService/ListMuted"
+	RelationExtService_FilterMuted_FullMethodName           = "/relation_ext.v1.RelationExtService/FilterMuted"
+	RelationExtService_CreateAudienceList_FullMethodName    = "/relation_ext.v1.RelationExtService/CreateAudienceList"
+	RelationExtService_RenameAudienceList_FullMethodName    = "/relation_ext.v1.RelationExtService/RenameAudienceList"
+	RelationExtService_DeleteAudienceList_FullMethodName    = "/relation_ext.v1.RelationExtService/DeleteAudienceList"
+	RelationExtService_ListAudienceLists_FullMethodName     = "/relation_ext.v1.RelationExtService/ListAudienceLists"
+	RelationExtService_AddAudienceMembers_FullMethodName    = "/relation_ext.v1.RelationExtService/AddAudienceMembers"
+	RelationExtService_RemoveAudienceMembers_FullMethodName = "/relation_ext.v1.RelationExtService/RemoveAudienceMembers"
+	RelationExtService_ListAudienceMembers_FullMethodName   = "/relation_ext.v1.RelationExtService/ListAudienceMembers"
+	RelationExtService_IsAudienceMember_FullMethodName      = "/relation_ext.v1.RelationExtService/IsAudienceMember"
+	RelationExtService_GetFollowSourceStats_FullMethodName  = "/relation_ext.v1.RelationExtService/GetFollowSourceStats"
+	RelationExtService_GetFollowerGrowth_FullMethodName     = "/relation_ext.v1.RelationExtService/GetFollowerGrowth"
+)
+
+// RelationExtServiceClient is the client API for RelationExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RelationExtService holds the relation RPCs that are not part of the shared
+// pinstack-proto-definitions contract yet. It is served on the same port as relation.v1.RelationService.
+type RelationExtServiceClient interface {
+	// GetRelationships returns the relationship between the viewer and every target in one call
+	GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error)
+	// GetMutualFollows lists the users that follow user_id and are followed back, newest mutual first
+	GetMutualFollows(ctx context.Context, in *GetMutualFollowsRequest, opts ...grpc.CallOption) (*GetMutualFollowsResponse, error)
+	// IsMutual reports whether the two users follow each other
+	IsMutual(ctx context.Context, in *IsMutualRequest, opts ...grpc.CallOption) (*IsMutualResponse, error)
+	// GetFollowersYouKnow returns followers of target_id that viewer_id follows, with their total count
+	GetFollowersYouKnow(ctx context.Context, in *GetFollowersYouKnowRequest, opts ...grpc.CallOption) (*GetFollowersYouKnowResponse, error)
+	// GetSuggestions returns who-to-follow candidates for user_id ranked by friends-of-friends reach
+	GetSuggestions(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption) (*GetSuggestionsResponse, error)
+	// FollowTarget follows a user, board or tag. User targets behave exactly like relation.v1 Follow
+	FollowTarget(ctx context.Context, in *FollowTargetRequest, opts ...grpc.CallOption) (*FollowTargetResponse, error)
+	// UnfollowTarget removes a follow of any target type
+	UnfollowTarget(ctx context.Context, in *UnfollowTargetRequest, opts ...grpc.CallOption) (*UnfollowTargetResponse, error)
+	// ListFollowedTargets lists the ids of the targets of one type that follower_id follows, newest first
+	ListFollowedTargets(ctx context.Context, in *ListFollowedTargetsRequest, opts ...grpc.CallOption) (*ListFollowedTargetsResponse, error)
+	// StreamFollowerIDs sends every follower id of user_id in ascending chunks, without user enrichment.
+	// A broken stream is resumed by passing the next_cursor of the last received chunk as cursor
+	StreamFollowerIDs(ctx context.Context, in *StreamFollowerIDsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamFollowerIDsResponse], error)
+	// ListFollowers is relation.v1 GetFollowers with sorting, a follow time window and filters
+	ListFollowers(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error)
+	// ListFollowees is relation.v1 GetFollowees with sorting, a follow time window and filters
+	ListFollowees(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error)
+	// SearchFollowers finds followers of user_id by username or full name prefix and trigram similarity,
+	// best match first. Followers whose profile has not been synced locally yet are not found
+	SearchFollowers(ctx context.Context, in *SearchFollowsRequest, opts ...grpc.CallOption) (*SearchFollowsResponse, error)
+	// SearchFollowees is SearchFollowers over the users user_id follows
+	SearchFollowees(ctx context.Context, in *SearchFollowsRequest, opts ...grpc.CallOption) (*SearchFollowsResponse, error)
+	// GetRelationHistory lists the follow, unfollow, block and removal records involving user_id,
+	// newest first. With other_user_id set only the records between the two users are returned
+	GetRelationHistory(ctx context.Context, in *GetRelationHistoryRequest, opts ...grpc.CallOption) (*GetRelationHistoryResponse, error)
+	// RemoveFollower ends the follow of follower_id on owner_id at the request of owner_id.
+	// It publishes follower_removed instead of follow_deleted so the follower is not notified
+	RemoveFollower(ctx context.Context, in *RemoveFollowerRequest, opts ...grpc.CallOption) (*RemoveFollowerResponse, error)
+	// RemoveFollowers removes many followers of owner_id in one transaction, skipping users that do not follow them
+	RemoveFollowers(ctx context.Context, in *RemoveFollowersRequest, opts ...grpc.CallOption) (*RemoveFollowersResponse, error)
+	// BulkFollow follows many users in one transaction and reports the outcome for each of them
+	BulkFollow(ctx context.Context, in *BulkFollowRequest, opts ...grpc.CallOption) (*BulkFollowResponse, error)
+	// BulkUnfollow unfollows many users in one transaction and reports the outcome for each of them
+	BulkUnfollow(ctx context.Context, in *BulkFollowRequest, opts ...grpc.CallOption) (*BulkFollowResponse, error)
+	// Mute hides the pins of followee_id from follower_id without unfollowing, until expires_at
+	// when it is set. Muting again replaces the expiry
+	Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error)
+	// Unmute ends an active mute
+	Unmute(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption) (*UnmuteResponse, error)
+	// ListMuted lists the active mutes of user_id, newest first
+	ListMuted(ctx context.Context, in *ListMutedRequest, opts ...grpc.CallOption) (*ListMutedResponse, error)
+	// FilterMuted returns the authors of author_ids that viewer_id has muted, for feeds to drop their pins
+	FilterMuted(ctx context.Context, in *FilterMutedRequest, opts ...grpc.CallOption) (*FilterMutedResponse, error)
+	CreateAudienceList(ctx context.Context, in *CreateAudienceListRequest, opts ...grpc.CallOption) (*CreateAudienceListResponse, error)
+	RenameAudienceList(ctx context.Context, in *RenameAudienceListRequest, opts ...grpc.CallOption) (*RenameAudienceListResponse, error)
+	// DeleteAudienceList removes the list together with its members
+	DeleteAudienceList(ctx context.Context, in *DeleteAudienceListRequest, opts ...grpc.CallOption) (*DeleteAudienceListResponse, error)
+	ListAudienceLists(ctx context.Context, in *ListAudienceListsRequest, opts ...grpc.CallOption) (*ListAudienceListsResponse, error)
+	// AddAudienceMembers adds users to a list, which may require them to follow the owner
+	AddAudienceMembers(ctx context.Context, in *AudienceMembersRequest, opts ...grpc.CallOption) (*AudienceMembersResponse, error)
+	RemoveAudienceMembers(ctx context.Context, in *AudienceMembersRequest, opts ...grpc.CallOption) (*AudienceMembersResponse, error)
+	// ListAudienceMembers lists the members of a list, most recently added first
+	ListAudienceMembers(ctx context.Context, in *ListAudienceMembersRequest, opts ...grpc.CallOption) (*ListAudienceMembersResponse, error)
+	// IsAudienceMember tells the post service whether a user may see content shared with a list
+	IsAudienceMember(ctx context.Context, in *IsAudienceMemberRequest, opts ...grpc.CallOption) (*IsAudienceMemberResponse, error)
+	// GetFollowSourceStats returns the daily user follows per source, for service accounts only.
+	// Follows made without a follow-source header are counted under "unknown".
+	GetFollowSourceStats(ctx context.Context, in *GetFollowSourceStatsRequest, opts ...grpc.CallOption) (*GetFollowSourceStatsResponse, error)
+	// GetFollowerGrowth returns the followers a user gained and lost over time, with the
+	// follower count at the end of every bucket. Growth is recounted periodically, so the
+	// latest changes can show up a few minutes late.
+	GetFollowerGrowth(ctx context.Context, in *GetFollowerGrowthRequest, opts ...grpc.CallOption) (*GetFollowerGrowthResponse, error)
+}
+
+type relationExtServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelationExtServiceClient(cc grpc.ClientConnInterface) RelationExtServiceClient {
+	return &relationExtServiceClient{cc}
+}
+
+func (c *relationExtServiceClient) GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelationshipsResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_GetRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) GetMutualFollows(ctx context.Context, in *GetMutualFollowsRequest, opts ...grpc.CallOption) (*GetMutualFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMutualFollowsResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_GetMutualFollows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) IsMutual(ctx context.Context, in *IsMutualRequest, opts ...grpc.CallOption) (*IsMutualResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsMutualResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_IsMutual_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) GetFollowersYouKnow(ctx context.Context, in *GetFollowersYouKnowRequest, opts ...grpc.CallOption) (*GetFollowersYouKnowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowersYouKnowResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_GetFollowersYouKnow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) GetSuggestions(ctx context.Context, in *GetSuggestionsRequest, opts ...grpc.CallOption) (*GetSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSuggestionsResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_GetSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) FollowTarget(ctx context.Context, in *FollowTargetRequest, opts ...grpc.CallOption) (*FollowTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowTargetResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_FollowTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) UnfollowTarget(ctx context.Context, in *UnfollowTargetRequest, opts ...grpc.CallOption) (*UnfollowTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowTargetResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_UnfollowTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) ListFollowedTargets(ctx context.Context, in *ListFollowedTargetsRequest, opts ...grpc.CallOption) (*ListFollowedTargetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowedTargetsResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_ListFollowedTargets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) StreamFollowerIDs(ctx context.Context, in *StreamFollowerIDsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamFollowerIDsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RelationExtService_ServiceDesc.Streams[0], RelationExtService_StreamFollowerIDs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamFollowerIDsRequest, StreamFollowerIDsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RelationExtService_StreamFollowerIDsClient = grpc.ServerStreamingClient[StreamFollowerIDsResponse]
+
+func (c *relationExtServiceClient) ListFollowers(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowsResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_ListFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) ListFollowees(ctx context.Context, in *ListFollowsRequest, opts ...grpc.CallOption) (*ListFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowsResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_ListFollowees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) SearchFollowers(ctx context.Context, in *SearchFollowsRequest, opts ...grpc.CallOption) (*SearchFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchFollowsResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_SearchFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) SearchFollowees(ctx context.Context, in *SearchFollowsRequest, opts ...grpc.CallOption) (*SearchFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchFollowsResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_SearchFollowees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) GetRelationHistory(ctx context.Context, in *GetRelationHistoryRequest, opts ...grpc.CallOption) (*GetRelationHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelationHistoryResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_GetRelationHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) RemoveFollower(ctx context.Context, in *RemoveFollowerRequest, opts ...grpc.CallOption) (*RemoveFollowerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFollowerResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_RemoveFollower_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) RemoveFollowers(ctx context.Context, in *RemoveFollowersRequest, opts ...grpc.CallOption) (*RemoveFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFollowersResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_RemoveFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) BulkFollow(ctx context.Context, in *BulkFollowRequest, opts ...grpc.CallOption) (*BulkFollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkFollowResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_BulkFollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) BulkUnfollow(ctx context.Context, in *BulkFollowRequest, opts ...grpc.CallOption) (*BulkFollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkFollowResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_BulkUnfollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_Mute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) Unmute(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption) (*UnmuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_Unmute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) ListMuted(ctx context.Context, in *ListMutedRequest, opts ...grpc.CallOption) (*ListMutedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMutedResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_ListMuted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) FilterMuted(ctx context.Context, in *FilterMutedRequest, opts ...grpc.CallOption) (*FilterMutedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterMutedResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_FilterMuted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) CreateAudienceList(ctx context.Context, in *CreateAudienceListRequest, opts ...grpc.CallOption) (*CreateAudienceListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAudienceListResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_CreateAudienceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) RenameAudienceList(ctx context.Context, in *RenameAudienceListRequest, opts ...grpc.CallOption) (*RenameAudienceListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameAudienceListResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_RenameAudienceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) DeleteAudienceList(ctx context.Context, in *DeleteAudienceListRequest, opts ...grpc.CallOption) (*DeleteAudienceListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAudienceListResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_DeleteAudienceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) ListAudienceLists(ctx context.Context, in *ListAudienceListsRequest, opts ...grpc.CallOption) (*ListAudienceListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAudienceListsResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_ListAudienceLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) AddAudienceMembers(ctx context.Context, in *AudienceMembersRequest, opts ...grpc.CallOption) (*AudienceMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudienceMembersResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_AddAudienceMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) RemoveAudienceMembers(ctx context.Context, in *AudienceMembersRequest, opts ...grpc.CallOption) (*AudienceMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AudienceMembersResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_RemoveAudienceMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) ListAudienceMembers(ctx context.Context, in *ListAudienceMembersRequest, opts ...grpc.CallOption) (*ListAudienceMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAudienceMembersResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_ListAudienceMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) IsAudienceMember(ctx context.Context, in *IsAudienceMemberRequest, opts ...grpc.CallOption) (*IsAudienceMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsAudienceMemberResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_IsAudienceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) GetFollowSourceStats(ctx context.Context, in *GetFollowSourceStatsRequest, opts ...grpc.CallOption) (*GetFollowSourceStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowSourceStatsResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_GetFollowSourceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relationExtServiceClient) GetFollowerGrowth(ctx context.Context, in *GetFollowerGrowthRequest, opts ...grpc.CallOption) (*GetFollowerGrowthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFollowerGrowthResponse)
+	err := c.cc.Invoke(ctx, RelationExtService_GetFollowerGrowth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelationExtServiceServer is the server API for RelationExtService service.
+// All implementations must embed UnimplementedRelationExtServiceServer
+// for forward compatibility.
+//
+// RelationExtService holds the relation RPCs that are not part of the shared
+// pinstack-proto-definitions contract yet. It is served on the same port as relation.v1.RelationService.
+type RelationExtServiceServer interface {
+	// GetRelationships returns the relationship between the viewer and every target in one call
+	GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error)
+	// GetMutualFollows lists the users that follow user_id and are followed back, newest mutual first
+	GetMutualFollows(context.Context, *GetMutualFollowsRequest) (*GetMutualFollowsResponse, error)
+	// IsMutual reports whether the two users follow each other
+	IsMutual(context.Context, *IsMutualRequest) (*IsMutualResponse, error)
+	// GetFollowersYouKnow returns followers of target_id that viewer_id follows, with their total count
+	GetFollowersYouKnow(context.Context, *GetFollowersYouKnowRequest) (*GetFollowersYouKnowResponse, error)
+	// GetSuggestions returns who-to-follow candidates for user_id ranked by friends-of-friends reach
+	GetSuggestions(context.Context, *GetSuggestionsRequest) (*GetSuggestionsResponse, error)
+	// FollowTarget follows a user, board or tag. User targets behave exactly like relation.v1 Follow
+	FollowTarget(context.Context, *FollowTargetRequest) (*FollowTargetResponse, error)
+	// UnfollowTarget removes a follow of any target type
+	UnfollowTarget(context.Context, *UnfollowTargetRequest) (*UnfollowTargetResponse, error)
+	// ListFollowedTargets lists the ids of the targets of one type that follower_id follows, newest first
+	ListFollowedTargets(context.Context, *ListFollowedTargetsRequest) (*ListFollowedTargetsResponse, error)
+	// StreamFollowerIDs sends every follower id of user_id in ascending chunks, without user enrichment.
+	// A broken stream is resumed by passing the next_cursor of the last received chunk as cursor
+	StreamFollowerIDs(*StreamFollowerIDsRequest, grpc.ServerStreamingServer[StreamFollowerIDsResponse]) error
+	// ListFollowers is relation.v1 GetFollowers with sorting, a follow time window and filters
+	ListFollowers(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error)
+	// ListFollowees is relation.v1 GetFollowees with sorting, a follow time window and filters
+	ListFollowees(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error)
+	// SearchFollowers finds followers of user_id by username or full name prefix and trigram similarity,
+	// best match first. Followers whose profile has not been synced locally yet are not found
+	SearchFollowers(context.Context, *SearchFollowsRequest) (*SearchFollowsResponse, error)
+	// SearchFollowees is SearchFollowers over the users user_id follows
+	SearchFollowees(context.Context, *SearchFollowsRequest) (*SearchFollowsResponse, error)
+	// GetRelationHistory lists the follow, unfollow, block and removal records involving user_id,
+	// newest first. With other_user_id set only the records between the two users are returned
+	GetRelationHistory(context.Context, *GetRelationHistoryRequest) (*GetRelationHistoryResponse, error)
+	// RemoveFollower ends the follow of follower_id on owner_id at the request of owner_id.
+	// It publishes follower_removed instead of follow_deleted so the follower is not notified
+	RemoveFollower(context.Context, *RemoveFollowerRequest) (*RemoveFollowerResponse, error)
+	// RemoveFollowers removes many followers of owner_id in one transaction, skipping users that do not follow them
+	RemoveFollowers(context.Context, *RemoveFollowersRequest) (*RemoveFollowersResponse, error)
+	// BulkFollow follows many users in one transaction and reports the outcome for each of them
+	BulkFollow(context.Context, *BulkFollowRequest) (*BulkFollowResponse, error)
+	// BulkUnfollow unfollows many users in one transaction and reports the outcome for each of them
+	BulkUnfollow(context.Context, *BulkFollowRequest) (*BulkFollowResponse, error)
+	// Mute hides the pins of followee_id from follower_id without unfollowing, until expires_at
+	// when it is set. Muting again replaces the expiry
+	Mute(context.Context, *MuteRequest) (*MuteResponse, error)
+	// Unmute ends an active mute
+	Unmute(context.Context, *UnmuteRequest) (*UnmuteResponse, error)
+	// ListMuted lists the active mutes of user_id, newest first
+	ListMuted(context.Context, *ListMutedRequest) (*ListMutedResponse, error)
+	// FilterMuted returns the authors of author_ids that viewer_id has muted, for feeds to drop their pins
+	FilterMuted(context.Context, *FilterMutedRequest) (*FilterMutedResponse, error)
+	CreateAudienceList(context.Context, *CreateAudienceListRequest) (*CreateAudienceListResponse, error)
+	RenameAudienceList(context.Context, *RenameAudienceListRequest) (*RenameAudienceListResponse, error)
+	// DeleteAudienceList removes the list together with its members
+	DeleteAudienceList(context.Context, *DeleteAudienceListRequest) (*DeleteAudienceListResponse, error)
+	ListAudienceLists(context.Context, *ListAudienceListsRequest) (*ListAudienceListsResponse, error)
+	// AddAudienceMembers adds users to a list, which may require them to follow the owner
+	AddAudienceMembers(context.Context, *AudienceMembersRequest) (*AudienceMembersResponse, error)
+	RemoveAudienceMembers(context.Context, *AudienceMembersRequest) (*AudienceMembersResponse, error)
+	// ListAudienceMembers lists the members of a list, most recently added first
+	ListAudienceMembers(context.Context, *ListAudienceMembersRequest) (*ListAudienceMembersResponse, error)
+	// IsAudienceMember tells the post service whether a user may see content shared with a list
+	IsAudienceMember(context.Context, *IsAudienceMemberRequest) (*IsAudienceMemberResponse, error)
+	// GetFollowSourceStats returns the daily user follows per source, for service accounts only.
+	// Follows made without a follow-source header are counted under "unknown".
+	GetFollowSourceStats(context.Context, *GetFollowSourceStatsRequest) (*GetFollowSourceStatsResponse, error)
+	// GetFollowerGrowth returns the followers a user gained and lost over time, with the
+	// follower count at the end of every bucket. Growth is recounted periodically, so the
+	// latest changes can show up a few minutes late.
+	GetFollowerGrowth(context.Context, *GetFollowerGrowthRequest) (*GetFollowerGrowthResponse, error)
+	mustEmbedUnimplementedRelationExtServiceServer()
+}
+
+// UnimplementedRelationExtServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRelationExtServiceServer struct{}
+
+func (UnimplementedRelationExtServiceServer) GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationships not implemented")
+}
+func (UnimplementedRelationExtServiceServer) GetMutualFollows(context.Context, *GetMutualFollowsRequest) (*GetMutualFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutualFollows not implemented")
+}
+func (UnimplementedRelationExtServiceServer) IsMutual(context.Context, *IsMutualRequest) (*IsMutualResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsMutual not implemented")
+}
+func (UnimplementedRelationExtServiceServer) GetFollowersYouKnow(context.Context, *GetFollowersYouKnowRequest) (*GetFollowersYouKnowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowersYouKnow not implemented")
+}
+func (UnimplementedRelationExtServiceServer) GetSuggestions(context.Context, *GetSuggestionsRequest) (*GetSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSuggestions not implemented")
+}
+func (UnimplementedRelationExtServiceServer) FollowTarget(context.Context, *FollowTargetRequest) (*FollowTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowTarget not implemented")
+}
+func (UnimplementedRelationExtServiceServer) UnfollowTarget(context.Context, *UnfollowTargetRequest) (*UnfollowTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowTarget not implemented")
+}
+func (UnimplementedRelationExtServiceServer) ListFollowedTargets(context.Context, *ListFollowedTargetsRequest) (*ListFollowedTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowedTargets not implemented")
+}
+func (UnimplementedRelationExtServiceServer) StreamFollowerIDs(*StreamFollowerIDsRequest, grpc.ServerStreamingServer[StreamFollowerIDsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFollowerIDs not implemented")
+}
+func (UnimplementedRelationExtServiceServer) ListFollowers(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedRelationExtServiceServer) ListFollowees(context.Context, *ListFollowsRequest) (*ListFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowees not implemented")
+}
+func (UnimplementedRelationExtServiceServer) SearchFollowers(context.Context, *SearchFollowsRequest) (*SearchFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFollowers not implemented")
+}
+func (UnimplementedRelationExtServiceServer) SearchFollowees(context.Context, *SearchFollowsRequest) (*SearchFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFollowees not implemented")
+}
+func (UnimplementedRelationExtServiceServer) GetRelationHistory(context.Context, *GetRelationHistoryRequest) (*GetRelationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationHistory not implemented")
+}
+func (UnimplementedRelationExtServiceServer) RemoveFollower(context.Context, *RemoveFollowerRequest) (*RemoveFollowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFollower not implemented")
+}
+func (UnimplementedRelationExtServiceServer) RemoveFollowers(context.Context, *RemoveFollowersRequest) (*RemoveFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFollowers not implemented")
+}
+func (UnimplementedRelationExtServiceServer) BulkFollow(context.Context, *BulkFollowRequest) (*BulkFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkFollow not implemented")
+}
+func (UnimplementedRelationExtServiceServer) BulkUnfollow(context.Context, *BulkFollowRequest) (*BulkFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUnfollow not implemented")
+}
+func (UnimplementedRelationExtServiceServer) Mute(context.Context, *MuteRequest) (*MuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mute not implemented")
+}
+func (UnimplementedRelationExtServiceServer) Unmute(context.Context, *UnmuteRequest) (*UnmuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmute not implemented")
+}
+func (UnimplementedRelationExtServiceServer) ListMuted(context.Context, *ListMutedRequest) (*ListMutedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMuted not implemented")
+}
+func (UnimplementedRelationExtServiceServer) FilterMuted(context.Context, *FilterMutedRequest) (*FilterMutedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterMuted not implemented")
+}
+func (UnimplementedRelationExtServiceServer) CreateAudienceList(context.Context, *CreateAudienceListRequest) (*CreateAudienceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAudienceList not implemented")
+}
+func (UnimplementedRelationExtServiceServer) RenameAudienceList(context.Context, *RenameAudienceListRequest) (*RenameAudienceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameAudienceList not implemented")
+}
+func (UnimplementedRelationExtServiceServer) DeleteAudienceList(context.Context, *DeleteAudienceListRequest) (*DeleteAudienceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAudienceList not implemented")
+}
+func (UnimplementedRelationExtServiceServer) ListAudienceLists(context.Context, *ListAudienceListsRequest) (*ListAudienceListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudienceLists not implemented")
+}
+func (UnimplementedRelationExtServiceServer) AddAudienceMembers(context.Context, *AudienceMembersRequest) (*AudienceMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAudienceMembers not implemented")
+}
+func (UnimplementedRelationExtServiceServer) RemoveAudienceMembers(context.Context, *AudienceMembersRequest) (*AudienceMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAudienceMembers not implemented")
+}
+func (UnimplementedRelationExtServiceServer) ListAudienceMembers(context.Context, *ListAudienceMembersRequest) (*ListAudienceMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudienceMembers not implemented")
+}
+func (UnimplementedRelationExtServiceServer) IsAudienceMember(context.Context, *IsAudienceMemberRequest) (*IsAudienceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsAudienceMember not implemented")
+}
+func (UnimplementedRelationExtServiceServer) GetFollowSourceStats(context.Context, *GetFollowSourceStatsRequest) (*GetFollowSourceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowSourceStats not implemented")
+}
+func (UnimplementedRelationExtServiceServer) GetFollowerGrowth(context.Context, *GetFollowerGrowthRequest) (*GetFollowerGrowthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowerGrowth not implemented")
+}
+func (UnimplementedRelationExtServiceServer) mustEmbedUnimplementedRelationExtServiceServer() {}
+func (UnimplementedRelationExtServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeRelationExtServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelationExtServiceServer will
+// result in compilation errors.
+type UnsafeRelationExtServiceServer interface {
+	mustEmbedUnimplementedRelationExtServiceServer()
+}
+
+func RegisterRelationExtServiceServer(s grpc.ServiceRegistrar, srv RelationExtServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRelationExtServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RelationExtService_ServiceDesc, srv)
+}
+
+func _RelationExtService_GetRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).GetRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_GetRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).GetRelationships(ctx, req.(*GetRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_GetMutualFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMutualFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).GetMutualFollows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_GetMutualFollows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).GetMutualFollows(ctx, req.(*GetMutualFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_IsMutual_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsMutualRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).IsMutual(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_IsMutual_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).IsMutual(ctx, req.(*IsMutualRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_GetFollowersYouKnow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowersYouKnowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).GetFollowersYouKnow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_GetFollowersYouKnow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).GetFollowersYouKnow(ctx, req.(*GetFollowersYouKnowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_GetSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).GetSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_GetSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).GetSuggestions(ctx, req.(*GetSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_FollowTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).FollowTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_FollowTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).FollowTarget(ctx, req.(*FollowTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_UnfollowTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).UnfollowTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_UnfollowTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).UnfollowTarget(ctx, req.(*UnfollowTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_ListFollowedTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowedTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).ListFollowedTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_ListFollowedTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).ListFollowedTargets(ctx, req.(*ListFollowedTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_StreamFollowerIDs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamFollowerIDsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RelationExtServiceServer).StreamFollowerIDs(m, &grpc.GenericServerStream[StreamFollowerIDsRequest, StreamFollowerIDsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RelationExtService_StreamFollowerIDsServer = grpc.ServerStreamingServer[StreamFollowerIDsResponse]
+
+func _RelationExtService_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).ListFollowers(ctx, req.(*ListFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_ListFollowees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).ListFollowees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_ListFollowees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).ListFollowees(ctx, req.(*ListFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_SearchFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).SearchFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_SearchFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).SearchFollowers(ctx, req.(*SearchFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_SearchFollowees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).SearchFollowees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_SearchFollowees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).SearchFollowees(ctx, req.(*SearchFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_GetRelationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).GetRelationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_GetRelationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).GetRelationHistory(ctx, req.(*GetRelationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_RemoveFollower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFollowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).RemoveFollower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_RemoveFollower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).RemoveFollower(ctx, req.(*RemoveFollowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_RemoveFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).RemoveFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_RemoveFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).RemoveFollowers(ctx, req.(*RemoveFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_BulkFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).BulkFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_BulkFollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).BulkFollow(ctx, req.(*BulkFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_BulkUnfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).BulkUnfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_BulkUnfollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).BulkUnfollow(ctx, req.(*BulkFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_Mute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).Mute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_Mute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).Mute(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_Unmute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).Unmute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_Unmute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).Unmute(ctx, req.(*UnmuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_ListMuted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMutedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).ListMuted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_ListMuted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).ListMuted(ctx, req.(*ListMutedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_FilterMuted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterMutedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).FilterMuted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_FilterMuted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).FilterMuted(ctx, req.(*FilterMutedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_CreateAudienceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAudienceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).CreateAudienceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_CreateAudienceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).CreateAudienceList(ctx, req.(*CreateAudienceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_RenameAudienceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameAudienceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).RenameAudienceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_RenameAudienceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).RenameAudienceList(ctx, req.(*RenameAudienceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_DeleteAudienceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAudienceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).DeleteAudienceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_DeleteAudienceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).DeleteAudienceList(ctx, req.(*DeleteAudienceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_ListAudienceLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAudienceListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).ListAudienceLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_ListAudienceLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).ListAudienceLists(ctx, req.(*ListAudienceListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_AddAudienceMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AudienceMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).AddAudienceMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_AddAudienceMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).AddAudienceMembers(ctx, req.(*AudienceMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_RemoveAudienceMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AudienceMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).RemoveAudienceMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_RemoveAudienceMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).RemoveAudienceMembers(ctx, req.(*AudienceMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_ListAudienceMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAudienceMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).ListAudienceMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_ListAudienceMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).ListAudienceMembers(ctx, req.(*ListAudienceMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_IsAudienceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsAudienceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).IsAudienceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_IsAudienceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).IsAudienceMember(ctx, req.(*IsAudienceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_GetFollowSourceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowSourceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).GetFollowSourceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_GetFollowSourceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).GetFollowSourceStats(ctx, req.(*GetFollowSourceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RelationExtService_GetFollowerGrowth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowerGrowthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelationExtServiceServer).GetFollowerGrowth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RelationExtService_GetFollowerGrowth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelationExtServiceServer).GetFollowerGrowth(ctx, req.(*GetFollowerGrowthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RelationExtService_ServiceDesc is the grpc.ServiceDesc for RelationExtService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RelationExtService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "relation_ext.v1.RelationExtService",
+	HandlerType: (*RelationExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRelationships",
+			Handler:    _RelationExtService_GetRelationships_Handler,
+		},
+		{
+			MethodName: "GetMutualFollows",
+			Handler:    _RelationExtService_GetMutualFollows_Handler,
+		},
+		{
+			MethodName: "IsMutual",
+			Handler:    _RelationExtService_IsMutual_Handler,
+		},
+		{
+			MethodName: "GetFollowersYouKnow",
+			Handler:    _RelationExtService_GetFollowersYouKnow_Handler,
+		},
+		{
+			MethodName: "GetSuggestions",
+			Handler:    _RelationExtService_GetSuggestions_Handler,
+		},
+		{
+			MethodName: "FollowTarget",
+			Handler:    _RelationExtService_FollowTarget_Handler,
+		},
+		{
+			MethodName: "UnfollowTarget",
+			Handler:    _RelationExtService_UnfollowTarget_Handler,
+		},
+		{
+			MethodName: "ListFollowedTargets",
+			Handler:    _RelationExtService_ListFollowedTargets_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _RelationExtService_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowees",
+			Handler:    _RelationExtService_ListFollowees_Handler,
+		},
+		{
+			MethodName: "SearchFollowers",
+			Handler:    _RelationExtService_SearchFollowers_Handler,
+		},
+		{
+			MethodName: "SearchFollowees",
+			Handler:    _RelationExtService_SearchFollowees_Handler,
+		},
+		{
+			MethodName: "GetRelationHistory",
+			Handler:    _RelationExtService_GetRelationHistory_Handler,
+		},
+		{
+			MethodName: "RemoveFollower",
+			Handler:    _RelationExtService_RemoveFollower_Handler,
+		},
+		{
+			MethodName: "RemoveFollowers",
+			Handler:    _RelationExtService_RemoveFollowers_Handler,
+		},
+		{
+			MethodName: "BulkFollow",
+			Handler:    _RelationExtService_BulkFollow_Handler,
+		},
+		{
+			MethodName: "BulkUnfollow",
+			Handler:    _RelationExtService_BulkUnfollow_Handler,
+		},
+		{
+			MethodName: "Mute",
+			Handler:    _RelationExtService_Mute_Handler,
+		},
+		{
+			MethodName: "Unmute",
+			Handler:    _RelationExtService_Unmute_Handler,
+		},
+		{
+			MethodName: "ListMuted",
+			Handler:    _RelationExtService_ListMuted_Handler,
+		},
+		{
+			MethodName: "FilterMuted",
+			Handler:    _RelationExtService_FilterMuted_Handler,
+		},
+		{
+			MethodName: "CreateAudienceList",
+			Handler:    _RelationExtService_CreateAudienceList_Handler,
+		},
+		{
+			MethodName: "RenameAudienceList",
+			Handler:    _RelationExtService_RenameAudienceList_Handler,
+		},
+		{
+			MethodName: "DeleteAudienceList",
+			Handler:    _RelationExtService_DeleteAudienceList_Handler,
+		},
+		{
+			MethodName: "ListAudienceLists",
+			Handler:    _RelationExtService_ListAudienceLists_Handler,
+		},
+		{
+			MethodName: "AddAudienceMembers",
+			Handler:    _RelationExtService_AddAudienceMembers_Handler,
+		},
+		{
+			MethodName: "RemoveAudienceMembers",
+			Handler:    _RelationExtService_RemoveAudienceMembers_Handler,
+		},
+		{
+			MethodName: "ListAudienceMembers",
+			Handler:    _RelationExtService_ListAudienceMembers_Handler,
+		},
+		{
+			MethodName: "IsAudienceMember",
+			Handler:    _RelationExtService_IsAudienceMember_Handler,
+		},
+		{
+			MethodName: "GetFollowSourceStats",
+			Handler:    _RelationExtService_GetFollowSourceStats_Handler,
+		},
+		{
+			MethodName: "GetFollowerGrowth",
+			Handler:    _RelationExtService_GetFollowerGrowth_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamFollowerIDs",
+			Handler:       _RelationExtService_StreamFollowerIDs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "relation_ext/v1/relation_ext.proto",
+}
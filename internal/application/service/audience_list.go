@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/domain/ports/output/outbox"
+	"pinstack-relation-service/internal/domain/ports/output/uow"
+	"pinstack-relation-service/internal/infrastructure/utils"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// CreateAudienceList adds a named list to ownerID. The list limit is checked before the insert,
+// so concurrent creates may go over it by a few lists.
+func (s *Service) CreateAudienceList(ctx context.Context, ownerID int64, name string) (model.AudienceList, error) {
+	s.logger(ctx).Info("CreateAudienceList request received", slog.Int64("ownerID", ownerID))
+
+	name, err := normalizeAudienceListName(name)
+	if err != nil {
+		return model.AudienceList{}, err
+	}
+
+	if err := s.authorizeActor(ctx, ownerID); err != nil {
+		return model.AudienceList{}, err
+	}
+
+	if s.audience.MaxLists > 0 {
+		count, err := s.audienceRepo.CountByOwner(ctx, ownerID)
+		if err != nil {
+			s.logger(ctx).Error("Error counting audience lists", slog.Int64("ownerID", ownerID), slog.String("error", err.Error()))
+			return model.AudienceList{}, err
+		}
+		if count >= int64(s.audience.MaxLists) {
+			s.logger(ctx).Debug("Audience list limit reached", slog.Int64("ownerID", ownerID), slog.Int64("count", count))
+			return model.AudienceList{}, model.ErrAudienceListLimit
+		}
+	}
+
+	list, err := s.audienceRepo.Create(ctx, ownerID, name)
+	if err != nil {
+		s.logger(ctx).Error("Error creating audience list", slog.Int64("ownerID", ownerID), slog.String("error", err.Error()))
+		return model.AudienceList{}, err
+	}
+
+	s.logger(ctx).Info("Audience list created successfully", slog.Int64("ownerID", ownerID), slog.Int64("listID", list.ID))
+	return list, nil
+}
+
+func (s *Service) RenameAudienceList(ctx context.Context, ownerID, listID int64, name string) (model.AudienceList, error) {
+	s.logger(ctx).Info("RenameAudienceList request received", slog.Int64("ownerID", ownerID), slog.Int64("listID", listID))
+
+	name, err := normalizeAudienceListName(name)
+	if err != nil {
+		return model.AudienceList{}, err
+	}
+
+	if err := s.authorizeActor(ctx, ownerID); err != nil {
+		return model.AudienceList{}, err
+	}
+
+	list, err := s.audienceRepo.Rename(ctx, ownerID, listID, name)
+	if err != nil {
+		s.logger(ctx).Error("Error renaming audience list", slog.Int64("listID", listID), slog.String("error", err.Error()))
+		return model.AudienceList{}, err
+	}
+	return list, nil
+}
+
+// DeleteAudienceList removes a list of ownerID with all of its members. A single
+// audience_list_deleted event replaces the member removed events.
+func (s *Service) DeleteAudienceList(ctx context.Context, ownerID, listID int64) (err error) {
+	s.logger(ctx).Info("DeleteAudienceList request received", slog.Int64("ownerID", ownerID), slog.Int64("listID", listID))
+
+	if err = s.authorizeActor(ctx, ownerID); err != nil {
+		return err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if err = tx.AudienceListRepository().Delete(ctx, ownerID, listID); err != nil {
+		s.logger(ctx).Error("Error deleting audience list", slog.Int64("listID", listID), slog.String("error", err.Error()))
+		return err
+	}
+
+	payload, err := json.Marshal(model.AudienceListDeletedPayload{
+		ListID:      listID,
+		OwnerID:     ownerID,
+		Timestamptz: time.Now(),
+	})
+	if err != nil {
+		s.logger(ctx).Error("Failed to marshal payload", slog.String("error", err.Error()))
+		return err
+	}
+
+	err = tx.OutboxRepository().AddEvent(ctx, model.OutboxEvent{
+		EventType:   model.EventTypeAudienceListDeleted,
+		Payload:     payload,
+		AggregateID: listID,
+	})
+	if err != nil {
+		s.logger(ctx).Error("Error adding event to outbox", slog.String("error", err.Error()))
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+
+	s.logger(ctx).Info("Audience list deleted successfully", slog.Int64("ownerID", ownerID), slog.Int64("listID", listID))
+	return nil
+}
+
+func (s *Service) ListAudienceLists(ctx context.Context, ownerID int64) ([]model.AudienceList, error) {
+	s.logger(ctx).Info("ListAudienceLists request received", slog.Int64("ownerID", ownerID))
+
+	if err := s.authorizeActor(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	lists, err := s.audienceRepo.ListByOwner(ctx, ownerID)
+	if err != nil {
+		s.logger(ctx).Error("Error listing audience lists", slog.Int64("ownerID", ownerID), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return lists, nil
+}
+
+// AddAudienceMembers adds memberIDs to a list of ownerID and returns the ids that were not
+// members yet. The whole request fails when one of them does not follow the owner and the
+// policy requires followers, or when the list would outgrow its limit.
+func (s *Service) AddAudienceMembers(ctx context.Context, ownerID, listID int64, memberIDs []int64) (added []int64, err error) {
+	s.logger(ctx).Info("AddAudienceMembers request received", slog.Int64("ownerID", ownerID), slog.Int64("listID", listID), slog.Int("members", len(memberIDs)))
+
+	uniqueIDs, err := uniqueAudienceMembers(ownerID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.authorizeActor(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	audienceRepo := tx.AudienceListRepository()
+
+	// The lock keeps concurrent additions from going over the member limit together
+	list, err := audienceRepo.GetForUpdate(ctx, ownerID, listID)
+	if err != nil {
+		s.logger(ctx).Error("Error getting audience list", slog.Int64("listID", listID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if s.audience.RequireFollowers {
+		// Locking the follows makes a concurrent unfollow wait for this commit, so its
+		// revokeAudienceMembership sees and removes the new members
+		following, err := tx.FollowRepository().LockFollowers(ctx, ownerID, uniqueIDs)
+		if err != nil {
+			s.logger(ctx).Error("Error locking followers", slog.String("error", err.Error()))
+			return nil, err
+		}
+		for _, memberID := range uniqueIDs {
+			if !slices.Contains(following, memberID) {
+				s.logger(ctx).Debug("Audience member does not follow the owner", slog.Int64("ownerID", ownerID), slog.Int64("memberID", memberID))
+				return nil, model.ErrAudienceMemberNotFollower
+			}
+		}
+	}
+
+	added, err = audienceRepo.AddMembers(ctx, listID, uniqueIDs)
+	if err != nil {
+		s.logger(ctx).Error("Error adding audience members", slog.Int64("listID", listID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if s.audience.MaxMembers > 0 && list.MemberCount+int64(len(added)) > int64(s.audience.MaxMembers) {
+		s.logger(ctx).Debug("Audience member limit reached", slog.Int64("listID", listID), slog.Int64("members", list.MemberCount))
+		return nil, model.ErrAudienceListLimit
+	}
+
+	outboxRepo := tx.OutboxRepository()
+	for _, memberID := range added {
+		if err = s.addAudienceMemberEvent(ctx, outboxRepo, model.EventTypeAudienceMemberAdded, list, memberID); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	s.logger(ctx).Info("Audience members added successfully", slog.Int64("listID", listID), slog.Int("added", len(added)))
+	return added, nil
+}
+
+// RemoveAudienceMembers removes memberIDs from a list of ownerID and returns the ids that were members
+func (s *Service) RemoveAudienceMembers(ctx context.Context, ownerID, listID int64, memberIDs []int64) (removed []int64, err error) {
+	s.logger(ctx).Info("RemoveAudienceMembers request received", slog.Int64("ownerID", ownerID), slog.Int64("listID", listID), slog.Int("members", len(memberIDs)))
+
+	uniqueIDs, err := uniqueAudienceMembers(ownerID, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.authorizeActor(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	audienceRepo := tx.AudienceListRepository()
+
+	list, err := audienceRepo.Get(ctx, ownerID, listID)
+	if err != nil {
+		s.logger(ctx).Error("Error getting audience list", slog.Int64("listID", listID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	removed, err = audienceRepo.RemoveMembers(ctx, listID, uniqueIDs)
+	if err != nil {
+		s.logger(ctx).Error("Error removing audience members", slog.Int64("listID", listID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	outboxRepo := tx.OutboxRepository()
+	for _, memberID := range removed {
+		if err = s.addAudienceMemberEvent(ctx, outboxRepo, model.EventTypeAudienceMemberRemoved, list, memberID); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	s.logger(ctx).Info("Audience members removed successfully", slog.Int64("listID", listID), slog.Int("removed", len(removed)))
+	return removed, nil
+}
+
+// ListAudienceMembers pages through the members of a list of ownerID, most recently added first
+func (s *Service) ListAudienceMembers(ctx context.Context, ownerID, listID int64, limit, page int32) ([]int64, int64, error) {
+	s.logger(ctx).Info("ListAudienceMembers request received", slog.Int64("ownerID", ownerID), slog.Int64("listID", listID))
+
+	if err := s.authorizeActor(ctx, ownerID); err != nil {
+		return nil, 0, err
+	}
+
+	if _, err := s.audienceRepo.Get(ctx, ownerID, listID); err != nil {
+		s.logger(ctx).Error("Error getting audience list", slog.Int64("listID", listID), slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	limit, offset := utils.SetPaginationDefaults(limit, page)
+	memberIDs, total, err := s.audienceRepo.ListMembers(ctx, listID, limit, offset)
+	if err != nil {
+		s.logger(ctx).Error("Error listing audience members", slog.Int64("listID", listID), slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	return memberIDs, total, nil
+}
+
+// IsAudienceMember tells the post service whether userID may see content shared with a list of ownerID
+func (s *Service) IsAudienceMember(ctx context.Context, ownerID, listID, userID int64) (bool, error) {
+	s.logger(ctx).Info("IsAudienceMember request received", slog.Int64("ownerID", ownerID), slog.Int64("listID", listID), slog.Int64("userID", userID))
+
+	if err := s.authorizeReader(ctx, ownerID); err != nil {
+		return false, err
+	}
+
+	if _, err := s.audienceRepo.Get(ctx, ownerID, listID); err != nil {
+		s.logger(ctx).Error("Error getting audience list", slog.Int64("listID", listID), slog.String("error", err.Error()))
+		return false, err
+	}
+
+	isMember, err := s.audienceRepo.IsMember(ctx, listID, userID)
+	if err != nil {
+		s.logger(ctx).Error("Error checking audience membership", slog.Int64("listID", listID), slog.String("error", err.Error()))
+		return false, err
+	}
+	return isMember, nil
+}
+
+// revokeAudienceMembership takes followerID off every list of followeeID once the follow ended,
+// when lists require followers
+func (s *Service) revokeAudienceMembership(ctx context.Context, tx uow.Transaction, followerID, followeeID int64) error {
+	if !s.audience.RequireFollowers {
+		return nil
+	}
+
+	listIDs, err := tx.AudienceListRepository().RemoveMemberFromOwnerLists(ctx, followeeID, followerID)
+	if err != nil {
+		s.logger(ctx).Error("Error revoking audience membership", slog.Int64("ownerID", followeeID), slog.Int64("memberID", followerID), slog.String("error", err.Error()))
+		return err
+	}
+	if len(listIDs) == 0 {
+		return nil
+	}
+
+	outboxRepo := tx.OutboxRepository()
+	for _, listID := range listIDs {
+		list := model.AudienceList{ID: listID, OwnerID: followeeID}
+		if err = s.addAudienceMemberEvent(ctx, outboxRepo, model.EventTypeAudienceMemberRemoved, list, followerID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) addAudienceMemberEvent(ctx context.Context, outboxRepo outbox.OutboxRepository, eventType events.EventType, list model.AudienceList, memberID int64) error {
+	payload, err := json.Marshal(model.AudienceMemberPayload{
+		ListID:      list.ID,
+		OwnerID:     list.OwnerID,
+		MemberID:    memberID,
+		Timestamptz: time.Now(),
+	})
+	if err != nil {
+		s.logger(ctx).Error("Failed to marshal payload", slog.String("error", err.Error()))
+		return err
+	}
+
+	err = outboxRepo.AddEvent(ctx, model.OutboxEvent{
+		EventType:   eventType,
+		Payload:     payload,
+		AggregateID: list.ID,
+	})
+	if err != nil {
+		s.logger(ctx).Error("Error adding event to outbox", slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
+
+func normalizeAudienceListName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > model.MaxAudienceListNameLength {
+		return "", custom_errors.ErrInvalidInput
+	}
+	return name, nil
+}
+
+// uniqueAudienceMembers drops duplicate members, an owner can not be a member of their own list
+func uniqueAudienceMembers(ownerID int64, memberIDs []int64) ([]int64, error) {
+	uniqueIDs := make([]int64, 0, len(memberIDs))
+	seen := make(map[int64]struct{}, len(memberIDs))
+	for _, memberID := range memberIDs {
+		if memberID == ownerID {
+			return nil, custom_errors.ErrInvalidInput
+		}
+		if _, ok := seen[memberID]; ok {
+			continue
+		}
+		seen[memberID] = struct{}{}
+		uniqueIDs = append(uniqueIDs, memberID)
+	}
+
+	if len(uniqueIDs) == 0 || len(uniqueIDs) > model.MaxAudienceMembersPerRequest {
+		return nil, custom_errors.ErrInvalidInput
+	}
+	return uniqueIDs, nil
+}
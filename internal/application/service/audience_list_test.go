@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/domain/ports/output/repository"
+	"pinstack-relation-service/mocks"
+	"strings"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testAudiencePolicy = model.AudienceListPolicy{RequireFollowers: true, MaxLists: 2, MaxMembers: 3}
+
+// setupAudienceTest is setupTest with the audience list policy of testAudiencePolicy
+func setupAudienceTest(t *testing.T) (*Service, *mocks.FollowRepository, *mocks.UnitOfWork, *mocks.Transaction, *mocks.OutboxRepository) {
+	svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
+	svc.audience = testAudiencePolicy
+	return svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo
+}
+
+// newAudienceRepoForTx makes tx hand out an audience list repository mock
+func newAudienceRepoForTx(t *testing.T, tx *mocks.Transaction) *mocks.AudienceListRepository {
+	audienceRepo := mocks.NewAudienceListRepository(t)
+	tx.On("AudienceListRepository").Return(audienceRepo)
+	return audienceRepo
+}
+
+func isAudienceMemberEvent(eventType string, listID, memberID int64) func(model.OutboxEvent) bool {
+	return func(event model.OutboxEvent) bool {
+		var payload model.AudienceMemberPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return false
+		}
+		return string(event.EventType) == eventType &&
+			event.AggregateID == listID &&
+			payload.ListID == listID &&
+			payload.MemberID == memberID
+	}
+}
+
+func TestService_CreateAudienceList(t *testing.T) {
+	t.Run("успешное создание списка", func(t *testing.T) {
+		svc, _, _, _, _ := setupAudienceTest(t)
+		ctx := context.Background()
+		audienceRepo := svc.audienceRepo.(*mocks.AudienceListRepository)
+		list := model.AudienceList{ID: 7, OwnerID: 1, Name: "Close friends"}
+
+		audienceRepo.On("CountByOwner", ctx, int64(1)).Return(int64(1), nil)
+		audienceRepo.On("Create", ctx, int64(1), "Close friends").Return(list, nil)
+
+		got, err := svc.CreateAudienceList(ctx, 1, "  Close friends ")
+
+		require.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("лимит списков исчерпан", func(t *testing.T) {
+		svc, _, _, _, _ := setupAudienceTest(t)
+		ctx := context.Background()
+
+		svc.audienceRepo.(*mocks.AudienceListRepository).On("CountByOwner", ctx, int64(1)).Return(int64(2), nil)
+
+		_, err := svc.CreateAudienceList(ctx, 1, "Family")
+
+		assert.ErrorIs(t, err, model.ErrAudienceListLimit)
+	})
+
+	t.Run("пустое или слишком длинное имя", func(t *testing.T) {
+		svc, _, _, _, _ := setupAudienceTest(t)
+
+		_, err := svc.CreateAudienceList(context.Background(), 1, "   ")
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+
+		_, err = svc.CreateAudienceList(context.Background(), 1, strings.Repeat("я", model.MaxAudienceListNameLength+1))
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+	})
+
+	t.Run("запрет создания от имени другого пользователя", func(t *testing.T) {
+		svc, _, _, _, _ := setupAudienceTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 3})
+
+		_, err := svc.CreateAudienceList(ctx, 1, "Family")
+
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+	})
+}
+
+func TestService_DeleteAudienceList(t *testing.T) {
+	t.Run("удаление списка публикует событие", func(t *testing.T) {
+		svc, _, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		newAudienceRepoForTx(t, mockTx).On("Delete", ctx, int64(1), int64(7)).Return(nil)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(func(event model.OutboxEvent) bool {
+			var payload model.AudienceListDeletedPayload
+			return json.Unmarshal(event.Payload, &payload) == nil &&
+				event.EventType == model.EventTypeAudienceListDeleted &&
+				event.AggregateID == 7 &&
+				payload.OwnerID == 1
+		})).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		err := svc.DeleteAudienceList(ctx, 1, 7)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("чужой список не найден", func(t *testing.T) {
+		svc, _, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		newAudienceRepoForTx(t, mockTx).On("Delete", ctx, int64(1), int64(7)).Return(model.ErrAudienceListNotFound)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := svc.DeleteAudienceList(ctx, 1, 7)
+
+		assert.ErrorIs(t, err, model.ErrAudienceListNotFound)
+		mockOutboxRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_AddAudienceMembers(t *testing.T) {
+	list := model.AudienceList{ID: 7, OwnerID: 1, MemberCount: 1}
+
+	t.Run("добавление подписчиков", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		audienceRepo := newAudienceRepoForTx(t, mockTx)
+		audienceRepo.On("GetForUpdate", ctx, int64(1), int64(7)).Return(list, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockFollowRepo.On("LockFollowers", ctx, int64(1), []int64{2, 3}).Return([]int64{2, 3}, nil)
+		audienceRepo.On("AddMembers", ctx, int64(7), []int64{2, 3}).Return([]int64{3}, nil)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isAudienceMemberEvent("audience_member_added", 7, 3))).Return(nil).Once()
+		mockTx.On("Commit", ctx).Return(nil)
+
+		added, err := svc.AddAudienceMembers(ctx, 1, 7, []int64{2, 3, 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, added)
+	})
+
+	t.Run("участник не подписан на владельца", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		audienceRepo := newAudienceRepoForTx(t, mockTx)
+		audienceRepo.On("GetForUpdate", ctx, int64(1), int64(7)).Return(list, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockFollowRepo.On("LockFollowers", ctx, int64(1), []int64{2, 3}).Return([]int64{2}, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.AddAudienceMembers(ctx, 1, 7, []int64{2, 3})
+
+		assert.ErrorIs(t, err, model.ErrAudienceMemberNotFollower)
+		audienceRepo.AssertNotCalled(t, "AddMembers", mock.Anything, mock.Anything, mock.Anything)
+		mockOutboxRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("подписка не требуется", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		svc.audience.RequireFollowers = false
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		audienceRepo := newAudienceRepoForTx(t, mockTx)
+		audienceRepo.On("GetForUpdate", ctx, int64(1), int64(7)).Return(list, nil)
+		audienceRepo.On("AddMembers", ctx, int64(7), []int64{2}).Return([]int64{2}, nil)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isAudienceMemberEvent("audience_member_added", 7, 2))).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		_, err := svc.AddAudienceMembers(ctx, 1, 7, []int64{2})
+
+		require.NoError(t, err)
+		mockFollowRepo.AssertNotCalled(t, "LockFollowers", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("лимит участников откатывает добавление", func(t *testing.T) {
+		svc, _, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		svc.audience.RequireFollowers = false
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		audienceRepo := newAudienceRepoForTx(t, mockTx)
+		audienceRepo.On("GetForUpdate", ctx, int64(1), int64(7)).Return(model.AudienceList{ID: 7, OwnerID: 1, MemberCount: 2}, nil)
+		audienceRepo.On("AddMembers", ctx, int64(7), []int64{2, 3}).Return([]int64{2, 3}, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.AddAudienceMembers(ctx, 1, 7, []int64{2, 3})
+
+		assert.ErrorIs(t, err, model.ErrAudienceListLimit)
+		mockOutboxRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("владелец не может быть участником", func(t *testing.T) {
+		svc, _, mockUOW, _, _ := setupAudienceTest(t)
+
+		_, err := svc.AddAudienceMembers(context.Background(), 1, 7, []int64{1, 2})
+
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+}
+
+func TestService_RemoveAudienceMembers(t *testing.T) {
+	t.Run("удаление участников публикует события", func(t *testing.T) {
+		svc, _, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		audienceRepo := newAudienceRepoForTx(t, mockTx)
+		audienceRepo.On("Get", ctx, int64(1), int64(7)).Return(model.AudienceList{ID: 7, OwnerID: 1}, nil)
+		audienceRepo.On("RemoveMembers", ctx, int64(7), []int64{2, 3}).Return([]int64{2}, nil)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isAudienceMemberEvent("audience_member_removed", 7, 2))).Return(nil).Once()
+		mockTx.On("Commit", ctx).Return(nil)
+
+		removed, err := svc.RemoveAudienceMembers(ctx, 1, 7, []int64{2, 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2}, removed)
+	})
+
+	t.Run("ошибка outbox откатывает удаление", func(t *testing.T) {
+		svc, _, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		audienceRepo := newAudienceRepoForTx(t, mockTx)
+		audienceRepo.On("Get", ctx, int64(1), int64(7)).Return(model.AudienceList{ID: 7, OwnerID: 1}, nil)
+		audienceRepo.On("RemoveMembers", ctx, int64(7), []int64{2}).Return([]int64{2}, nil)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(errors.New("outbox error"))
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.RemoveAudienceMembers(ctx, 1, 7, []int64{2})
+
+		assert.Error(t, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestService_IsAudienceMember(t *testing.T) {
+	t.Run("сервис постов проверяет участника", func(t *testing.T) {
+		svc, _, _, _, _ := setupAudienceTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 100, ServiceAccount: true})
+		audienceRepo := svc.audienceRepo.(*mocks.AudienceListRepository)
+
+		audienceRepo.On("Get", ctx, int64(1), int64(7)).Return(model.AudienceList{ID: 7, OwnerID: 1}, nil)
+		audienceRepo.On("IsMember", ctx, int64(7), int64(2)).Return(true, nil)
+
+		isMember, err := svc.IsAudienceMember(ctx, 1, 7, 2)
+
+		require.NoError(t, err)
+		assert.True(t, isMember)
+	})
+
+	t.Run("список другого владельца", func(t *testing.T) {
+		svc, _, _, _, _ := setupAudienceTest(t)
+		ctx := context.Background()
+
+		svc.audienceRepo.(*mocks.AudienceListRepository).On("Get", ctx, int64(2), int64(7)).Return(model.AudienceList{}, model.ErrAudienceListNotFound)
+
+		_, err := svc.IsAudienceMember(ctx, 2, 7, 3)
+
+		assert.ErrorIs(t, err, model.ErrAudienceListNotFound)
+	})
+
+	t.Run("другой пользователь не может проверять", func(t *testing.T) {
+		svc, _, _, _, _ := setupAudienceTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 3})
+
+		_, err := svc.IsAudienceMember(ctx, 1, 7, 3)
+
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+	})
+}
+
+// audienceMembershipStub keeps list memberships in memory, so a follow removal and a later
+// membership check see the same lists
+type audienceMembershipStub struct {
+	repository.AudienceListRepository
+	owners  map[int64]int64
+	members map[int64]map[int64]bool
+}
+
+func newAudienceMembershipStub(ownerID, listID int64, memberIDs ...int64) *audienceMembershipStub {
+	stub := &audienceMembershipStub{
+		owners:  map[int64]int64{listID: ownerID},
+		members: map[int64]map[int64]bool{listID: {}},
+	}
+	for _, memberID := range memberIDs {
+		stub.members[listID][memberID] = true
+	}
+	return stub
+}
+
+func (r *audienceMembershipStub) Get(_ context.Context, ownerID, listID int64) (model.AudienceList, error) {
+	if owner, ok := r.owners[listID]; !ok || owner != ownerID {
+		return model.AudienceList{}, model.ErrAudienceListNotFound
+	}
+	return model.AudienceList{ID: listID, OwnerID: ownerID, MemberCount: int64(len(r.members[listID]))}, nil
+}
+
+func (r *audienceMembershipStub) IsMember(_ context.Context, listID, userID int64) (bool, error) {
+	return r.members[listID][userID], nil
+}
+
+func (r *audienceMembershipStub) RemoveMemberFromOwnerLists(_ context.Context, ownerID, memberID int64) ([]int64, error) {
+	listIDs := make([]int64, 0)
+	for listID, owner := range r.owners {
+		if owner == ownerID && r.members[listID][memberID] {
+			delete(r.members[listID], memberID)
+			listIDs = append(listIDs, listID)
+		}
+	}
+	return listIDs, nil
+}
+
+func TestService_AudienceMembershipEndsWithFollow(t *testing.T) {
+	ownerID, memberID, listID := int64(1), int64(2), int64(7)
+
+	t.Run("отписка лишает доступа к списку", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		ctx := context.Background()
+		audienceRepo := newAudienceMembershipStub(ownerID, listID, memberID, 3)
+		svc.audienceRepo = audienceRepo
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockTx.On("AudienceListRepository").Return(audienceRepo)
+		mockFollowRepo.On("Delete", ctx, memberID, ownerID).Return(model.Follower{ID: 10, FollowerID: memberID, FolloweeID: ownerID}, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(memberID, ownerID, model.RelationActionUnfollow))
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isAudienceMemberEvent("audience_member_removed", listID, memberID))).Return(nil).Once()
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowDeletedEvent(10, memberID, ownerID))).Return(nil).Once()
+		mockTx.On("Commit", ctx).Return(nil)
+
+		isMember, err := svc.IsAudienceMember(ctx, ownerID, listID, memberID)
+		require.NoError(t, err)
+		require.True(t, isMember)
+
+		require.NoError(t, svc.Unfollow(ctx, memberID, ownerID))
+
+		isMember, err = svc.IsAudienceMember(ctx, ownerID, listID, memberID)
+		require.NoError(t, err)
+		assert.False(t, isMember)
+		isMember, err = svc.IsAudienceMember(ctx, ownerID, listID, 3)
+		require.NoError(t, err)
+		assert.True(t, isMember)
+	})
+
+	t.Run("удаление подписчика лишает доступа к списку", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		ctx := context.Background()
+		audienceRepo := newAudienceMembershipStub(ownerID, listID, memberID)
+		svc.audienceRepo = audienceRepo
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockTx.On("AudienceListRepository").Return(audienceRepo)
+		mockFollowRepo.On("Delete", ctx, memberID, ownerID).Return(model.Follower{ID: 10, FollowerID: memberID, FolloweeID: ownerID}, nil)
+		expectRelationHistory(t, mockTx, removalHistoryEntry(ownerID, memberID))
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isAudienceMemberEvent("audience_member_removed", listID, memberID))).Return(nil).Once()
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowerRemovedEvent(ownerID, memberID))).Return(nil).Once()
+		mockTx.On("Commit", ctx).Return(nil)
+
+		require.NoError(t, svc.RemoveFollower(ctx, ownerID, memberID))
+
+		isMember, err := svc.IsAudienceMember(ctx, ownerID, listID, memberID)
+		require.NoError(t, err)
+		assert.False(t, isMember)
+	})
+
+	t.Run("без требования подписки участник остается", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo := setupAudienceTest(t)
+		svc.audience.RequireFollowers = false
+		ctx := context.Background()
+		audienceRepo := newAudienceMembershipStub(ownerID, listID, memberID)
+		svc.audienceRepo = audienceRepo
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Delete", ctx, memberID, ownerID).Return(model.Follower{ID: 10, FollowerID: memberID, FolloweeID: ownerID}, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(memberID, ownerID, model.RelationActionUnfollow))
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowDeletedEvent(10, memberID, ownerID))).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		require.NoError(t, svc.Unfollow(ctx, memberID, ownerID))
+
+		isMember, err := svc.IsAudienceMember(ctx, ownerID, listID, memberID)
+		require.NoError(t, err)
+		assert.True(t, isMember)
+		mockTx.AssertNotCalled(t, "AudienceListRepository")
+	})
+}
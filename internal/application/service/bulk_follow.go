@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// BulkFollow follows every user of followeeIDs in one transaction and reports what happened
+// with each of them, in the order of the request. Missing users, existing follows and follows
+// refused by the anti-spam rules do not fail the request.
+func (s *Service) BulkFollow(ctx context.Context, followerID int64, followeeIDs []int64) (results []model.BulkResult, err error) {
+	s.logger(ctx).Info("BulkFollow request received", slog.Int64("followerID", followerID), slog.Int("followees", len(followeeIDs)))
+
+	uniqueIDs, err := uniqueBulkTargets(followerID, followeeIDs, custom_errors.ErrSelfFollow)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.authorizeActor(ctx, followerID); err != nil {
+		return nil, err
+	}
+
+	if len(uniqueIDs) == 0 {
+		return []model.BulkResult{}, nil
+	}
+
+	users, err := s.userClient.GetUsers(ctx, uniqueIDs)
+	if err != nil {
+		s.logger(ctx).Error("Failed to get users", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	outcomes := make(map[int64]model.BulkOutcome, len(uniqueIDs))
+	candidates := make([]int64, 0, len(uniqueIDs))
+	for _, followeeID := range uniqueIDs {
+		if _, ok := users[followeeID]; !ok {
+			outcomes[followeeID] = model.BulkOutcomeNotFound
+			continue
+		}
+		candidates = append(candidates, followeeID)
+	}
+
+	if len(candidates) > 0 {
+		if err = s.bulkFollowTx(ctx, followerID, candidates, outcomes); err != nil {
+			return nil, err
+		}
+		s.suggestionCache.Invalidate(ctx, followerID)
+	}
+
+	results = bulkResults(uniqueIDs, outcomes)
+	s.logger(ctx).Info("BulkFollow completed", slog.Int64("followerID", followerID), slog.Int("targets", len(results)))
+	return results, nil
+}
+
+// bulkFollowTx creates the follows of candidates and fills in their outcomes
+func (s *Service) bulkFollowTx(ctx context.Context, followerID int64, candidates []int64, outcomes map[int64]model.BulkOutcome) (err error) {
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	followRepo := tx.FollowRepository()
+
+	relationships, err := followRepo.GetRelationships(ctx, followerID, candidates)
+	if err != nil {
+		s.logger(ctx).Error("Error getting relationships", slog.String("error", err.Error()))
+		return err
+	}
+
+	accepted := make([]int64, 0, len(candidates))
+	var exhausted bool
+	for _, followeeID := range candidates {
+		if relationships[followeeID].Following {
+			outcomes[followeeID] = model.BulkOutcomeAlreadyFollowing
+			continue
+		}
+		if s.limits.Enabled() {
+			// Once a quota is used up every remaining target would break it as well
+			if exhausted {
+				outcomes[followeeID] = model.BulkOutcomeBlocked
+				continue
+			}
+			violation, err := s.checkFollowLimits(ctx, tx, followerID, followeeID, int64(len(accepted)))
+			if err != nil {
+				return err
+			}
+			if violation != nil {
+				outcomes[followeeID] = model.BulkOutcomeBlocked
+				exhausted = violation.Rule != model.FollowLimitRuleCooldown
+				continue
+			}
+		}
+		accepted = append(accepted, followeeID)
+	}
+
+	if len(accepted) > 0 {
+		created, err := followRepo.CreateMany(ctx, followerID, accepted, model.FollowAttributionFromContext(ctx))
+		if err != nil {
+			s.logger(ctx).Error("Error creating follow relationships", slog.String("error", err.Error()))
+			return err
+		}
+
+		for _, followeeID := range accepted {
+			// Follows created concurrently since the relationship check are not returned
+			outcomes[followeeID] = model.BulkOutcomeAlreadyFollowing
+		}
+		for _, follower := range created {
+			outcomes[follower.FolloweeID] = model.BulkOutcomeCreated
+
+			if s.limits.Enabled() {
+				err = tx.FollowActionRepository().Record(ctx, followerID, follower.FolloweeID, model.FollowActionFollow)
+				if err != nil {
+					s.logger(ctx).Error("Error recording follow action", slog.String("error", err.Error()))
+					return err
+				}
+			}
+
+			if err = s.recordRelationChange(ctx, tx, historyEntry(ctx, followerID, followerID, follower.FolloweeID, model.RelationActionFollow)); err != nil {
+				return err
+			}
+
+			if err = s.addFollowCreatedEvent(ctx, tx.OutboxRepository(), follower); err != nil {
+				return err
+			}
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	return nil
+}
+
+// BulkUnfollow unfollows every user of followeeIDs in one transaction and reports for each of
+// them, in the order of the request, whether a follow was removed
+func (s *Service) BulkUnfollow(ctx context.Context, followerID int64, followeeIDs []int64) (results []model.BulkResult, err error) {
+	s.logger(ctx).Info("BulkUnfollow request received", slog.Int64("followerID", followerID), slog.Int("followees", len(followeeIDs)))
+
+	uniqueIDs, err := uniqueBulkTargets(followerID, followeeIDs, custom_errors.ErrSelfUnfollow)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.authorizeActor(ctx, followerID); err != nil {
+		return nil, err
+	}
+
+	if len(uniqueIDs) == 0 {
+		return []model.BulkResult{}, nil
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	removed, err := tx.FollowRepository().DeleteMany(ctx, followerID, uniqueIDs)
+	if err != nil {
+		s.logger(ctx).Error("Error deleting follow relationships", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	outcomes := make(map[int64]model.BulkOutcome, len(uniqueIDs))
+	for _, followeeID := range uniqueIDs {
+		outcomes[followeeID] = model.BulkOutcomeNotFollowing
+	}
+	outboxRepo := tx.OutboxRepository()
+	for _, follower := range removed {
+		outcomes[follower.FolloweeID] = model.BulkOutcomeRemoved
+
+		if s.limits.Enabled() {
+			err = tx.FollowActionRepository().Record(ctx, followerID, follower.FolloweeID, model.FollowActionUnfollow)
+			if err != nil {
+				s.logger(ctx).Error("Error recording unfollow action", slog.String("error", err.Error()))
+				return nil, err
+			}
+		}
+
+		if err = s.recordRelationChange(ctx, tx, historyEntry(ctx, followerID, followerID, follower.FolloweeID, model.RelationActionUnfollow)); err != nil {
+			return nil, err
+		}
+
+		if err = s.revokeAudienceMembership(ctx, tx, followerID, follower.FolloweeID); err != nil {
+			return nil, err
+		}
+
+		if err = s.addFollowDeletedEvent(ctx, outboxRepo, follower); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	if len(removed) > 0 {
+		s.suggestionCache.Invalidate(ctx, followerID)
+	}
+
+	results = bulkResults(uniqueIDs, outcomes)
+	s.logger(ctx).Info("BulkUnfollow completed", slog.Int64("followerID", followerID), slog.Int("removed", len(removed)))
+	return results, nil
+}
+
+// uniqueBulkTargets drops duplicate targets while keeping the request order. selfErr is returned
+// when followerID is among the targets.
+func uniqueBulkTargets(followerID int64, targetIDs []int64, selfErr error) ([]int64, error) {
+	uniqueIDs := make([]int64, 0, len(targetIDs))
+	seen := make(map[int64]struct{}, len(targetIDs))
+	for _, targetID := range targetIDs {
+		if targetID == followerID {
+			return nil, selfErr
+		}
+		if _, ok := seen[targetID]; ok {
+			continue
+		}
+		seen[targetID] = struct{}{}
+		uniqueIDs = append(uniqueIDs, targetID)
+	}
+
+	if len(uniqueIDs) > model.MaxBulkFollowTargets {
+		return nil, custom_errors.ErrInvalidInput
+	}
+	return uniqueIDs, nil
+}
+
+func bulkResults(targetIDs []int64, outcomes map[int64]model.BulkOutcome) []model.BulkResult {
+	results := make([]model.BulkResult, 0, len(targetIDs))
+	for _, targetID := range targetIDs {
+		results = append(results, model.BulkResult{TargetID: targetID, Outcome: outcomes[targetID]})
+	}
+	return results
+}
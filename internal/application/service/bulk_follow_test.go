@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "pinstack-relation-service/internal/domain/models"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func isFollowCreatedEvent(followerID, followeeID int64) func(model.OutboxEvent) bool {
+	return func(event model.OutboxEvent) bool {
+		var payload events.FollowCreatedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return false
+		}
+		return event.EventType == events.EventTypeFollowCreated &&
+			payload.FollowerID == followerID &&
+			payload.FolloweeID == followeeID
+	}
+}
+
+func TestService_BulkFollow(t *testing.T) {
+	t.Run("результат для каждого пользователя в порядке запроса", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, mockUserClient := setupTest(t)
+		ctx := context.Background()
+		followerID := int64(1)
+
+		svc.suggestionCache.Set(ctx, followerID, []model.Suggestion{{UserID: 9}})
+		mockUserClient.On("GetUsers", ctx, []int64{2, 3, 4, 5}).Return(map[int64]*model.User{
+			2: {ID: 2}, 3: {ID: 3}, 5: {ID: 5},
+		}, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("GetRelationships", ctx, followerID, []int64{2, 3, 5}).Return(map[int64]model.Relationship{
+			3: {TargetID: 3, Following: true},
+		}, nil)
+		mockFollowRepo.On("CreateMany", ctx, followerID, []int64{2, 5}, model.FollowAttribution{}).Return([]model.Follower{
+			{ID: 10, FollowerID: followerID, FolloweeID: 2},
+		}, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, 2, model.RelationActionFollow))
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowCreatedEvent(followerID, 2))).Return(nil).Once()
+		mockTx.On("Commit", ctx).Return(nil)
+
+		results, err := svc.BulkFollow(ctx, followerID, []int64{2, 3, 2, 4, 5})
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.BulkResult{
+			{TargetID: 2, Outcome: model.BulkOutcomeCreated},
+			{TargetID: 3, Outcome: model.BulkOutcomeAlreadyFollowing},
+			{TargetID: 4, Outcome: model.BulkOutcomeNotFound},
+			{TargetID: 5, Outcome: model.BulkOutcomeAlreadyFollowing},
+		}, results)
+		_, ok := svc.suggestionCache.Get(ctx, followerID)
+		assert.False(t, ok)
+	})
+
+	t.Run("никого из списка не существует", func(t *testing.T) {
+		svc, _, mockUOW, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUsers", ctx, []int64{2, 3}).Return(map[int64]*model.User{}, nil)
+
+		results, err := svc.BulkFollow(ctx, 1, []int64{2, 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.BulkResult{
+			{TargetID: 2, Outcome: model.BulkOutcomeNotFound},
+			{TargetID: 3, Outcome: model.BulkOutcomeNotFound},
+		}, results)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("подписка на самого себя", func(t *testing.T) {
+		svc, _, _, _, _, mockUserClient := setupTest(t)
+
+		results, err := svc.BulkFollow(context.Background(), 1, []int64{2, 1})
+
+		assert.Equal(t, custom_errors.ErrSelfFollow, err)
+		assert.Nil(t, results)
+		mockUserClient.AssertNotCalled(t, "GetUsers", mock.Anything, mock.Anything)
+	})
+
+	t.Run("слишком много пользователей", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		followeeIDs := make([]int64, model.MaxBulkFollowTargets+1)
+		for i := range followeeIDs {
+			followeeIDs[i] = int64(i + 2)
+		}
+
+		_, err := svc.BulkFollow(context.Background(), 1, followeeIDs)
+
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+	})
+
+	t.Run("подписываться можно только от своего имени", func(t *testing.T) {
+		svc, _, _, _, _, mockUserClient := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 2})
+
+		_, err := svc.BulkFollow(ctx, 1, []int64{3})
+
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+		mockUserClient.AssertNotCalled(t, "GetUsers", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ошибка user service", func(t *testing.T) {
+		svc, _, mockUOW, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUsers", ctx, []int64{2}).Return(nil, errors.New("user service down"))
+
+		_, err := svc.BulkFollow(ctx, 1, []int64{2})
+
+		assert.Error(t, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("ошибка создания подписок откатывает транзакцию", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUsers", ctx, []int64{2}).Return(map[int64]*model.User{2: {ID: 2}}, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockFollowRepo.On("GetRelationships", ctx, int64(1), []int64{2}).Return(map[int64]model.Relationship{}, nil)
+		mockFollowRepo.On("CreateMany", ctx, int64(1), []int64{2}, model.FollowAttribution{}).Return(nil, custom_errors.ErrFollowRelationCreateFail)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.BulkFollow(ctx, 1, []int64{2})
+
+		assert.ErrorIs(t, err, custom_errors.ErrFollowRelationCreateFail)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestService_BulkFollowLimits(t *testing.T) {
+	t.Run("подписки сверх лимита блокируются", func(t *testing.T) {
+		svc, m := setupLimitsTest(t)
+		ctx := context.Background()
+		followerID := int64(1)
+
+		m.userClient.On("GetUsers", ctx, []int64{2, 3, 4}).Return(map[int64]*model.User{
+			2: {ID: 2}, 3: {ID: 3}, 4: {ID: 4},
+		}, nil)
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.tx.On("OutboxRepository").Return(m.outboxRepo)
+		m.tx.On("FollowActionRepository").Return(m.actionRepo)
+		m.followRepo.On("GetRelationships", ctx, followerID, []int64{2, 3, 4}).Return(map[int64]model.Relationship{}, nil)
+		velocity := model.FollowVelocity{FollowsLastHour: 9, FollowsLastDay: 9, Followees: 9}
+		m.actionRepo.On("GetVelocity", ctx, followerID, int64(2), mock.AnythingOfType("time.Time")).Return(velocity, nil).Once()
+		m.actionRepo.On("GetVelocity", ctx, followerID, int64(3), mock.AnythingOfType("time.Time")).Return(velocity, nil).Once()
+		m.outboxRepo.On("AddEvent", ctx, mock.MatchedBy(func(event model.OutboxEvent) bool {
+			return event.EventType == model.EventTypeFollowLimitViolated
+		})).Return(nil).Once()
+		m.followRepo.On("CreateMany", ctx, followerID, []int64{2}, model.FollowAttribution{}).Return([]model.Follower{
+			{ID: 10, FollowerID: followerID, FolloweeID: 2},
+		}, nil)
+		m.actionRepo.On("Record", ctx, followerID, int64(2), model.FollowActionFollow).Return(nil)
+		expectRelationHistory(t, m.tx, userHistoryEntry(followerID, 2, model.RelationActionFollow))
+		m.outboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowCreatedEvent(followerID, 2))).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil)
+
+		results, err := svc.BulkFollow(ctx, followerID, []int64{2, 3, 4})
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.BulkResult{
+			{TargetID: 2, Outcome: model.BulkOutcomeCreated},
+			{TargetID: 3, Outcome: model.BulkOutcomeBlocked},
+			{TargetID: 4, Outcome: model.BulkOutcomeBlocked},
+		}, results)
+	})
+}
+
+func TestService_BulkUnfollow(t *testing.T) {
+	t.Run("результат для каждого пользователя в порядке запроса", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
+		ctx := context.Background()
+		followerID := int64(1)
+
+		svc.suggestionCache.Set(ctx, followerID, []model.Suggestion{{UserID: 9}})
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("DeleteMany", ctx, followerID, []int64{3, 2}).Return([]model.Follower{{ID: 10, FollowerID: followerID, FolloweeID: 2}}, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, 2, model.RelationActionUnfollow))
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowDeletedEvent(10, followerID, 2))).Return(nil).Once()
+		mockTx.On("Commit", ctx).Return(nil)
+
+		results, err := svc.BulkUnfollow(ctx, followerID, []int64{3, 2, 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.BulkResult{
+			{TargetID: 3, Outcome: model.BulkOutcomeNotFollowing},
+			{TargetID: 2, Outcome: model.BulkOutcomeRemoved},
+		}, results)
+		_, ok := svc.suggestionCache.Get(ctx, followerID)
+		assert.False(t, ok)
+	})
+
+	t.Run("отписка от самого себя", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+
+		_, err := svc.BulkUnfollow(context.Background(), 1, []int64{1})
+
+		assert.Equal(t, custom_errors.ErrSelfUnfollow, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("ошибка удаления подписок откатывает транзакцию", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockFollowRepo.On("DeleteMany", ctx, int64(1), []int64{2}).Return(nil, custom_errors.ErrFollowRelationDeleteFail)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.BulkUnfollow(ctx, 1, []int64{2})
+
+		assert.ErrorIs(t, err, custom_errors.ErrFollowRelationDeleteFail)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("с лимитами отписки записываются в транзакции", func(t *testing.T) {
+		svc, m := setupLimitsTest(t)
+		ctx := context.Background()
+
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.tx.On("FollowActionRepository").Return(m.actionRepo)
+		m.tx.On("OutboxRepository").Return(m.outboxRepo)
+		m.followRepo.On("DeleteMany", ctx, int64(1), []int64{2, 3}).Return([]model.Follower{
+			{ID: 10, FollowerID: 1, FolloweeID: 2},
+			{ID: 11, FollowerID: 1, FolloweeID: 3},
+		}, nil)
+		m.actionRepo.On("Record", ctx, int64(1), int64(2), model.FollowActionUnfollow).Return(nil).Once()
+		m.actionRepo.On("Record", ctx, int64(1), int64(3), model.FollowActionUnfollow).Return(nil).Once()
+		historyRepo := newHistoryRepoForTx(t, m.tx)
+		historyRepo.On("Record", ctx, userHistoryEntry(1, 2, model.RelationActionUnfollow)).Return(nil).Once()
+		historyRepo.On("Record", ctx, userHistoryEntry(1, 3, model.RelationActionUnfollow)).Return(nil).Once()
+		m.outboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowDeletedEvent(10, 1, 2))).Return(nil).Once()
+		m.outboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowDeletedEvent(11, 1, 3))).Return(nil).Once()
+		m.tx.On("Commit", ctx).Return(nil)
+
+		results, err := svc.BulkUnfollow(ctx, 1, []int64{2, 3})
+
+		require.NoError(t, err)
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("ошибка записи отписки откатывает транзакцию", func(t *testing.T) {
+		svc, m := setupLimitsTest(t)
+		ctx := context.Background()
+
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.tx.On("FollowActionRepository").Return(m.actionRepo)
+		m.tx.On("OutboxRepository").Return(m.outboxRepo)
+		m.followRepo.On("DeleteMany", ctx, int64(1), []int64{2}).Return([]model.Follower{{ID: 10, FollowerID: 1, FolloweeID: 2}}, nil)
+		m.actionRepo.On("Record", ctx, int64(1), int64(2), model.FollowActionUnfollow).Return(errors.New("db error"))
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.BulkUnfollow(ctx, 1, []int64{2})
+
+		require.Error(t, err)
+		m.tx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/domain/ports/output/uow"
+	"time"
+)
+
+// checkFollowLimits evaluates the anti-spam rules for one follow and adds the violation event
+// to the transaction when a rule is broken. pending counts the follows the same transaction
+// is about to create, which the stored velocity does not include yet.
+func (s *Service) checkFollowLimits(ctx context.Context, tx uow.Transaction, followerID, followeeID, pending int64) (*model.FollowLimitViolation, error) {
+	now := time.Now()
+	velocity, err := tx.FollowActionRepository().GetVelocity(ctx, followerID, followeeID, now)
+	if err != nil {
+		s.logger(ctx).Error("Error getting follow velocity", slog.String("error", err.Error()))
+		return nil, err
+	}
+	velocity.FollowsLastHour += pending
+	velocity.FollowsLastDay += pending
+	velocity.Followees += pending
+
+	violation := s.limits.Check(velocity, now)
+	if violation == nil {
+		return nil, nil
+	}
+
+	s.logger(ctx).Warn("Follow rejected by anti-spam rule",
+		slog.Int64("followerID", followerID),
+		slog.Int64("followeeID", followeeID),
+		slog.String("rule", string(violation.Rule)),
+		slog.Int64("limit", violation.Limit),
+		slog.Int64("observed", violation.Observed))
+
+	payload, err := json.Marshal(model.FollowLimitViolatedPayload{
+		FollowerID:        followerID,
+		FolloweeID:        followeeID,
+		Rule:              violation.Rule,
+		Limit:             violation.Limit,
+		Observed:          violation.Observed,
+		RetryAfterSeconds: int64(violation.RetryAfter.Seconds()),
+		Timestamptz:       now,
+	})
+	if err != nil {
+		s.logger(ctx).Error("Failed to marshal payload", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	err = tx.OutboxRepository().AddEvent(ctx, model.OutboxEvent{
+		EventType:   model.EventTypeFollowLimitViolated,
+		Payload:     payload,
+		AggregateID: followerID,
+	})
+	if err != nil {
+		s.logger(ctx).Error("Error adding event to outbox", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return violation, nil
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "pinstack-relation-service/internal/domain/models"
+	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type limitsMocks struct {
+	followRepo *mocks.FollowRepository
+	actionRepo *mocks.FollowActionRepository
+	uow        *mocks.UnitOfWork
+	tx         *mocks.Transaction
+	outboxRepo *mocks.OutboxRepository
+	userClient *mocks.Client
+}
+
+var testFollowLimits = model.FollowLimits{
+	MaxFollowsPerHour: 10,
+	MaxFollowsPerDay:  50,
+	MaxFollowees:      100,
+	RefollowCooldown:  time.Hour,
+}
+
+func setupLimitsTest(t *testing.T) (*Service, limitsMocks) {
+	m := limitsMocks{
+		followRepo: mocks.NewFollowRepository(t),
+		actionRepo: mocks.NewFollowActionRepository(t),
+		uow:        mocks.NewUnitOfWork(t),
+		tx:         mocks.NewTransaction(t),
+		outboxRepo: mocks.NewOutboxRepository(t),
+		userClient: mocks.NewClient(t),
+	}
+	svc := NewFollowService(infra_logger.New("test"), m.followRepo, mocks.NewSuggestionRepository(t), mocks.NewProfileRepository(t), mocks.NewRelationHistoryRepository(t), mocks.NewIdempotencyRepository(t), mocks.NewMuteRepository(t), mocks.NewAudienceListRepository(t), mocks.NewFollowStatsRepository(t), mocks.NewFollowerGrowthRepository(t), m.uow, m.userClient, mocks.NewPostClient(t), newSuggestionCache(t), testFollowLimits, model.AudienceListPolicy{}, testIdempotencyTTL)
+	return svc, m
+}
+
+// expectFollowUntilLimits sets up the calls Follow makes before the anti-spam rules are evaluated
+func expectFollowUntilLimits(ctx context.Context, m limitsMocks, followerID, followeeID int64, velocity model.FollowVelocity) {
+	m.userClient.On("GetUser", ctx, followeeID).Return(&model.User{ID: followeeID}, nil)
+	m.uow.On("Begin", ctx).Return(m.tx, nil)
+	m.tx.On("FollowRepository").Return(m.followRepo)
+	m.tx.On("OutboxRepository").Return(m.outboxRepo)
+	m.tx.On("FollowActionRepository").Return(m.actionRepo)
+	m.followRepo.On("Exists", ctx, followerID, followeeID).Return(false, nil)
+	m.actionRepo.On("GetVelocity", ctx, followerID, followeeID, mock.AnythingOfType("time.Time")).Return(velocity, nil)
+}
+
+func TestService_FollowLimits(t *testing.T) {
+	t.Run("подписка в пределах лимитов записывает действие", func(t *testing.T) {
+		svc, m := setupLimitsTest(t)
+		ctx := context.Background()
+		followerID, followeeID := int64(1), int64(2)
+
+		expectFollowUntilLimits(ctx, m, followerID, followeeID, model.FollowVelocity{FollowsLastHour: 9, FollowsLastDay: 49, Followees: 99})
+		m.followRepo.On("Create", ctx, followerID, followeeID, model.FollowAttribution{}).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, true, nil)
+		m.actionRepo.On("Record", ctx, followerID, followeeID, model.FollowActionFollow).Return(nil)
+		expectRelationHistory(t, m.tx, userHistoryEntry(followerID, followeeID, model.RelationActionFollow))
+		m.outboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		err := svc.Follow(ctx, followerID, followeeID)
+
+		require.NoError(t, err)
+	})
+
+	violations := []struct {
+		name     string
+		velocity model.FollowVelocity
+		rule     model.FollowLimitRule
+	}{
+		{
+			name:     "превышен лимит подписок в час",
+			velocity: model.FollowVelocity{FollowsLastHour: 10, FollowsLastDay: 10},
+			rule:     model.FollowLimitRuleHourly,
+		},
+		{
+			name:     "превышен лимит подписок в день",
+			velocity: model.FollowVelocity{FollowsLastHour: 1, FollowsLastDay: 50},
+			rule:     model.FollowLimitRuleDaily,
+		},
+		{
+			name:     "превышено максимальное число подписок",
+			velocity: model.FollowVelocity{Followees: 100},
+			rule:     model.FollowLimitRuleFollowees,
+		},
+		{
+			name:     "повторная подписка до окончания cooldown",
+			velocity: model.FollowVelocity{LastUnfollowAt: func() *time.Time { t := time.Now().Add(-10 * time.Minute); return &t }()},
+			rule:     model.FollowLimitRuleCooldown,
+		},
+	}
+
+	for _, tt := range violations {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := setupLimitsTest(t)
+			ctx := context.Background()
+			followerID, followeeID := int64(1), int64(2)
+
+			expectFollowUntilLimits(ctx, m, followerID, followeeID, tt.velocity)
+
+			var published model.OutboxEvent
+			m.outboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).
+				Run(func(args mock.Arguments) { published = args.Get(1).(model.OutboxEvent) }).
+				Return(nil)
+			m.tx.On("Commit", ctx).Return(nil)
+
+			err := svc.Follow(ctx, followerID, followeeID)
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, model.ErrFollowLimitExceeded)
+			var violation *model.FollowLimitViolation
+			require.True(t, errors.As(err, &violation))
+			assert.Equal(t, tt.rule, violation.Rule)
+
+			assert.Equal(t, model.EventTypeFollowLimitViolated, published.EventType)
+			assert.Equal(t, followerID, published.AggregateID)
+			var payload model.FollowLimitViolatedPayload
+			require.NoError(t, json.Unmarshal(published.Payload, &payload))
+			assert.Equal(t, tt.rule, payload.Rule)
+			assert.Equal(t, followeeID, payload.FolloweeID)
+
+			m.followRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			m.tx.AssertCalled(t, "Commit", ctx)
+			m.tx.AssertNotCalled(t, "Rollback", ctx)
+		})
+	}
+
+	t.Run("повторная подписка после окончания cooldown", func(t *testing.T) {
+		svc, m := setupLimitsTest(t)
+		ctx := context.Background()
+		followerID, followeeID := int64(1), int64(2)
+		unfollowedAt := time.Now().Add(-2 * time.Hour)
+
+		expectFollowUntilLimits(ctx, m, followerID, followeeID, model.FollowVelocity{LastUnfollowAt: &unfollowedAt})
+		m.followRepo.On("Create", ctx, followerID, followeeID, model.FollowAttribution{}).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, true, nil)
+		m.actionRepo.On("Record", ctx, followerID, followeeID, model.FollowActionFollow).Return(nil)
+		expectRelationHistory(t, m.tx, userHistoryEntry(followerID, followeeID, model.RelationActionFollow))
+		m.outboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		err := svc.Follow(ctx, followerID, followeeID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("ошибка при получении истории действий", func(t *testing.T) {
+		svc, m := setupLimitsTest(t)
+		ctx := context.Background()
+		followerID, followeeID := int64(1), int64(2)
+
+		m.userClient.On("GetUser", ctx, followeeID).Return(&model.User{ID: followeeID}, nil)
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.tx.On("OutboxRepository").Return(m.outboxRepo)
+		m.tx.On("FollowActionRepository").Return(m.actionRepo)
+		m.tx.On("Rollback", ctx).Return(nil)
+		m.followRepo.On("Exists", ctx, followerID, followeeID).Return(false, nil)
+		m.actionRepo.On("GetVelocity", ctx, followerID, followeeID, mock.AnythingOfType("time.Time")).
+			Return(model.FollowVelocity{}, errors.New("db error"))
+
+		err := svc.Follow(ctx, followerID, followeeID)
+
+		assert.Error(t, err)
+		m.tx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("отписка записывает действие", func(t *testing.T) {
+		svc, m := setupLimitsTest(t)
+		ctx := context.Background()
+		followerID, followeeID := int64(1), int64(2)
+
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.tx.On("OutboxRepository").Return(m.outboxRepo)
+		m.tx.On("FollowActionRepository").Return(m.actionRepo)
+		m.followRepo.On("Delete", ctx, followerID, followeeID).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, nil)
+		m.actionRepo.On("Record", ctx, followerID, followeeID, model.FollowActionUnfollow).Return(nil)
+		expectRelationHistory(t, m.tx, userHistoryEntry(followerID, followeeID, model.RelationActionUnfollow))
+		m.outboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		err := svc.Unfollow(ctx, followerID, followeeID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("ошибка записи отписки откатывает отписку", func(t *testing.T) {
+		svc, m := setupLimitsTest(t)
+		ctx := context.Background()
+		followerID, followeeID := int64(1), int64(2)
+
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.tx.On("FollowActionRepository").Return(m.actionRepo)
+		m.followRepo.On("Delete", ctx, followerID, followeeID).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, nil)
+		m.actionRepo.On("Record", ctx, followerID, followeeID, model.FollowActionUnfollow).Return(errors.New("db error"))
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		err := svc.Unfollow(ctx, followerID, followeeID)
+
+		assert.Error(t, err)
+		m.tx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/utils"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// ListFollowers pages through the followers of followeeID with the given options
+func (s *Service) ListFollowers(ctx context.Context, followeeID int64, opts model.FollowListOptions, limit, page int32) (*model.FollowList, error) {
+	s.logger(ctx).Info("ListFollowers request received", slog.Int64("followeeID", followeeID))
+	return s.listFollows(ctx, followeeID, opts, limit, page, s.followRepo.ListFollowers)
+}
+
+// ListFollowees pages through the users followerID follows with the given options
+func (s *Service) ListFollowees(ctx context.Context, followerID int64, opts model.FollowListOptions, limit, page int32) (*model.FollowList, error) {
+	s.logger(ctx).Info("ListFollowees request received", slog.Int64("followerID", followerID))
+	return s.listFollows(ctx, followerID, opts, limit, page, s.followRepo.ListFollowees)
+}
+
+type followListQuery func(ctx context.Context, userID int64, opts model.FollowListOptions, limit, offset int32) ([]int64, int64, error)
+
+func (s *Service) listFollows(ctx context.Context, userID int64, opts model.FollowListOptions, limit, page int32, query followListQuery) (*model.FollowList, error) {
+	if opts.Since != nil && opts.Until != nil && !opts.Since.Before(*opts.Until) {
+		s.logger(ctx).Debug("Empty follow list time window",
+			slog.Time("since", *opts.Since),
+			slog.Time("until", *opts.Until))
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	_, err := s.userClient.GetUser(ctx, userID)
+	if err != nil {
+		s.logger(ctx).Error("Failed to get user", slog.Int64("userID", userID))
+		switch {
+		case errors.Is(err, custom_errors.ErrUserNotFound):
+			return nil, custom_errors.ErrUserNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	limit, offset := utils.SetPaginationDefaults(limit, page)
+	ids, total, err := query(ctx, userID, opts, limit, offset)
+	if err != nil {
+		s.logger(ctx).Error("Error listing follows", slog.Int64("userID", userID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	list := &model.FollowList{UserIDs: ids, Total: total}
+	if !opts.IDsOnly {
+		list.Users = s.resolveUsers(ctx, ids)
+	}
+
+	s.logger(ctx).Info("Follow list retrieved successfully", slog.Int64("userID", userID), slog.Int("count", len(ids)), slog.Int64("total", total))
+	return list, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	model "pinstack-relation-service/internal/domain/models"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ListFollowers(t *testing.T) {
+	since := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC)
+
+	t.Run("подписчики с профилями", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+		opts := model.FollowListOptions{Sort: model.FollowListSortOldest, Since: &since, MutualOnly: true}
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockFollowRepo.On("ListFollowers", ctx, int64(1), opts, int32(10), int32(10)).Return([]int64{3}, int64(11), nil)
+		mockUserClient.On("GetUser", ctx, int64(3)).Return(&model.User{ID: 3, Username: "user3"}, nil)
+
+		list, err := svc.ListFollowers(ctx, 1, opts, 10, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, list.UserIDs)
+		require.Len(t, list.Users, 1)
+		assert.Equal(t, "user3", list.Users[0].Username)
+		assert.Equal(t, int64(11), list.Total)
+	})
+
+	t.Run("только идентификаторы без обогащения", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+		opts := model.FollowListOptions{IDsOnly: true}
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil).Once()
+		mockFollowRepo.On("ListFollowers", ctx, int64(1), opts, int32(10), int32(0)).Return([]int64{3, 4}, int64(2), nil)
+
+		list, err := svc.ListFollowers(ctx, 1, opts, 10, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 4}, list.UserIDs)
+		assert.Nil(t, list.Users)
+		mockUserClient.AssertNumberOfCalls(t, "GetUser", 1)
+	})
+
+	t.Run("пустое временное окно", func(t *testing.T) {
+		svc, _, _, _, _, mockUserClient := setupTest(t)
+
+		_, err := svc.ListFollowers(context.Background(), 1, model.FollowListOptions{Since: &until, Until: &since}, 10, 1)
+
+		assert.ErrorIs(t, err, custom_errors.ErrInvalidInput)
+		mockUserClient.AssertNotCalled(t, "GetUser", mock.Anything, mock.Anything)
+	})
+
+	t.Run("пользователь не найден", func(t *testing.T) {
+		svc, _, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(nil, custom_errors.ErrUserNotFound)
+
+		_, err := svc.ListFollowers(ctx, 1, model.FollowListOptions{}, 10, 1)
+
+		assert.ErrorIs(t, err, custom_errors.ErrUserNotFound)
+	})
+
+	t.Run("ошибка базы данных", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockFollowRepo.On("ListFollowers", ctx, int64(1), mock.Anything, mock.Anything, mock.Anything).Return(nil, int64(0), custom_errors.ErrDatabaseQuery)
+
+		_, err := svc.ListFollowers(ctx, 1, model.FollowListOptions{}, 10, 1)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestService_ListFollowees(t *testing.T) {
+	t.Run("подписки в указанном окне", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+		until := time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC)
+		opts := model.FollowListOptions{Until: &until, IDsOnly: true}
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockFollowRepo.On("ListFollowees", ctx, int64(1), opts, int32(5), int32(0)).Return([]int64{8}, int64(1), nil)
+
+		list, err := svc.ListFollowees(ctx, 1, opts, 5, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{8}, list.UserIDs)
+		assert.Equal(t, int64(1), list.Total)
+	})
+}
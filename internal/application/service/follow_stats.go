@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// GetFollowSourceStats returns the daily follows per source for the UTC days from through to.
+// It is a reporting read for other backends, end users can not call it.
+func (s *Service) GetFollowSourceStats(ctx context.Context, from, to time.Time) ([]model.FollowSourceStat, error) {
+	s.logger(ctx).Info("GetFollowSourceStats request received", slog.Time("from", from), slog.Time("to", to))
+
+	if to.Before(from) || to.Sub(from) >= model.MaxFollowSourceStatsDays*24*time.Hour {
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	if err := s.authorizeService(ctx); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.ListDaily(ctx, from, to)
+	if err != nil {
+		s.logger(ctx).Error("Error listing follow source stats", slog.String("error", err.Error()))
+		return nil, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_FollowAttribution(t *testing.T) {
+	t.Run("источник подписки сохраняется и попадает в событие", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, mockUserClient := setupTest(t)
+		attribution := model.FollowAttribution{Source: model.FollowSourceBoard, ContextID: "board-42"}
+		ctx := model.ContextWithFollowAttribution(context.Background(), attribution)
+		followerID, followeeID := int64(1), int64(2)
+
+		mockUserClient.On("GetUser", ctx, followeeID).Return(&model.User{ID: followeeID}, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(false, nil)
+		mockFollowRepo.On("Create", ctx, followerID, followeeID, attribution).Return(model.Follower{
+			ID:         10,
+			FollowerID: followerID,
+			FolloweeID: followeeID,
+			Source:     attribution.Source,
+			ContextID:  attribution.ContextID,
+		}, true, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, followeeID, model.RelationActionFollow))
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(func(event model.OutboxEvent) bool {
+			var payload model.FollowCreatedPayload
+			return json.Unmarshal(event.Payload, &payload) == nil &&
+				event.AggregateID == 10 &&
+				payload.Source == model.FollowSourceBoard &&
+				payload.ContextID == "board-42"
+		})).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		err := svc.Follow(ctx, followerID, followeeID)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestService_GetFollowSourceStats(t *testing.T) {
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC)
+
+	t.Run("сервисный аккаунт получает статистику", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 100, ServiceAccount: true})
+		stats := []model.FollowSourceStat{{Day: from, Source: model.FollowSourceSearch, Follows: 12}}
+
+		svc.statsRepo.(*mocks.FollowStatsRepository).On("ListDaily", ctx, from, to).Return(stats, nil)
+
+		got, err := svc.GetFollowSourceStats(ctx, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, stats, got)
+	})
+
+	t.Run("обратный или слишком длинный период", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+
+		_, err := svc.GetFollowSourceStats(context.Background(), to, from)
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+
+		_, err = svc.GetFollowSourceStats(context.Background(), from, from.AddDate(0, 0, model.MaxFollowSourceStatsDays))
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+	})
+
+	t.Run("пользователь не видит статистику", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 1})
+
+		_, err := svc.GetFollowSourceStats(ctx, from, to)
+
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+	})
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/domain/ports/output/outbox"
+	"pinstack-relation-service/internal/infrastructure/utils"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// FollowTarget follows a user, board or tag. User targets go through Follow so they
+// keep the anti-spam rules and the follow_created event existing consumers rely on.
+func (s *Service) FollowTarget(ctx context.Context, followerID int64, target model.FollowTarget) (err error) {
+	if target.Type == model.TargetTypeUser {
+		return s.Follow(ctx, followerID, target.ID)
+	}
+
+	s.logger(ctx).Info("FollowTarget request received", slog.Int64("followerID", followerID), slog.String("targetType", string(target.Type)), slog.Int64("targetID", target.ID))
+
+	if err = s.authorizeActor(ctx, followerID); err != nil {
+		return err
+	}
+
+	if err = s.checkTargetExists(ctx, target); err != nil {
+		return err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	follow, err := tx.FollowRepository().CreateTarget(ctx, followerID, target)
+	if err != nil {
+		s.logger(ctx).Error("Error creating target follow", slog.String("error", err.Error()))
+		return err
+	}
+
+	created, _ := model.TargetFollowEventTypes(target.Type)
+	if err = s.addTargetFollowEvent(ctx, tx.OutboxRepository(), follow.ID, created, followerID, target); err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+
+	s.logger(ctx).Info("Target followed successfully", slog.Int64("followerID", followerID), slog.String("targetType", string(target.Type)), slog.Int64("targetID", target.ID))
+	return nil
+}
+
+// UnfollowTarget removes a follow of any target type, user targets go through Unfollow
+func (s *Service) UnfollowTarget(ctx context.Context, followerID int64, target model.FollowTarget) (err error) {
+	if target.Type == model.TargetTypeUser {
+		return s.Unfollow(ctx, followerID, target.ID)
+	}
+
+	s.logger(ctx).Info("UnfollowTarget request received", slog.Int64("followerID", followerID), slog.String("targetType", string(target.Type)), slog.Int64("targetID", target.ID))
+
+	if err = s.authorizeActor(ctx, followerID); err != nil {
+		return err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if err = tx.FollowRepository().DeleteTarget(ctx, followerID, target); err != nil {
+		s.logger(ctx).Error("Error deleting target follow", slog.String("error", err.Error()))
+		return err
+	}
+
+	// There is no row left to point at, so deletions are keyed by the follower
+	_, deleted := model.TargetFollowEventTypes(target.Type)
+	if err = s.addTargetFollowEvent(ctx, tx.OutboxRepository(), followerID, deleted, followerID, target); err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+
+	s.logger(ctx).Info("Target unfollowed successfully", slog.Int64("followerID", followerID), slog.String("targetType", string(target.Type)), slog.Int64("targetID", target.ID))
+	return nil
+}
+
+// ListFollowedTargets returns the ids of the targets of one type that followerID follows, newest first
+func (s *Service) ListFollowedTargets(ctx context.Context, followerID int64, targetType model.TargetType, limit, page int32) ([]int64, int64, error) {
+	s.logger(ctx).Info("ListFollowedTargets request received", slog.Int64("followerID", followerID), slog.String("targetType", string(targetType)))
+
+	limit, offset := utils.SetPaginationDefaults(limit, page)
+	targetIDs, total, err := s.followRepo.GetFollowedTargets(ctx, followerID, targetType, limit, offset)
+	if err != nil {
+		s.logger(ctx).Error("Error listing followed targets", slog.Int64("followerID", followerID), slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	return targetIDs, total, nil
+}
+
+func (s *Service) checkTargetExists(ctx context.Context, target model.FollowTarget) error {
+	var (
+		exists   bool
+		err      error
+		notFound error
+	)
+	if s.postClient == nil {
+		return model.ErrFollowTargetsDisabled
+	}
+	switch target.Type {
+	case model.TargetTypeBoard:
+		exists, err = s.postClient.BoardExists(ctx, target.ID)
+		notFound = model.ErrBoardNotFound
+	case model.TargetTypeTag:
+		exists, err = s.postClient.TagExists(ctx, target.ID)
+		notFound = custom_errors.ErrTagNotFound
+	default:
+		return custom_errors.ErrInvalidInput
+	}
+	if err != nil {
+		s.logger(ctx).Error("Failed to check follow target", slog.String("targetType", string(target.Type)), slog.Int64("targetID", target.ID), slog.String("error", err.Error()))
+		return err
+	}
+	if !exists {
+		return notFound
+	}
+	return nil
+}
+
+func (s *Service) addTargetFollowEvent(ctx context.Context, outboxRepo outbox.OutboxRepository, aggregateID int64, eventType events.EventType, followerID int64, target model.FollowTarget) error {
+	payload, err := json.Marshal(model.TargetFollowPayload{
+		FollowerID:  followerID,
+		TargetType:  target.Type,
+		TargetID:    target.ID,
+		Timestamptz: time.Now(),
+	})
+	if err != nil {
+		s.logger(ctx).Error("Failed to marshal payload", slog.String("error", err.Error()))
+		return err
+	}
+
+	err = outboxRepo.AddEvent(ctx, model.OutboxEvent{
+		EventType:   eventType,
+		Payload:     payload,
+		AggregateID: aggregateID,
+	})
+	if err != nil {
+		s.logger(ctx).Error("Error adding event to outbox", slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
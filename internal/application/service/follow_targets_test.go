@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "pinstack-relation-service/internal/domain/models"
+	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/mocks"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type targetsMocks struct {
+	followRepo *mocks.FollowRepository
+	uow        *mocks.UnitOfWork
+	tx         *mocks.Transaction
+	outboxRepo *mocks.OutboxRepository
+	userClient *mocks.Client
+	postClient *mocks.PostClient
+}
+
+func setupTargetsTest(t *testing.T) (*Service, targetsMocks) {
+	m := targetsMocks{
+		followRepo: mocks.NewFollowRepository(t),
+		uow:        mocks.NewUnitOfWork(t),
+		tx:         mocks.NewTransaction(t),
+		outboxRepo: mocks.NewOutboxRepository(t),
+		userClient: mocks.NewClient(t),
+		postClient: mocks.NewPostClient(t),
+	}
+	svc := NewFollowService(infra_logger.New("test"), m.followRepo, mocks.NewSuggestionRepository(t), mocks.NewProfileRepository(t), mocks.NewRelationHistoryRepository(t), mocks.NewIdempotencyRepository(t), mocks.NewMuteRepository(t), mocks.NewAudienceListRepository(t), mocks.NewFollowStatsRepository(t), mocks.NewFollowerGrowthRepository(t), m.uow, m.userClient, m.postClient, newSuggestionCache(t), model.FollowLimits{}, model.AudienceListPolicy{}, testIdempotencyTTL)
+	return svc, m
+}
+
+func TestService_FollowTarget(t *testing.T) {
+	board := model.FollowTarget{Type: model.TargetTypeBoard, ID: 7}
+
+	t.Run("успешная подписка на доску", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.postClient.On("BoardExists", ctx, int64(7)).Return(true, nil)
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.tx.On("OutboxRepository").Return(m.outboxRepo)
+		m.followRepo.On("CreateTarget", ctx, int64(1), board).
+			Return(model.Follower{ID: 11, FollowerID: 1, FolloweeID: 7, TargetType: model.TargetTypeBoard}, nil)
+
+		var event model.OutboxEvent
+		m.outboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).
+			Run(func(args mock.Arguments) { event = args.Get(1).(model.OutboxEvent) }).
+			Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		require.NoError(t, svc.FollowTarget(ctx, 1, board))
+
+		assert.Equal(t, model.EventTypeBoardFollowCreated, event.EventType)
+		assert.Equal(t, int64(11), event.AggregateID)
+		var payload model.TargetFollowPayload
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		assert.Equal(t, int64(1), payload.FollowerID)
+		assert.Equal(t, model.TargetTypeBoard, payload.TargetType)
+		assert.Equal(t, int64(7), payload.TargetID)
+	})
+
+	t.Run("доска не найдена", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.postClient.On("BoardExists", ctx, int64(7)).Return(false, nil)
+
+		err := svc.FollowTarget(ctx, 1, board)
+
+		assert.ErrorIs(t, err, model.ErrBoardNotFound)
+		m.uow.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("тег не найден", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.postClient.On("TagExists", ctx, int64(3)).Return(false, nil)
+
+		err := svc.FollowTarget(ctx, 1, model.FollowTarget{Type: model.TargetTypeTag, ID: 3})
+
+		assert.ErrorIs(t, err, custom_errors.ErrTagNotFound)
+	})
+
+	t.Run("ошибка сервиса постов", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.postClient.On("BoardExists", ctx, int64(7)).Return(false, custom_errors.ErrExternalServiceError)
+
+		err := svc.FollowTarget(ctx, 1, board)
+
+		assert.ErrorIs(t, err, custom_errors.ErrExternalServiceError)
+	})
+
+	t.Run("без клиента сервиса постов подписки на доски отключены", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		svc.postClient = nil
+
+		err := svc.FollowTarget(context.Background(), 1, board)
+
+		assert.ErrorIs(t, err, model.ErrFollowTargetsDisabled)
+		m.uow.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("повторная подписка откатывает транзакцию", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.postClient.On("BoardExists", ctx, int64(7)).Return(true, nil)
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.followRepo.On("CreateTarget", ctx, int64(1), board).Return(model.Follower{}, custom_errors.ErrFollowRelationExists)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		err := svc.FollowTarget(ctx, 1, board)
+
+		assert.ErrorIs(t, err, custom_errors.ErrFollowRelationExists)
+		m.outboxRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("подписка на пользователя идёт через Follow", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.userClient.On("GetUser", ctx, int64(2)).Return(nil, custom_errors.ErrUserNotFound)
+
+		err := svc.FollowTarget(ctx, 1, model.FollowTarget{Type: model.TargetTypeUser, ID: 2})
+
+		assert.ErrorIs(t, err, custom_errors.ErrUserNotFound)
+		m.postClient.AssertNotCalled(t, "BoardExists", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_UnfollowTarget(t *testing.T) {
+	tag := model.FollowTarget{Type: model.TargetTypeTag, ID: 3}
+
+	t.Run("успешная отписка от тега", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.tx.On("OutboxRepository").Return(m.outboxRepo)
+		m.followRepo.On("DeleteTarget", ctx, int64(1), tag).Return(nil)
+		m.outboxRepo.On("AddEvent", ctx, mock.MatchedBy(func(event model.OutboxEvent) bool {
+			return event.EventType == model.EventTypeTagFollowDeleted && event.AggregateID == 1
+		})).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		require.NoError(t, svc.UnfollowTarget(ctx, 1, tag))
+	})
+
+	t.Run("подписка не найдена", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.followRepo.On("DeleteTarget", ctx, int64(1), tag).Return(custom_errors.ErrFollowRelationNotFound)
+		m.tx.On("Rollback", ctx).Return(nil)
+
+		err := svc.UnfollowTarget(ctx, 1, tag)
+
+		assert.ErrorIs(t, err, custom_errors.ErrFollowRelationNotFound)
+	})
+
+	t.Run("отписка от пользователя идёт через Unfollow", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.uow.On("Begin", ctx).Return(m.tx, nil)
+		m.tx.On("FollowRepository").Return(m.followRepo)
+		m.tx.On("OutboxRepository").Return(m.outboxRepo)
+		m.followRepo.On("Delete", ctx, int64(1), int64(2)).Return(model.Follower{ID: 10, FollowerID: int64(1), FolloweeID: int64(2)}, nil)
+		expectRelationHistory(t, m.tx, userHistoryEntry(1, 2, model.RelationActionUnfollow))
+		m.outboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil)
+		m.tx.On("Commit", ctx).Return(nil)
+
+		require.NoError(t, svc.UnfollowTarget(ctx, 1, model.FollowTarget{Type: model.TargetTypeUser, ID: 2}))
+		m.followRepo.AssertNotCalled(t, "DeleteTarget", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_ListFollowedTargets(t *testing.T) {
+	t.Run("список досок с пагинацией", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.followRepo.On("GetFollowedTargets", ctx, int64(1), model.TargetTypeBoard, int32(2), int32(2)).Return([]int64{9, 8}, int64(5), nil)
+
+		targetIDs, total, err := svc.ListFollowedTargets(ctx, 1, model.TargetTypeBoard, 2, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{9, 8}, targetIDs)
+		assert.Equal(t, int64(5), total)
+	})
+
+	t.Run("ошибка базы данных", func(t *testing.T) {
+		svc, m := setupTargetsTest(t)
+		ctx := context.Background()
+
+		m.followRepo.On("GetFollowedTargets", ctx, int64(1), model.TargetTypeTag, mock.Anything, mock.Anything).Return(nil, int64(0), errors.New("db error"))
+
+		_, _, err := svc.ListFollowedTargets(ctx, 1, model.TargetTypeTag, 10, 1)
+
+		assert.Error(t, err)
+	})
+}
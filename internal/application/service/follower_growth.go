@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// GetFollowerGrowth returns the followers userID gained and lost on the UTC days from through
+// to, bucketed by granularity, with the follower count at the end of every bucket.
+func (s *Service) GetFollowerGrowth(ctx context.Context, userID int64, from, to time.Time, granularity model.FollowerGrowthGranularity) ([]model.FollowerGrowthBucket, error) {
+	s.logger(ctx).Info("GetFollowerGrowth request received",
+		slog.Int64("userID", userID),
+		slog.Time("from", from),
+		slog.Time("to", to),
+		slog.String("granularity", string(granularity)))
+
+	if !granularity.Valid() || to.Before(from) || to.Sub(from) >= model.MaxFollowerGrowthDays*24*time.Hour {
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	if err := s.authorizeReader(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	days, err := s.growthRepo.ListDaily(ctx, userID, from, to)
+	if err != nil {
+		s.logger(ctx).Error("Error listing follower growth", slog.Int64("userID", userID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	total, err := s.growthRepo.TotalAt(ctx, userID, to)
+	if err != nil {
+		s.logger(ctx).Error("Error counting followers", slog.Int64("userID", userID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return followerGrowthBuckets(days, total, from, to, granularity), nil
+}
+
+// followerGrowthBuckets sums days into every bucket between from and to, empty buckets
+// included, and walks the totals back from total, the follower count at the end of to.
+func followerGrowthBuckets(days []model.FollowerGrowthDay, total int64, from, to time.Time, granularity model.FollowerGrowthGranularity) []model.FollowerGrowthBucket {
+	buckets := make([]model.FollowerGrowthBucket, 0)
+	index := make(map[time.Time]int)
+	last := granularity.BucketStart(to)
+	for start := granularity.BucketStart(from); !start.After(last); start = granularity.Next(start) {
+		index[start] = len(buckets)
+		buckets = append(buckets, model.FollowerGrowthBucket{Start: start})
+	}
+
+	for _, day := range days {
+		i, ok := index[granularity.BucketStart(day.Day)]
+		if !ok {
+			continue
+		}
+		buckets[i].Gained += day.Gained
+		buckets[i].Lost += day.Lost
+		buckets[i].Net += day.Gained - day.Lost
+	}
+
+	for i := len(buckets) - 1; i >= 0; i-- {
+		buckets[i].Total = total
+		total -= buckets[i].Net
+	}
+	return buckets
+}
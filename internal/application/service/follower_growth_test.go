@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetFollowerGrowth(t *testing.T) {
+	// Wednesday 4 June through Tuesday 17 June touches three Monday weeks
+	from := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 17, 0, 0, 0, 0, time.UTC)
+	days := []model.FollowerGrowthDay{
+		{Day: time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC), Gained: 5, Lost: 1},
+		{Day: time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC), Gained: 2},
+		{Day: time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC), Gained: 1, Lost: 4},
+	}
+
+	t.Run("рост по неделям с итогами", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 1})
+		growthRepo := svc.growthRepo.(*mocks.FollowerGrowthRepository)
+		growthRepo.On("ListDaily", ctx, int64(1), from, to).Return(days, nil)
+		growthRepo.On("TotalAt", ctx, int64(1), to).Return(int64(50), nil)
+
+		buckets, err := svc.GetFollowerGrowth(ctx, 1, from, to, model.FollowerGrowthWeekly)
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.FollowerGrowthBucket{
+			{Start: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC), Gained: 7, Lost: 1, Net: 6, Total: 53},
+			{Start: time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC), Total: 53},
+			{Start: time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC), Gained: 1, Lost: 4, Net: -3, Total: 50},
+		}, buckets)
+	})
+
+	t.Run("рост по месяцам", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		growthRepo := svc.growthRepo.(*mocks.FollowerGrowthRepository)
+		growthRepo.On("ListDaily", mock.Anything, int64(1), from, to).Return(days, nil)
+		growthRepo.On("TotalAt", mock.Anything, int64(1), to).Return(int64(50), nil)
+
+		buckets, err := svc.GetFollowerGrowth(context.Background(), 1, from, to, model.FollowerGrowthMonthly)
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.FollowerGrowthBucket{
+			{Start: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), Gained: 8, Lost: 5, Net: 3, Total: 50},
+		}, buckets)
+	})
+
+	t.Run("неверный период или гранулярность", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+
+		_, err := svc.GetFollowerGrowth(context.Background(), 1, to, from, model.FollowerGrowthDaily)
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+
+		_, err = svc.GetFollowerGrowth(context.Background(), 1, from, from.AddDate(0, 0, model.MaxFollowerGrowthDays), model.FollowerGrowthDaily)
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+
+		_, err = svc.GetFollowerGrowth(context.Background(), 1, from, to, "year")
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+	})
+
+	t.Run("чужой рост недоступен", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 2})
+
+		_, err := svc.GetFollowerGrowth(ctx, 1, from, to, model.FollowerGrowthDaily)
+
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+	})
+
+	t.Run("ошибка базы данных", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		svc.growthRepo.(*mocks.FollowerGrowthRepository).On("ListDaily", mock.Anything, int64(1), from, to).Return(nil, custom_errors.ErrDatabaseQuery)
+
+		_, err := svc.GetFollowerGrowth(context.Background(), 1, from, to, model.FollowerGrowthDaily)
+
+		assert.Equal(t, custom_errors.ErrDatabaseQuery, err)
+	})
+}
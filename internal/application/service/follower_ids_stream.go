@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+)
+
+// StreamFollowerIDs walks the followers of userID in ascending id order starting after cursor
+// and hands each chunk to send. Send blocking is the flow control: the next chunk is only
+// read once the previous one was accepted. The user service is never called, so an unknown
+// user simply has no followers.
+func (s *Service) StreamFollowerIDs(ctx context.Context, userID, cursor int64, chunkSize int32, send func(followerIDs []int64, nextCursor int64) error) error {
+	s.logger(ctx).Info("StreamFollowerIDs request received", slog.Int64("userID", userID), slog.Int64("cursor", cursor))
+
+	if err := s.authorizeService(ctx); err != nil {
+		return err
+	}
+
+	if chunkSize <= 0 {
+		chunkSize = model.DefaultFollowerIDChunkSize
+	}
+	if chunkSize > model.MaxFollowerIDChunkSize {
+		chunkSize = model.MaxFollowerIDChunkSize
+	}
+
+	var sent int
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		followerIDs, err := s.followRepo.GetFollowerIDsAfter(ctx, userID, cursor, chunkSize)
+		if err != nil {
+			s.logger(ctx).Error("Failed to get follower ids chunk",
+				slog.Int64("userID", userID),
+				slog.Int64("cursor", cursor),
+				slog.String("error", err.Error()))
+			return err
+		}
+		if len(followerIDs) == 0 {
+			break
+		}
+
+		cursor = followerIDs[len(followerIDs)-1]
+		if err := send(followerIDs, cursor); err != nil {
+			s.logger(ctx).Warn("Failed to send follower ids chunk",
+				slog.Int64("userID", userID),
+				slog.Int64("cursor", cursor),
+				slog.String("error", err.Error()))
+			return err
+		}
+		sent += len(followerIDs)
+
+		if len(followerIDs) < int(chunkSize) {
+			break
+		}
+	}
+
+	s.logger(ctx).Debug("StreamFollowerIDs completed", slog.Int64("userID", userID), slog.Int("sent", sent))
+	return nil
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	model "pinstack-relation-service/internal/domain/models"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type sentChunk struct {
+	followerIDs []int64
+	nextCursor  int64
+}
+
+func collectChunks(chunks *[]sentChunk) func([]int64, int64) error {
+	return func(followerIDs []int64, nextCursor int64) error {
+		*chunks = append(*chunks, sentChunk{followerIDs: followerIDs, nextCursor: nextCursor})
+		return nil
+	}
+}
+
+func TestService_StreamFollowerIDs(t *testing.T) {
+	t.Run("все подписчики отправлены чанками", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(1), int64(0), int32(2)).Return([]int64{3, 5}, nil).Once()
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(1), int64(5), int32(2)).Return([]int64{8, 9}, nil).Once()
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(1), int64(9), int32(2)).Return([]int64{12}, nil).Once()
+
+		var chunks []sentChunk
+		err := svc.StreamFollowerIDs(ctx, 1, 0, 2, collectChunks(&chunks))
+
+		require.NoError(t, err)
+		assert.Equal(t, []sentChunk{
+			{followerIDs: []int64{3, 5}, nextCursor: 5},
+			{followerIDs: []int64{8, 9}, nextCursor: 9},
+			{followerIDs: []int64{12}, nextCursor: 12},
+		}, chunks)
+		mockUserClient.AssertNotCalled(t, "GetUser", mock.Anything, mock.Anything)
+	})
+
+	t.Run("продолжение с курсора", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(1), int64(9), int32(2)).Return([]int64{12}, nil).Once()
+
+		var chunks []sentChunk
+		require.NoError(t, svc.StreamFollowerIDs(ctx, 1, 9, 2, collectChunks(&chunks)))
+		assert.Equal(t, []sentChunk{{followerIDs: []int64{12}, nextCursor: 12}}, chunks)
+	})
+
+	t.Run("полный последний чанк завершается пустым запросом", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(1), int64(0), int32(2)).Return([]int64{3, 5}, nil).Once()
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(1), int64(5), int32(2)).Return([]int64{}, nil).Once()
+
+		var chunks []sentChunk
+		require.NoError(t, svc.StreamFollowerIDs(ctx, 1, 0, 2, collectChunks(&chunks)))
+		assert.Len(t, chunks, 1)
+	})
+
+	t.Run("размер чанка по умолчанию и ограничение сверху", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(1), int64(0), int32(model.DefaultFollowerIDChunkSize)).Return([]int64{}, nil).Once()
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(2), int64(0), int32(model.MaxFollowerIDChunkSize)).Return([]int64{}, nil).Once()
+
+		var chunks []sentChunk
+		require.NoError(t, svc.StreamFollowerIDs(ctx, 1, 0, 0, collectChunks(&chunks)))
+		require.NoError(t, svc.StreamFollowerIDs(ctx, 2, 0, model.MaxFollowerIDChunkSize+1, collectChunks(&chunks)))
+		assert.Empty(t, chunks)
+	})
+
+	t.Run("ошибка отправки останавливает поток", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+		sendErr := errors.New("stream closed")
+
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(1), int64(0), int32(2)).Return([]int64{3, 5}, nil).Once()
+
+		err := svc.StreamFollowerIDs(ctx, 1, 0, 2, func([]int64, int64) error { return sendErr })
+
+		assert.ErrorIs(t, err, sendErr)
+	})
+
+	t.Run("отменённый контекст", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var chunks []sentChunk
+		err := svc.StreamFollowerIDs(ctx, 1, 0, 2, collectChunks(&chunks))
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Empty(t, chunks)
+	})
+
+	t.Run("ошибка базы данных", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(1), int64(0), int32(2)).Return(nil, custom_errors.ErrDatabaseQuery)
+
+		var chunks []sentChunk
+		err := svc.StreamFollowerIDs(ctx, 1, 0, 2, collectChunks(&chunks))
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+
+	t.Run("пользователь без сервисного аккаунта", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 1})
+
+		var chunks []sentChunk
+		err := svc.StreamFollowerIDs(ctx, 1, 0, 2, collectChunks(&chunks))
+
+		assert.ErrorIs(t, err, custom_errors.ErrForbidden)
+	})
+
+	t.Run("сервисный аккаунт", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 100, ServiceAccount: true})
+
+		mockFollowRepo.On("GetFollowerIDsAfter", ctx, int64(1), int64(0), int32(2)).Return([]int64{3}, nil).Once()
+
+		var chunks []sentChunk
+		require.NoError(t, svc.StreamFollowerIDs(ctx, 1, 0, 2, collectChunks(&chunks)))
+		assert.Len(t, chunks, 1)
+	})
+}
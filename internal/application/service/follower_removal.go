@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/domain/ports/output/uow"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// RemoveFollower ends the follow of followerID on ownerID at the request of ownerID
+func (s *Service) RemoveFollower(ctx context.Context, ownerID, followerID int64) (err error) {
+	s.logger(ctx).Info("RemoveFollower request received", slog.Int64("ownerID", ownerID), slog.Int64("followerID", followerID))
+
+	if ownerID == followerID {
+		return custom_errors.ErrSelfUnfollow
+	}
+
+	if err = s.authorizeActor(ctx, ownerID); err != nil {
+		return err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if _, err = tx.FollowRepository().Delete(ctx, followerID, ownerID); err != nil {
+		s.logger(ctx).Error("Error removing follower", slog.String("error", err.Error()))
+		return err
+	}
+
+	if err = s.recordFollowerRemoval(ctx, tx, ownerID, followerID); err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	s.suggestionCache.Invalidate(ctx, followerID)
+
+	s.logger(ctx).Info("Follower removed successfully", slog.Int64("ownerID", ownerID), slog.Int64("followerID", followerID))
+	return nil
+}
+
+// RemoveFollowers removes many followers of ownerID in one transaction. Users that do not
+// follow ownerID are skipped, the ids of the removed followers are returned.
+func (s *Service) RemoveFollowers(ctx context.Context, ownerID int64, followerIDs []int64) (removed []int64, err error) {
+	s.logger(ctx).Info("RemoveFollowers request received", slog.Int64("ownerID", ownerID), slog.Int("followers", len(followerIDs)))
+
+	uniqueIDs := make([]int64, 0, len(followerIDs))
+	seen := make(map[int64]struct{}, len(followerIDs))
+	for _, followerID := range followerIDs {
+		if followerID == ownerID {
+			return nil, custom_errors.ErrSelfUnfollow
+		}
+		if _, ok := seen[followerID]; ok {
+			continue
+		}
+		seen[followerID] = struct{}{}
+		uniqueIDs = append(uniqueIDs, followerID)
+	}
+
+	if len(uniqueIDs) > model.MaxRemoveFollowers {
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	if err = s.authorizeActor(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
+	if len(uniqueIDs) == 0 {
+		return []int64{}, nil
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	removed, err = tx.FollowRepository().DeleteFollowers(ctx, ownerID, uniqueIDs)
+	if err != nil {
+		s.logger(ctx).Error("Error removing followers", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	for _, followerID := range removed {
+		if err = s.recordFollowerRemoval(ctx, tx, ownerID, followerID); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	for _, followerID := range removed {
+		s.suggestionCache.Invalidate(ctx, followerID)
+	}
+
+	s.logger(ctx).Info("Followers removed successfully", slog.Int64("ownerID", ownerID), slog.Int("removed", len(removed)))
+	return removed, nil
+}
+
+// recordFollowerRemoval writes the history record and the follower_removed event of a removal,
+// and takes the follower off the audience lists of the owner
+func (s *Service) recordFollowerRemoval(ctx context.Context, tx uow.Transaction, ownerID, followerID int64) error {
+	if err := s.recordRelationChange(ctx, tx, historyEntry(ctx, ownerID, followerID, ownerID, model.RelationActionRemove)); err != nil {
+		return err
+	}
+
+	if err := s.revokeAudienceMembership(ctx, tx, followerID, ownerID); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(model.FollowerRemovedPayload{
+		OwnerID:     ownerID,
+		FollowerID:  followerID,
+		Timestamptz: time.Now(),
+	})
+	if err != nil {
+		s.logger(ctx).Error("Failed to marshal payload", slog.String("error", err.Error()))
+		return err
+	}
+
+	err = tx.OutboxRepository().AddEvent(ctx, model.OutboxEvent{
+		EventType:   model.EventTypeFollowerRemoved,
+		Payload:     payload,
+		AggregateID: ownerID,
+	})
+	if err != nil {
+		s.logger(ctx).Error("Error adding event to outbox", slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
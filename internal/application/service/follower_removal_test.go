@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "pinstack-relation-service/internal/domain/models"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// removalHistoryEntry is the history record of ownerID removing followerID themselves
+func removalHistoryEntry(ownerID, followerID int64) model.RelationHistoryEntry {
+	return model.RelationHistoryEntry{
+		FollowerID: followerID,
+		FolloweeID: ownerID,
+		Action:     model.RelationActionRemove,
+		ActorID:    &ownerID,
+		Reason:     model.RelationReasonUser,
+	}
+}
+
+func isFollowerRemovedEvent(ownerID, followerID int64) func(model.OutboxEvent) bool {
+	return func(event model.OutboxEvent) bool {
+		var payload model.FollowerRemovedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return false
+		}
+		return event.EventType == model.EventTypeFollowerRemoved &&
+			event.AggregateID == ownerID &&
+			payload.OwnerID == ownerID &&
+			payload.FollowerID == followerID
+	}
+}
+
+func TestService_RemoveFollower(t *testing.T) {
+	t.Run("успешное удаление подписчика", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
+		ctx := context.Background()
+		ownerID, followerID := int64(1), int64(2)
+
+		svc.suggestionCache.Set(ctx, followerID, []model.Suggestion{{UserID: 3}})
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Delete", ctx, followerID, ownerID).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: ownerID}, nil)
+		expectRelationHistory(t, mockTx, removalHistoryEntry(ownerID, followerID))
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowerRemovedEvent(ownerID, followerID))).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		err := svc.RemoveFollower(ctx, ownerID, followerID)
+
+		require.NoError(t, err)
+		_, ok := svc.suggestionCache.Get(ctx, followerID)
+		assert.False(t, ok)
+	})
+
+	t.Run("удаление самого себя", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+
+		err := svc.RemoveFollower(context.Background(), 1, 1)
+
+		assert.Equal(t, custom_errors.ErrSelfUnfollow, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("удалять подписчика может только тот, на кого подписаны", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 2})
+
+		err := svc.RemoveFollower(ctx, 1, 2)
+
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("пользователь не подписан", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockFollowRepo.On("Delete", ctx, int64(2), int64(1)).Return(model.Follower{}, custom_errors.ErrFollowRelationNotFound)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := svc.RemoveFollower(ctx, 1, 2)
+
+		assert.ErrorIs(t, err, custom_errors.ErrFollowRelationNotFound)
+		mockTx.AssertNotCalled(t, "OutboxRepository")
+	})
+
+	t.Run("ошибка outbox откатывает удаление", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Delete", ctx, int64(2), int64(1)).Return(model.Follower{ID: 10, FollowerID: 2, FolloweeID: 1}, nil)
+		expectRelationHistory(t, mockTx, removalHistoryEntry(1, 2))
+		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(errors.New("outbox error"))
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := svc.RemoveFollower(ctx, 1, 2)
+
+		assert.Error(t, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestService_RemoveFollowers(t *testing.T) {
+	t.Run("удаляются только существующие подписчики", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
+		ctx := context.Background()
+		ownerID := int64(1)
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("DeleteFollowers", ctx, ownerID, []int64{2, 3, 4}).Return([]int64{2, 4}, nil)
+		historyRepo := newHistoryRepoForTx(t, mockTx)
+		historyRepo.On("Record", ctx, removalHistoryEntry(ownerID, 2)).Return(nil).Once()
+		historyRepo.On("Record", ctx, removalHistoryEntry(ownerID, 4)).Return(nil).Once()
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowerRemovedEvent(ownerID, 2))).Return(nil).Once()
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowerRemovedEvent(ownerID, 4))).Return(nil).Once()
+		mockTx.On("Commit", ctx).Return(nil)
+
+		removed, err := svc.RemoveFollowers(ctx, ownerID, []int64{2, 3, 2, 4})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 4}, removed)
+	})
+
+	t.Run("никто из списка не подписан", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockFollowRepo.On("DeleteFollowers", ctx, int64(1), []int64{5}).Return([]int64{}, nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		removed, err := svc.RemoveFollowers(ctx, 1, []int64{5})
+
+		require.NoError(t, err)
+		assert.Empty(t, removed)
+		mockTx.AssertNotCalled(t, "OutboxRepository")
+	})
+
+	t.Run("себя нельзя удалить из подписчиков", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+
+		_, err := svc.RemoveFollowers(context.Background(), 1, []int64{2, 1})
+
+		assert.Equal(t, custom_errors.ErrSelfUnfollow, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("слишком много подписчиков", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+		followerIDs := make([]int64, 0, model.MaxRemoveFollowers+1)
+		for i := 0; i <= model.MaxRemoveFollowers; i++ {
+			followerIDs = append(followerIDs, int64(i+2))
+		}
+
+		_, err := svc.RemoveFollowers(context.Background(), 1, followerIDs)
+
+		assert.ErrorIs(t, err, custom_errors.ErrInvalidInput)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("ошибка удаления", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockFollowRepo.On("DeleteFollowers", ctx, int64(1), []int64{2}).Return(nil, custom_errors.ErrFollowRelationDeleteFail)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.RemoveFollowers(ctx, 1, []int64{2})
+
+		assert.ErrorIs(t, err, custom_errors.ErrFollowRelationDeleteFail)
+	})
+}
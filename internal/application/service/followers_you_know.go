@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+)
+
+// GetFollowersYouKnow returns a sample of the target's followers that the viewer follows,
+// along with the size of the whole intersection.
+func (s *Service) GetFollowersYouKnow(ctx context.Context, viewerID, targetID int64, limit int32) ([]*model.User, int64, error) {
+	s.logger(ctx).Info("GetFollowersYouKnow request received", slog.Int64("viewerID", viewerID), slog.Int64("targetID", targetID))
+
+	if viewerID == targetID {
+		return []*model.User{}, 0, nil
+	}
+
+	knownIDs, total, err := s.followRepo.GetFollowersYouKnow(ctx, viewerID, targetID, limit)
+	if err != nil {
+		s.logger(ctx).Error("Error getting followers you know", slog.Int64("viewerID", viewerID), slog.Int64("targetID", targetID), slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	known := s.resolveUsers(ctx, knownIDs)
+
+	s.logger(ctx).Info("Followers you know retrieved successfully", slog.Int64("viewerID", viewerID), slog.Int64("targetID", targetID), slog.Int("count", len(known)), slog.Int64("total", total))
+	return known, total, nil
+}
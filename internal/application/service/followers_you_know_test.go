@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	model "pinstack-relation-service/internal/domain/models"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetFollowersYouKnow(t *testing.T) {
+	t.Run("успешное получение общих знакомых", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockFollowRepo.On("GetFollowersYouKnow", ctx, int64(1), int64(2), int32(2)).Return([]int64{3, 4}, int64(14), nil)
+		mockUserClient.On("GetUser", ctx, int64(3)).Return(&model.User{ID: 3, Username: "alice"}, nil)
+		mockUserClient.On("GetUser", ctx, int64(4)).Return(&model.User{ID: 4, Username: "bob"}, nil)
+
+		known, total, err := svc.GetFollowersYouKnow(ctx, 1, 2, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(14), total)
+		require.Len(t, known, 2)
+		assert.Equal(t, "alice", known[0].Username)
+		assert.Equal(t, "bob", known[1].Username)
+		mockFollowRepo.AssertExpectations(t)
+		mockUserClient.AssertExpectations(t)
+	})
+
+	t.Run("свой профиль не имеет общих знакомых", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+
+		known, total, err := svc.GetFollowersYouKnow(context.Background(), 1, 1, 3)
+
+		require.NoError(t, err)
+		assert.Empty(t, known)
+		assert.Equal(t, int64(0), total)
+		mockFollowRepo.AssertNotCalled(t, "GetFollowersYouKnow")
+	})
+
+	t.Run("ошибка базы данных", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockFollowRepo.On("GetFollowersYouKnow", ctx, int64(1), int64(2), int32(3)).Return(nil, int64(0), custom_errors.ErrDatabaseQuery)
+
+		known, _, err := svc.GetFollowersYouKnow(ctx, 1, 2, 3)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+		assert.Nil(t, known)
+		mockUserClient.AssertNotCalled(t, "GetUser")
+	})
+}
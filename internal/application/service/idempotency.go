@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/domain/ports/output/uow"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// replayIdempotent reports whether the request carrying the idempotency key of ctx already
+// completed, so it must not run again. A key reused for a different request is rejected.
+func (s *Service) replayIdempotent(ctx context.Context, userID int64, operation model.IdempotentOperation, targetID int64) (bool, error) {
+	key := model.IdempotencyKeyFromContext(ctx)
+	if key == "" {
+		return false, nil
+	}
+
+	record, found, err := s.idempotencyRepo.Get(ctx, userID, key)
+	if err != nil {
+		s.logger(ctx).Error("Error getting idempotency key", slog.Int64("userID", userID), slog.String("error", err.Error()))
+		return false, err
+	}
+	if !found {
+		return false, nil
+	}
+
+	return s.matchIdempotent(ctx, record, operation, targetID)
+}
+
+// claimIdempotencyKey stores the idempotency key of ctx in tx so it commits together with the
+// request. When a concurrent request with the same key completed first it reports false, and
+// the request must not run again.
+func (s *Service) claimIdempotencyKey(ctx context.Context, tx uow.Transaction, userID int64, operation model.IdempotentOperation, targetID int64) (bool, error) {
+	key := model.IdempotencyKeyFromContext(ctx)
+	if key == "" {
+		return true, nil
+	}
+
+	idempotencyRepo := tx.IdempotencyRepository()
+	claimed, err := idempotencyRepo.Claim(ctx, model.IdempotencyRecord{
+		UserID:    userID,
+		Key:       key,
+		Operation: operation,
+		TargetID:  targetID,
+		ExpiresAt: time.Now().Add(s.idempotencyTTL),
+	})
+	if err != nil {
+		s.logger(ctx).Error("Error claiming idempotency key", slog.Int64("userID", userID), slog.String("error", err.Error()))
+		return false, err
+	}
+	if claimed {
+		return true, nil
+	}
+
+	record, found, err := idempotencyRepo.Get(ctx, userID, key)
+	if err != nil {
+		s.logger(ctx).Error("Error getting idempotency key", slog.Int64("userID", userID), slog.String("error", err.Error()))
+		return false, err
+	}
+	if !found {
+		// The record expired in between, which only happens with a TTL shorter than the request
+		return false, custom_errors.ErrDatabaseQuery
+	}
+
+	replay, err := s.matchIdempotent(ctx, record, operation, targetID)
+	return !replay, err
+}
+
+func (s *Service) matchIdempotent(ctx context.Context, record model.IdempotencyRecord, operation model.IdempotentOperation, targetID int64) (bool, error) {
+	if !record.Matches(operation, targetID) {
+		s.logger(ctx).Warn("Idempotency key reused for a different request",
+			slog.Int64("userID", record.UserID),
+			slog.String("operation", string(operation)),
+			slog.String("storedOperation", string(record.Operation)))
+		return false, custom_errors.ErrInvalidInput
+	}
+
+	s.logger(ctx).Info("Replaying completed request",
+		slog.Int64("userID", record.UserID),
+		slog.String("operation", string(operation)),
+		slog.Int64("targetID", targetID))
+	return true, nil
+}
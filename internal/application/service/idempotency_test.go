@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/mocks"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testIdempotencyKey = "retry-key-1"
+
+func idempotencyRecord(userID int64, operation model.IdempotentOperation, targetID int64) model.IdempotencyRecord {
+	return model.IdempotencyRecord{
+		UserID:    userID,
+		Key:       testIdempotencyKey,
+		Operation: operation,
+		TargetID:  targetID,
+		ExpiresAt: time.Now().Add(testIdempotencyTTL),
+	}
+}
+
+func isIdempotencyClaim(userID int64, operation model.IdempotentOperation, targetID int64) func(model.IdempotencyRecord) bool {
+	return func(record model.IdempotencyRecord) bool {
+		return record.UserID == userID &&
+			record.Key == testIdempotencyKey &&
+			record.Operation == operation &&
+			record.TargetID == targetID &&
+			record.ExpiresAt.After(time.Now())
+	}
+}
+
+// newIdempotencyRepoForTx makes tx hand out an idempotency repository mock
+func newIdempotencyRepoForTx(t *testing.T, tx *mocks.Transaction) *mocks.IdempotencyRepository {
+	idempotencyRepo := mocks.NewIdempotencyRepository(t)
+	tx.On("IdempotencyRepository").Return(idempotencyRepo)
+	return idempotencyRepo
+}
+
+func TestService_FollowIdempotency(t *testing.T) {
+	t.Run("повторный запрос с тем же ключом возвращает успех", func(t *testing.T) {
+		svc, _, mockUOW, _, _, mockUserClient := setupTest(t)
+		ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+
+		svc.idempotencyRepo.(*mocks.IdempotencyRepository).On("Get", ctx, int64(1), testIdempotencyKey).
+			Return(idempotencyRecord(1, model.IdempotentOperationFollow, 2), true, nil)
+
+		err := svc.Follow(ctx, 1, 2)
+
+		require.NoError(t, err)
+		mockUserClient.AssertNotCalled(t, "GetUser", mock.Anything, mock.Anything)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("ключ использован для другого запроса", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+		ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+
+		svc.idempotencyRepo.(*mocks.IdempotencyRepository).On("Get", ctx, int64(1), testIdempotencyKey).
+			Return(idempotencyRecord(1, model.IdempotentOperationFollow, 3), true, nil)
+
+		err := svc.Follow(ctx, 1, 2)
+
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("ключ сохраняется в транзакции подписки", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, mockUserClient := setupTest(t)
+		ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+		followerID, followeeID := int64(1), int64(2)
+
+		svc.idempotencyRepo.(*mocks.IdempotencyRepository).On("Get", ctx, followerID, testIdempotencyKey).
+			Return(model.IdempotencyRecord{}, false, nil)
+		mockUserClient.On("GetUser", ctx, followeeID).Return(&model.User{ID: followeeID}, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(false, nil)
+		newIdempotencyRepoForTx(t, mockTx).On("Claim", ctx, mock.MatchedBy(isIdempotencyClaim(followerID, model.IdempotentOperationFollow, followeeID))).Return(true, nil)
+		mockFollowRepo.On("Create", ctx, followerID, followeeID, model.FollowAttribution{}).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, true, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, followeeID, model.RelationActionFollow))
+		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		err := svc.Follow(ctx, followerID, followeeID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("параллельный запрос с тем же ключом завершился первым", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, mockUserClient := setupTest(t)
+		ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+		followerID, followeeID := int64(1), int64(2)
+
+		svc.idempotencyRepo.(*mocks.IdempotencyRepository).On("Get", ctx, followerID, testIdempotencyKey).
+			Return(model.IdempotencyRecord{}, false, nil)
+		mockUserClient.On("GetUser", ctx, followeeID).Return(&model.User{ID: followeeID}, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(false, nil)
+		txIdempotencyRepo := newIdempotencyRepoForTx(t, mockTx)
+		txIdempotencyRepo.On("Claim", ctx, mock.AnythingOfType("model.IdempotencyRecord")).Return(false, nil)
+		txIdempotencyRepo.On("Get", ctx, followerID, testIdempotencyKey).Return(idempotencyRecord(followerID, model.IdempotentOperationFollow, followeeID), true, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := svc.Follow(ctx, followerID, followeeID)
+
+		require.NoError(t, err)
+		mockFollowRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+
+	t.Run("параллельные запросы с одним ключом создают одну подписку", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, mockUserClient := setupTest(t)
+		ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+		followerID, followeeID := int64(1), int64(2)
+		const requests = 8
+
+		svc.idempotencyRepo.(*mocks.IdempotencyRepository).On("Get", ctx, followerID, testIdempotencyKey).
+			Return(model.IdempotencyRecord{}, false, nil)
+		mockUserClient.On("GetUser", ctx, followeeID).Return(&model.User{ID: followeeID}, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(false, nil)
+
+		// Like the primary key of idempotency_keys, only the first claim inserts the key
+		var claimedOnce atomic.Bool
+		txIdempotencyRepo := newIdempotencyRepoForTx(t, mockTx)
+		txIdempotencyRepo.On("Claim", ctx, mock.MatchedBy(isIdempotencyClaim(followerID, model.IdempotentOperationFollow, followeeID))).
+			Return(func(context.Context, model.IdempotencyRecord) bool { return claimedOnce.CompareAndSwap(false, true) }, nil)
+		txIdempotencyRepo.On("Get", ctx, followerID, testIdempotencyKey).
+			Return(idempotencyRecord(followerID, model.IdempotentOperationFollow, followeeID), true, nil).Maybe()
+
+		mockFollowRepo.On("Create", ctx, followerID, followeeID, model.FollowAttribution{}).
+			Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, true, nil).Once()
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, followeeID, model.RelationActionFollow))
+		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil).Once()
+		mockTx.On("Commit", ctx).Return(nil).Once()
+		mockTx.On("Rollback", ctx).Return(nil).Times(requests - 1)
+
+		errs := make([]error, requests)
+		var wg sync.WaitGroup
+		for i := range requests {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = svc.Follow(ctx, followerID, followeeID)
+			}()
+		}
+		wg.Wait()
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		mockFollowRepo.AssertNumberOfCalls(t, "Create", 1)
+		mockOutboxRepo.AssertNumberOfCalls(t, "AddEvent", 1)
+		mockTx.AssertNumberOfCalls(t, "Commit", 1)
+	})
+
+	t.Run("подписку успел создать параллельный запрос", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, mockUserClient := setupTest(t)
+		ctx := context.Background()
+		followerID, followeeID := int64(1), int64(2)
+
+		mockUserClient.On("GetUser", ctx, followeeID).Return(&model.User{ID: followeeID}, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(false, nil)
+		mockFollowRepo.On("Create", ctx, followerID, followeeID, model.FollowAttribution{}).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, false, nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := svc.Follow(ctx, followerID, followeeID)
+
+		assert.Equal(t, custom_errors.ErrAlreadyFollowing, err)
+		mockOutboxRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+}
+
+func TestService_UnfollowIdempotency(t *testing.T) {
+	t.Run("повторный запрос с тем же ключом возвращает успех", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+		ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+
+		svc.idempotencyRepo.(*mocks.IdempotencyRepository).On("Get", ctx, int64(1), testIdempotencyKey).
+			Return(idempotencyRecord(1, model.IdempotentOperationUnfollow, 2), true, nil)
+
+		err := svc.Unfollow(ctx, 1, 2)
+
+		require.NoError(t, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("ключ сохраняется в транзакции отписки", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
+		ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+		followerID, followeeID := int64(1), int64(2)
+
+		svc.idempotencyRepo.(*mocks.IdempotencyRepository).On("Get", ctx, followerID, testIdempotencyKey).
+			Return(model.IdempotencyRecord{}, false, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		newIdempotencyRepoForTx(t, mockTx).On("Claim", ctx, mock.MatchedBy(isIdempotencyClaim(followerID, model.IdempotentOperationUnfollow, followeeID))).Return(true, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Delete", ctx, followerID, followeeID).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, followeeID, model.RelationActionUnfollow))
+		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		err := svc.Unfollow(ctx, followerID, followeeID)
+
+		require.NoError(t, err)
+	})
+}
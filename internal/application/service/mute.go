@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/domain/ports/output/outbox"
+	"pinstack-relation-service/internal/infrastructure/utils"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// Mute hides the pins of followeeID from followerID until expiresAt, or until unmuted when it
+// is nil. Muting an already muted user replaces the expiry.
+func (s *Service) Mute(ctx context.Context, followerID, followeeID int64, expiresAt *time.Time) (mute model.Mute, err error) {
+	s.logger(ctx).Info("Mute request received", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
+
+	if followerID == followeeID {
+		return model.Mute{}, custom_errors.ErrInvalidInput
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return model.Mute{}, custom_errors.ErrInvalidInput
+	}
+
+	if err = s.authorizeActor(ctx, followerID); err != nil {
+		return model.Mute{}, err
+	}
+
+	_, err = s.userClient.GetUser(ctx, followeeID)
+	if err != nil {
+		if errors.Is(err, custom_errors.ErrUserNotFound) {
+			s.logger(ctx).Debug("User not found in mute", slog.Int64("followeeID", followeeID), slog.String("error", err.Error()))
+			return model.Mute{}, custom_errors.ErrUserNotFound
+		}
+		s.logger(ctx).Error("Failed to get user", slog.Int64("followeeID", followeeID), slog.String("error", err.Error()))
+		return model.Mute{}, err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return model.Mute{}, custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	mute, err = tx.MuteRepository().Upsert(ctx, model.Mute{FollowerID: followerID, FolloweeID: followeeID, ExpiresAt: expiresAt})
+	if err != nil {
+		s.logger(ctx).Error("Error saving mute", slog.String("error", err.Error()))
+		return model.Mute{}, err
+	}
+
+	if err = s.addMuteEvent(ctx, tx.OutboxRepository(), model.EventTypeUserMuted, mute); err != nil {
+		return model.Mute{}, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return model.Mute{}, custom_errors.ErrDatabaseQuery
+	}
+
+	s.logger(ctx).Info("User muted successfully", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
+	return mute, nil
+}
+
+// Unmute ends an active mute of followeeID by followerID
+func (s *Service) Unmute(ctx context.Context, followerID, followeeID int64) (err error) {
+	s.logger(ctx).Info("Unmute request received", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
+
+	if followerID == followeeID {
+		return custom_errors.ErrInvalidInput
+	}
+
+	if err = s.authorizeActor(ctx, followerID); err != nil {
+		return err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	mute, err := tx.MuteRepository().Delete(ctx, followerID, followeeID)
+	if err != nil {
+		s.logger(ctx).Error("Error deleting mute", slog.String("error", err.Error()))
+		return err
+	}
+
+	if err = s.addMuteEvent(ctx, tx.OutboxRepository(), model.EventTypeUserUnmuted, mute); err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+
+	s.logger(ctx).Info("User unmuted successfully", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
+	return nil
+}
+
+// ListMuted pages through the active mutes of followerID, newest first
+func (s *Service) ListMuted(ctx context.Context, followerID int64, limit, page int32) ([]model.Mute, int64, error) {
+	s.logger(ctx).Info("ListMuted request received", slog.Int64("followerID", followerID))
+
+	if err := s.authorizeActor(ctx, followerID); err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := utils.SetPaginationDefaults(limit, page)
+	mutes, total, err := s.muteRepo.List(ctx, followerID, limit, offset)
+	if err != nil {
+		s.logger(ctx).Error("Error listing mutes", slog.Int64("followerID", followerID), slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+	return mutes, total, nil
+}
+
+// FilterMuted returns the authors of authorIDs that viewerID has muted, for feeds to drop their
+// pins. The result follows no particular order.
+func (s *Service) FilterMuted(ctx context.Context, viewerID int64, authorIDs []int64) ([]int64, error) {
+	s.logger(ctx).Info("FilterMuted request received", slog.Int64("viewerID", viewerID), slog.Int("authors", len(authorIDs)))
+
+	if len(authorIDs) > model.MaxFilterMutedAuthors {
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	if err := s.authorizeReader(ctx, viewerID); err != nil {
+		return nil, err
+	}
+
+	if len(authorIDs) == 0 {
+		return []int64{}, nil
+	}
+
+	muted, err := s.muteRepo.FilterMuted(ctx, viewerID, authorIDs)
+	if err != nil {
+		s.logger(ctx).Error("Error filtering muted authors", slog.Int64("viewerID", viewerID), slog.String("error", err.Error()))
+		return nil, err
+	}
+	return muted, nil
+}
+
+func (s *Service) addMuteEvent(ctx context.Context, outboxRepo outbox.OutboxRepository, eventType events.EventType, mute model.Mute) error {
+	payload, err := json.Marshal(model.MutePayload{
+		FollowerID:  mute.FollowerID,
+		FolloweeID:  mute.FolloweeID,
+		ExpiresAt:   mute.ExpiresAt,
+		Timestamptz: time.Now(),
+	})
+	if err != nil {
+		s.logger(ctx).Error("Failed to marshal payload", slog.String("error", err.Error()))
+		return err
+	}
+
+	// Mutes have no id of their own, events are keyed by the muting user
+	err = outboxRepo.AddEvent(ctx, model.OutboxEvent{
+		EventType:   eventType,
+		Payload:     payload,
+		AggregateID: mute.FollowerID,
+	})
+	if err != nil {
+		s.logger(ctx).Error("Error adding event to outbox", slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
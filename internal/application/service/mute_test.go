@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newMuteRepoForTx makes tx hand out a mute repository mock
+func newMuteRepoForTx(t *testing.T, tx *mocks.Transaction) *mocks.MuteRepository {
+	muteRepo := mocks.NewMuteRepository(t)
+	tx.On("MuteRepository").Return(muteRepo)
+	return muteRepo
+}
+
+func isMuteEvent(eventType string, followerID, followeeID int64, expiresAt *time.Time) func(model.OutboxEvent) bool {
+	return func(event model.OutboxEvent) bool {
+		var payload model.MutePayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return false
+		}
+		sameExpiry := (payload.ExpiresAt == nil) == (expiresAt == nil)
+		if sameExpiry && expiresAt != nil {
+			sameExpiry = payload.ExpiresAt.Equal(*expiresAt)
+		}
+		return string(event.EventType) == eventType &&
+			event.AggregateID == followerID &&
+			payload.FollowerID == followerID &&
+			payload.FolloweeID == followeeID &&
+			sameExpiry
+	}
+}
+
+func TestService_Mute(t *testing.T) {
+	t.Run("успешное временное заглушение", func(t *testing.T) {
+		svc, _, mockUOW, mockTx, mockOutboxRepo, mockUserClient := setupTest(t)
+		ctx := context.Background()
+		expiresAt := time.Now().Add(24 * time.Hour).UTC()
+		saved := model.Mute{FollowerID: 1, FolloweeID: 2, CreatedAt: time.Now(), ExpiresAt: &expiresAt}
+
+		mockUserClient.On("GetUser", ctx, int64(2)).Return(&model.User{ID: 2}, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		newMuteRepoForTx(t, mockTx).On("Upsert", ctx, model.Mute{FollowerID: 1, FolloweeID: 2, ExpiresAt: &expiresAt}).Return(saved, nil)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isMuteEvent("user_muted", 1, 2, &expiresAt))).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		got, err := svc.Mute(ctx, 1, 2, &expiresAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, saved, got)
+		mockTx.AssertNotCalled(t, "Rollback", ctx)
+	})
+
+	t.Run("заглушение самого себя", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+
+		_, err := svc.Mute(context.Background(), 1, 1, nil)
+
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("срок заглушения уже прошёл", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+		expiresAt := time.Now().Add(-time.Minute)
+
+		_, err := svc.Mute(context.Background(), 1, 2, &expiresAt)
+
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("запрет заглушения от имени другого пользователя", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 3})
+
+		_, err := svc.Mute(ctx, 1, 2, nil)
+
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("пользователь не найден", func(t *testing.T) {
+		svc, _, mockUOW, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(2)).Return(nil, custom_errors.ErrUserNotFound)
+
+		_, err := svc.Mute(ctx, 1, 2, nil)
+
+		assert.Equal(t, custom_errors.ErrUserNotFound, err)
+		mockUOW.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("ошибка outbox откатывает заглушение", func(t *testing.T) {
+		svc, _, mockUOW, mockTx, mockOutboxRepo, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(2)).Return(&model.User{ID: 2}, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		newMuteRepoForTx(t, mockTx).On("Upsert", ctx, mock.AnythingOfType("model.Mute")).Return(model.Mute{FollowerID: 1, FolloweeID: 2}, nil)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(errors.New("outbox error"))
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		_, err := svc.Mute(ctx, 1, 2, nil)
+
+		assert.Error(t, err)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestService_Unmute(t *testing.T) {
+	t.Run("успешное снятие заглушения", func(t *testing.T) {
+		svc, _, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		newMuteRepoForTx(t, mockTx).On("Delete", ctx, int64(1), int64(2)).Return(model.Mute{FollowerID: 1, FolloweeID: 2}, nil)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isMuteEvent("user_unmuted", 1, 2, nil))).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		err := svc.Unmute(ctx, 1, 2)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("заглушения нет", func(t *testing.T) {
+		svc, _, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		newMuteRepoForTx(t, mockTx).On("Delete", ctx, int64(1), int64(2)).Return(model.Mute{}, model.ErrMuteNotFound)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := svc.Unmute(ctx, 1, 2)
+
+		assert.ErrorIs(t, err, model.ErrMuteNotFound)
+		mockOutboxRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+}
+
+func TestService_ListMuted(t *testing.T) {
+	t.Run("список заглушённых с пагинацией", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+		mutes := []model.Mute{{FollowerID: 1, FolloweeID: 2}}
+
+		svc.muteRepo.(*mocks.MuteRepository).On("List", ctx, int64(1), int32(10), int32(10)).Return(mutes, int64(11), nil)
+
+		got, total, err := svc.ListMuted(ctx, 1, 10, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, mutes, got)
+		assert.Equal(t, int64(11), total)
+	})
+
+	t.Run("чужой список недоступен", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 3})
+
+		_, _, err := svc.ListMuted(ctx, 1, 10, 1)
+
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+	})
+}
+
+func TestService_FilterMuted(t *testing.T) {
+	t.Run("сервис ленты фильтрует авторов", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 100, ServiceAccount: true})
+
+		svc.muteRepo.(*mocks.MuteRepository).On("FilterMuted", ctx, int64(1), []int64{2, 3}).Return([]int64{3}, nil)
+
+		muted, err := svc.FilterMuted(ctx, 1, []int64{2, 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, muted)
+	})
+
+	t.Run("пустой список авторов", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+
+		muted, err := svc.FilterMuted(context.Background(), 1, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, muted)
+	})
+
+	t.Run("слишком много авторов", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+
+		_, err := svc.FilterMuted(context.Background(), 1, make([]int64, model.MaxFilterMutedAuthors+1))
+
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+	})
+
+	t.Run("другой пользователь не видит заглушения", func(t *testing.T) {
+		svc, _, _, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 3})
+
+		_, err := svc.FilterMuted(ctx, 1, []int64{2})
+
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+	})
+}
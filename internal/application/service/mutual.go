@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/utils"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func (s *Service) GetMutualFollows(ctx context.Context, userID int64, limit, page int32) ([]*model.User, int64, error) {
+	s.logger(ctx).Info("GetMutualFollows request received", slog.Int64("userID", userID))
+	_, err := s.userClient.GetUser(ctx, userID)
+	if err != nil {
+		s.logger(ctx).Error("Failed to get user", slog.Int64("userID", userID))
+		switch {
+		case errors.Is(err, custom_errors.ErrUserNotFound):
+			s.logger(ctx).Debug("User not found in GetMutualFollows", slog.Int64("userID", userID), slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrUserNotFound
+		default:
+			return nil, 0, err
+		}
+	}
+	limit, offset := utils.SetPaginationDefaults(limit, page)
+	mutualIDs, total, err := s.followRepo.GetMutualFollows(ctx, userID, limit, offset)
+	if err != nil {
+		s.logger(ctx).Error("Error getting mutual follows", slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	mutuals := s.resolveUsers(ctx, mutualIDs)
+
+	s.logger(ctx).Info("Mutual follows retrieved successfully", slog.Int64("userID", userID), slog.Int("count", len(mutuals)), slog.Int64("total", total))
+	return mutuals, total, nil
+}
+
+func (s *Service) IsMutual(ctx context.Context, userID, otherUserID int64) (bool, error) {
+	if userID == otherUserID {
+		return false, nil
+	}
+
+	mutual, err := s.followRepo.IsMutual(ctx, userID, otherUserID)
+	if err != nil {
+		s.logger(ctx).Error("Error checking mutual follow", slog.Int64("userID", userID), slog.Int64("otherUserID", otherUserID), slog.String("error", err.Error()))
+		return false, err
+	}
+	return mutual, nil
+}
+
+// resolveUsers loads the profiles for ids, keeping a placeholder for users the user service can't return
+func (s *Service) resolveUsers(ctx context.Context, ids []int64) []*model.User {
+	users := make([]*model.User, 0, len(ids))
+	for _, id := range ids {
+		user, err := s.userClient.GetUser(ctx, id)
+		if err != nil {
+			s.logger(ctx).Error("Failed to get user profile", slog.Int64("userID", id), slog.String("error", err.Error()))
+			user = &model.User{
+				ID:       id,
+				Username: "Missing user",
+				Email:    "Missing user",
+			}
+		}
+		users = append(users, user)
+	}
+	return users
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	model "pinstack-relation-service/internal/domain/models"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetMutualFollows(t *testing.T) {
+	t.Run("успешное получение взаимных подписок", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+		userID := int64(1)
+		limit, page := int32(10), int32(2)
+
+		mockUserClient.On("GetUser", ctx, userID).Return(&model.User{ID: userID}, nil)
+		mockFollowRepo.On("GetMutualFollows", ctx, userID, limit, int32(10)).Return([]int64{3, 5}, int64(12), nil)
+		mockUserClient.On("GetUser", ctx, int64(3)).Return(&model.User{ID: 3, Username: "user3"}, nil)
+		mockUserClient.On("GetUser", ctx, int64(5)).Return(nil, errors.New("user service unavailable"))
+
+		mutuals, total, err := svc.GetMutualFollows(ctx, userID, limit, page)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), total)
+		require.Len(t, mutuals, 2)
+		assert.Equal(t, "user3", mutuals[0].Username)
+		assert.Equal(t, int64(5), mutuals[1].ID)
+		assert.Equal(t, "Missing user", mutuals[1].Username)
+		mockFollowRepo.AssertExpectations(t)
+		mockUserClient.AssertExpectations(t)
+	})
+
+	t.Run("ошибка при несуществующем пользователе", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(nil, custom_errors.ErrUserNotFound)
+
+		mutuals, total, err := svc.GetMutualFollows(ctx, 1, 10, 1)
+
+		assert.Equal(t, custom_errors.ErrUserNotFound, err)
+		assert.Nil(t, mutuals)
+		assert.Equal(t, int64(0), total)
+		mockFollowRepo.AssertNotCalled(t, "GetMutualFollows")
+	})
+
+	t.Run("ошибка базы данных", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, mockUserClient := setupTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockFollowRepo.On("GetMutualFollows", ctx, int64(1), int32(10), int32(0)).Return(nil, int64(0), custom_errors.ErrDatabaseQuery)
+
+		mutuals, _, err := svc.GetMutualFollows(ctx, 1, 10, 1)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+		assert.Nil(t, mutuals)
+	})
+}
+
+func TestService_IsMutual(t *testing.T) {
+	t.Run("взаимная подписка", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockFollowRepo.On("IsMutual", ctx, int64(1), int64(2)).Return(true, nil)
+
+		mutual, err := svc.IsMutual(ctx, 1, 2)
+
+		require.NoError(t, err)
+		assert.True(t, mutual)
+	})
+
+	t.Run("пользователь не взаимен сам с собой", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+
+		mutual, err := svc.IsMutual(context.Background(), 1, 1)
+
+		require.NoError(t, err)
+		assert.False(t, mutual)
+		mockFollowRepo.AssertNotCalled(t, "IsMutual")
+	})
+
+	t.Run("ошибка базы данных", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockFollowRepo.On("IsMutual", ctx, int64(1), int64(2)).Return(false, custom_errors.ErrDatabaseQuery)
+
+		mutual, err := svc.IsMutual(ctx, 1, 2)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+		assert.False(t, mutual)
+	})
+}
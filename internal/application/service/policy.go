@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// authorizeActor checks that the authenticated caller may act on behalf of userID.
+// Requests without a caller identity only reach the service when authentication is disabled.
+func (s *Service) authorizeActor(ctx context.Context, userID int64) error {
+	caller, ok := model.CallerFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if !caller.CanActFor(userID) {
+		s.logger(ctx).Warn("Caller is not allowed to act for user",
+			slog.Int64("callerID", caller.UserID),
+			slog.Int64("userID", userID))
+		return custom_errors.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeService checks that the authenticated caller is a service account, for bulk
+// reads that exist for other backends rather than for end users.
+func (s *Service) authorizeService(ctx context.Context) error {
+	caller, ok := model.CallerFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if !caller.ServiceAccount {
+		s.logger(ctx).Warn("Caller is not a service account",
+			slog.Int64("callerID", caller.UserID))
+		return custom_errors.ErrForbidden
+	}
+	return nil
+}
+
+// authorizeReader checks that the authenticated caller may read the private data of userID,
+// which any service account may do on behalf of its users.
+func (s *Service) authorizeReader(ctx context.Context, userID int64) error {
+	caller, ok := model.CallerFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if !caller.CanActFor(userID) && !caller.ServiceAccount {
+		s.logger(ctx).Warn("Caller is not allowed to read for user",
+			slog.Int64("callerID", caller.UserID),
+			slog.Int64("userID", userID))
+		return custom_errors.ErrForbidden
+	}
+	return nil
+}
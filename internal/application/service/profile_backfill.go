@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/domain/ports/output/repository"
+	"pinstack-relation-service/internal/domain/ports/output/user_client"
+	"time"
+)
+
+// ProfileBackfiller fetches the profiles of follow graph users that are missing from
+// the local copy or have gone stale, covering users no request has fetched recently.
+type ProfileBackfiller struct {
+	profiles   repository.ProfileRepository
+	userClient user_client.Client
+	batchSize  int32
+	log        ports.Logger
+}
+
+func NewProfileBackfiller(log ports.Logger, profiles repository.ProfileRepository, userClient user_client.Client, batchSize int32) *ProfileBackfiller {
+	return &ProfileBackfiller{
+		profiles:   profiles,
+		userClient: userClient,
+		batchSize:  batchSize,
+		log:        log,
+	}
+}
+
+// Run stores a fresh profile for every user that has none or one synced before staleBefore.
+// A user that can't be fetched is logged and skipped; failing to list or store aborts the run.
+func (b *ProfileBackfiller) Run(ctx context.Context, staleBefore time.Time) (synced int, failed int, err error) {
+	b.log.Info("Backfilling user profiles", slog.Time("staleBefore", staleBefore), slog.Int("batchSize", int(b.batchSize)))
+
+	var afterID int64
+	for {
+		userIDs, err := b.profiles.ListUnsynced(ctx, staleBefore, afterID, b.batchSize)
+		if err != nil {
+			b.log.Error("Failed to list unsynced profiles", slog.Int64("afterID", afterID), slog.String("error", err.Error()))
+			return synced, failed, err
+		}
+
+		users := make([]*model.User, 0, len(userIDs))
+		for _, userID := range userIDs {
+			if err := ctx.Err(); err != nil {
+				return synced, failed, err
+			}
+			user, err := b.userClient.GetUser(ctx, userID)
+			if err != nil {
+				b.log.Warn("Failed to fetch user profile", slog.Int64("userID", userID), slog.String("error", err.Error()))
+				failed++
+				continue
+			}
+			users = append(users, user)
+		}
+
+		if len(users) > 0 {
+			if err := b.profiles.Upsert(ctx, users); err != nil {
+				b.log.Error("Failed to store user profiles", slog.Int("count", len(users)), slog.String("error", err.Error()))
+				return synced, failed, err
+			}
+			synced += len(users)
+		}
+
+		if len(userIDs) < int(b.batchSize) {
+			break
+		}
+		afterID = userIDs[len(userIDs)-1]
+	}
+
+	b.log.Info("User profiles backfilled", slog.Int("synced", synced), slog.Int("failed", failed))
+	return synced, failed, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// SearchFollowers finds followers of userID whose username or full name matches query.
+// It runs against the local profile copy, so a follower whose profile was never fetched
+// is not found until the profile backfill batch has stored it.
+func (s *Service) SearchFollowers(ctx context.Context, userID int64, query string, limit int32) ([]*model.ProfileMatch, error) {
+	s.logger(ctx).Info("SearchFollowers request received", slog.Int64("userID", userID))
+	return s.searchFollows(ctx, userID, query, limit, s.profileRepo.SearchFollowers)
+}
+
+// SearchFollowees finds users userID follows whose username or full name matches query
+func (s *Service) SearchFollowees(ctx context.Context, userID int64, query string, limit int32) ([]*model.ProfileMatch, error) {
+	s.logger(ctx).Info("SearchFollowees request received", slog.Int64("userID", userID))
+	return s.searchFollows(ctx, userID, query, limit, s.profileRepo.SearchFollowees)
+}
+
+type profileSearch func(ctx context.Context, userID int64, query string, limit int32) ([]model.ProfileMatch, error)
+
+func (s *Service) searchFollows(ctx context.Context, userID int64, query string, limit int32, search profileSearch) ([]*model.ProfileMatch, error) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" || utf8.RuneCountInString(query) > model.MaxProfileSearchQueryLength {
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	if limit <= 0 {
+		limit = model.DefaultProfileSearchLimit
+	}
+	if limit > model.MaxProfileSearchLimit {
+		limit = model.MaxProfileSearchLimit
+	}
+
+	_, err := s.userClient.GetUser(ctx, userID)
+	if err != nil {
+		s.logger(ctx).Error("Failed to get user", slog.Int64("userID", userID))
+		switch {
+		case errors.Is(err, custom_errors.ErrUserNotFound):
+			return nil, custom_errors.ErrUserNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	found, err := search(ctx, userID, query, limit)
+	if err != nil {
+		s.logger(ctx).Error("Error searching profiles", slog.Int64("userID", userID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	matches := make([]*model.ProfileMatch, 0, len(found))
+	for i := range found {
+		matches = append(matches, &found[i])
+	}
+
+	s.logger(ctx).Debug("Profile search completed", slog.Int64("userID", userID), slog.Int("count", len(matches)))
+	return matches, nil
+}
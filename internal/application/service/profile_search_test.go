@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	model "pinstack-relation-service/internal/domain/models"
+	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/mocks"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupProfileSearchTest(t *testing.T) (*Service, *mocks.ProfileRepository, *mocks.Client) {
+	mockProfileRepo := mocks.NewProfileRepository(t)
+	mockUserClient := mocks.NewClient(t)
+	svc := NewFollowService(infra_logger.New("test"), mocks.NewFollowRepository(t), mocks.NewSuggestionRepository(t), mockProfileRepo, mocks.NewRelationHistoryRepository(t), mocks.NewIdempotencyRepository(t), mocks.NewMuteRepository(t), mocks.NewAudienceListRepository(t), mocks.NewFollowStatsRepository(t), mocks.NewFollowerGrowthRepository(t), mocks.NewUnitOfWork(t), mockUserClient, mocks.NewPostClient(t), newSuggestionCache(t), model.FollowLimits{}, model.AudienceListPolicy{}, testIdempotencyTTL)
+	return svc, mockProfileRepo, mockUserClient
+}
+
+func TestService_SearchFollowers(t *testing.T) {
+	t.Run("запрос нормализуется и результаты сохраняют порядок", func(t *testing.T) {
+		svc, mockProfileRepo, mockUserClient := setupProfileSearchTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil).Once()
+		mockProfileRepo.On("SearchFollowers", ctx, int64(1), "anna", int32(10)).Return([]model.ProfileMatch{
+			{User: &model.User{ID: 3, Username: "anna"}, Score: 4},
+			{User: &model.User{ID: 7, Username: "annabel"}, Score: 2.5},
+		}, nil)
+
+		matches, err := svc.SearchFollowers(ctx, 1, "  Anna ", 10)
+
+		require.NoError(t, err)
+		require.Len(t, matches, 2)
+		assert.Equal(t, int64(3), matches[0].User.ID)
+		assert.Equal(t, int64(7), matches[1].User.ID)
+		mockUserClient.AssertNumberOfCalls(t, "GetUser", 1)
+	})
+
+	t.Run("лимит по умолчанию и ограничение сверху", func(t *testing.T) {
+		svc, mockProfileRepo, mockUserClient := setupProfileSearchTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockProfileRepo.On("SearchFollowers", ctx, int64(1), "bo", int32(model.DefaultProfileSearchLimit)).Return([]model.ProfileMatch{}, nil).Once()
+		mockProfileRepo.On("SearchFollowers", ctx, int64(1), "bo", int32(model.MaxProfileSearchLimit)).Return([]model.ProfileMatch{}, nil).Once()
+
+		_, err := svc.SearchFollowers(ctx, 1, "bo", 0)
+		require.NoError(t, err)
+		_, err = svc.SearchFollowers(ctx, 1, "bo", 500)
+		require.NoError(t, err)
+	})
+
+	t.Run("пустой или слишком длинный запрос", func(t *testing.T) {
+		svc, _, mockUserClient := setupProfileSearchTest(t)
+
+		_, err := svc.SearchFollowers(context.Background(), 1, "   ", 10)
+		assert.ErrorIs(t, err, custom_errors.ErrInvalidInput)
+
+		_, err = svc.SearchFollowers(context.Background(), 1, strings.Repeat("я", model.MaxProfileSearchQueryLength+1), 10)
+		assert.ErrorIs(t, err, custom_errors.ErrInvalidInput)
+
+		mockUserClient.AssertNotCalled(t, "GetUser", mock.Anything, mock.Anything)
+	})
+
+	t.Run("пользователь не найден", func(t *testing.T) {
+		svc, _, mockUserClient := setupProfileSearchTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(nil, custom_errors.ErrUserNotFound)
+
+		_, err := svc.SearchFollowers(ctx, 1, "anna", 10)
+
+		assert.ErrorIs(t, err, custom_errors.ErrUserNotFound)
+	})
+
+	t.Run("ошибка базы данных", func(t *testing.T) {
+		svc, mockProfileRepo, mockUserClient := setupProfileSearchTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockProfileRepo.On("SearchFollowers", ctx, int64(1), "anna", int32(10)).Return(nil, custom_errors.ErrDatabaseQuery)
+
+		_, err := svc.SearchFollowers(ctx, 1, "anna", 10)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestService_SearchFollowees(t *testing.T) {
+	svc, mockProfileRepo, mockUserClient := setupProfileSearchTest(t)
+	ctx := context.Background()
+
+	mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+	mockProfileRepo.On("SearchFollowees", ctx, int64(1), "ivan", int32(5)).Return([]model.ProfileMatch{
+		{User: &model.User{ID: 4, Username: "ivan_p"}, Score: 2.4},
+	}, nil)
+
+	matches, err := svc.SearchFollowees(ctx, 1, "Ivan", 5)
+
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	assert.Equal(t, "ivan_p", matches[0].User.Username)
+}
+
+func TestProfileBackfiller_Run(t *testing.T) {
+	staleBefore := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("обход всех страниц и сохранение профилей", func(t *testing.T) {
+		mockProfileRepo := mocks.NewProfileRepository(t)
+		mockUserClient := mocks.NewClient(t)
+		ctx := context.Background()
+
+		mockProfileRepo.On("ListUnsynced", ctx, staleBefore, int64(0), int32(2)).Return([]int64{1, 2}, nil)
+		mockProfileRepo.On("ListUnsynced", ctx, staleBefore, int64(2), int32(2)).Return([]int64{5}, nil)
+		users := map[int64]*model.User{}
+		for _, userID := range []int64{1, 2, 5} {
+			users[userID] = &model.User{ID: userID, Username: "user"}
+			mockUserClient.On("GetUser", ctx, userID).Return(users[userID], nil)
+		}
+		mockProfileRepo.On("Upsert", ctx, []*model.User{users[1], users[2]}).Return(nil).Once()
+		mockProfileRepo.On("Upsert", ctx, []*model.User{users[5]}).Return(nil).Once()
+
+		backfiller := NewProfileBackfiller(infra_logger.New("test"), mockProfileRepo, mockUserClient, 2)
+		synced, failed, err := backfiller.Run(ctx, staleBefore)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, synced)
+		assert.Equal(t, 0, failed)
+	})
+
+	t.Run("недоступный профиль пропускается", func(t *testing.T) {
+		mockProfileRepo := mocks.NewProfileRepository(t)
+		mockUserClient := mocks.NewClient(t)
+		ctx := context.Background()
+		user := &model.User{ID: 2, Username: "user2"}
+
+		mockProfileRepo.On("ListUnsynced", ctx, staleBefore, int64(0), int32(10)).Return([]int64{1, 2}, nil)
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(nil, custom_errors.ErrUserNotFound)
+		mockUserClient.On("GetUser", ctx, int64(2)).Return(user, nil)
+		mockProfileRepo.On("Upsert", ctx, []*model.User{user}).Return(nil)
+
+		backfiller := NewProfileBackfiller(infra_logger.New("test"), mockProfileRepo, mockUserClient, 10)
+		synced, failed, err := backfiller.Run(ctx, staleBefore)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, synced)
+		assert.Equal(t, 1, failed)
+	})
+
+	t.Run("ошибка сохранения прерывает обход", func(t *testing.T) {
+		mockProfileRepo := mocks.NewProfileRepository(t)
+		mockUserClient := mocks.NewClient(t)
+		ctx := context.Background()
+
+		mockProfileRepo.On("ListUnsynced", ctx, staleBefore, int64(0), int32(10)).Return([]int64{1}, nil)
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockProfileRepo.On("Upsert", ctx, mock.Anything).Return(custom_errors.ErrDatabaseQuery)
+
+		backfiller := NewProfileBackfiller(infra_logger.New("test"), mockProfileRepo, mockUserClient, 10)
+		_, _, err := backfiller.Run(ctx, staleBefore)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/domain/ports/output/uow"
+	"pinstack-relation-service/internal/infrastructure/utils"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// GetRelationHistory pages through the relationship changes involving userID, newest first.
+// With otherUserID set only the changes between the two users are returned, in both directions.
+func (s *Service) GetRelationHistory(ctx context.Context, userID int64, otherUserID *int64, limit, page int32) ([]model.RelationHistoryEntry, int64, error) {
+	s.logger(ctx).Info("GetRelationHistory request received", slog.Int64("userID", userID))
+
+	if otherUserID != nil && *otherUserID == userID {
+		return nil, 0, custom_errors.ErrInvalidInput
+	}
+
+	if err := s.authorizeActor(ctx, userID); err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := utils.SetPaginationDefaults(limit, page)
+	entries, total, err := s.historyRepo.List(ctx, model.RelationHistoryFilter{UserID: userID, OtherUserID: otherUserID}, limit, offset)
+	if err != nil {
+		s.logger(ctx).Error("Error listing relation history", slog.Int64("userID", userID), slog.String("error", err.Error()))
+		return nil, 0, err
+	}
+
+	s.logger(ctx).Info("Relation history retrieved successfully", slog.Int64("userID", userID), slog.Int("count", len(entries)), slog.Int64("total", total))
+	return entries, total, nil
+}
+
+// historyEntry describes a change made on behalf of userID. The caller is recorded as the
+// actor, and a caller acting for someone else can only be an admin.
+func historyEntry(ctx context.Context, userID, followerID, followeeID int64, action model.RelationAction) model.RelationHistoryEntry {
+	entry := model.RelationHistoryEntry{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+		Action:     action,
+		ActorID:    &userID,
+		Reason:     model.RelationReasonUser,
+	}
+	if caller, ok := model.CallerFromContext(ctx); ok && caller.UserID != userID {
+		entry.ActorID = &caller.UserID
+		entry.Reason = model.RelationReasonAdmin
+	}
+	return entry
+}
+
+// recordRelationChange writes entry to the relation history. The follower growth of the
+// followee is aggregated from the history later, so the change locks no shared counter row.
+func (s *Service) recordRelationChange(ctx context.Context, tx uow.Transaction, entry model.RelationHistoryEntry) error {
+	err := tx.RelationHistoryRepository().Record(ctx, entry)
+	if err != nil {
+		s.logger(ctx).Error("Error recording relation history", slog.String("error", err.Error()))
+		return err
+	}
+	return nil
+}
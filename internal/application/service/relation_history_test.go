@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	model "pinstack-relation-service/internal/domain/models"
+	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupHistoryTest(t *testing.T) (*Service, *mocks.RelationHistoryRepository) {
+	mockHistoryRepo := mocks.NewRelationHistoryRepository(t)
+	svc := NewFollowService(infra_logger.New("test"), mocks.NewFollowRepository(t), mocks.NewSuggestionRepository(t), mocks.NewProfileRepository(t), mockHistoryRepo, mocks.NewIdempotencyRepository(t), mocks.NewMuteRepository(t), mocks.NewAudienceListRepository(t), mocks.NewFollowStatsRepository(t), mocks.NewFollowerGrowthRepository(t), mocks.NewUnitOfWork(t), mocks.NewClient(t), mocks.NewPostClient(t), newSuggestionCache(t), model.FollowLimits{}, model.AudienceListPolicy{}, testIdempotencyTTL)
+	return svc, mockHistoryRepo
+}
+
+func TestService_GetRelationHistory(t *testing.T) {
+	actorID := int64(2)
+	entries := []model.RelationHistoryEntry{
+		{ID: 2, FollowerID: 2, FolloweeID: 1, Action: model.RelationActionUnfollow, ActorID: &actorID, Reason: model.RelationReasonUser, CreatedAt: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 1, FollowerID: 2, FolloweeID: 1, Action: model.RelationActionFollow, ActorID: &actorID, Reason: model.RelationReasonUser, CreatedAt: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	t.Run("история пары пользователей", func(t *testing.T) {
+		svc, mockHistoryRepo := setupHistoryTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 1})
+		otherUserID := int64(2)
+
+		mockHistoryRepo.On("List", ctx, model.RelationHistoryFilter{UserID: 1, OtherUserID: &otherUserID}, int32(10), int32(0)).Return(entries, int64(2), nil)
+
+		got, total, err := svc.GetRelationHistory(ctx, 1, &otherUserID, 10, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, entries, got)
+		assert.Equal(t, int64(2), total)
+	})
+
+	t.Run("история пользователя с пагинацией", func(t *testing.T) {
+		svc, mockHistoryRepo := setupHistoryTest(t)
+		ctx := context.Background()
+
+		mockHistoryRepo.On("List", ctx, model.RelationHistoryFilter{UserID: 1}, int32(10), int32(10)).Return([]model.RelationHistoryEntry{}, int64(2), nil)
+
+		got, total, err := svc.GetRelationHistory(ctx, 1, nil, 10, 2)
+
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		assert.Equal(t, int64(2), total)
+	})
+
+	t.Run("история с самим собой", func(t *testing.T) {
+		svc, mockHistoryRepo := setupHistoryTest(t)
+		userID := int64(1)
+
+		_, _, err := svc.GetRelationHistory(context.Background(), userID, &userID, 10, 1)
+
+		assert.ErrorIs(t, err, custom_errors.ErrInvalidInput)
+		mockHistoryRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("запрет чтения чужой истории", func(t *testing.T) {
+		svc, mockHistoryRepo := setupHistoryTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 3})
+
+		_, _, err := svc.GetRelationHistory(ctx, 1, nil, 10, 1)
+
+		assert.ErrorIs(t, err, custom_errors.ErrForbidden)
+		mockHistoryRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ошибка базы данных", func(t *testing.T) {
+		svc, mockHistoryRepo := setupHistoryTest(t)
+		ctx := context.Background()
+
+		mockHistoryRepo.On("List", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, int64(0), custom_errors.ErrDatabaseQuery)
+
+		_, _, err := svc.GetRelationHistory(ctx, 1, nil, 10, 1)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
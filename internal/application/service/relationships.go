@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// GetRelationships resolves the relationship of the viewer with every target using a single
+// repository query. Duplicate targets are collapsed, the result keeps the request order.
+func (s *Service) GetRelationships(ctx context.Context, viewerID int64, targetIDs []int64) ([]model.Relationship, error) {
+	s.logger(ctx).Info("GetRelationships request received", slog.Int64("viewerID", viewerID), slog.Int("targets", len(targetIDs)))
+
+	if len(targetIDs) > model.MaxRelationshipTargets {
+		return nil, custom_errors.ErrInvalidInput
+	}
+
+	uniqueIDs := make([]int64, 0, len(targetIDs))
+	seen := make(map[int64]struct{}, len(targetIDs))
+	for _, targetID := range targetIDs {
+		if _, ok := seen[targetID]; ok {
+			continue
+		}
+		seen[targetID] = struct{}{}
+		uniqueIDs = append(uniqueIDs, targetID)
+	}
+
+	if len(uniqueIDs) == 0 {
+		return []model.Relationship{}, nil
+	}
+
+	found, err := s.followRepo.GetRelationships(ctx, viewerID, uniqueIDs)
+	if err != nil {
+		s.logger(ctx).Error("Error getting relationships", slog.Int64("viewerID", viewerID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	relationships := make([]model.Relationship, 0, len(uniqueIDs))
+	for _, targetID := range uniqueIDs {
+		relationship, ok := found[targetID]
+		if !ok {
+			relationship = model.Relationship{TargetID: targetID}
+		}
+		relationships = append(relationships, relationship)
+	}
+
+	s.logger(ctx).Info("Relationships retrieved successfully", slog.Int64("viewerID", viewerID), slog.Int("count", len(relationships)))
+	return relationships, nil
+}
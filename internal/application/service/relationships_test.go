@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/domain/ports/output/repository"
+	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	"testing"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_GetRelationships(t *testing.T) {
+	t.Run("успешное получение отношений в порядке запроса", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+		viewerID := int64(1)
+
+		mockFollowRepo.On("GetRelationships", ctx, viewerID, []int64{4, 2, 3}).Return(map[int64]model.Relationship{
+			2: {TargetID: 2, Following: true, FollowedBy: true},
+			3: {TargetID: 3, FollowedBy: true},
+		}, nil)
+
+		relationships, err := svc.GetRelationships(ctx, viewerID, []int64{4, 2, 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.Relationship{
+			{TargetID: 4},
+			{TargetID: 2, Following: true, FollowedBy: true},
+			{TargetID: 3, FollowedBy: true},
+		}, relationships)
+		mockFollowRepo.AssertExpectations(t)
+	})
+
+	t.Run("дубликаты целей схлопываются", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+		viewerID := int64(1)
+
+		mockFollowRepo.On("GetRelationships", ctx, viewerID, []int64{2, 3}).Return(map[int64]model.Relationship{
+			3: {TargetID: 3, Following: true},
+		}, nil)
+
+		relationships, err := svc.GetRelationships(ctx, viewerID, []int64{2, 3, 2, 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.Relationship{{TargetID: 2}, {TargetID: 3, Following: true}}, relationships)
+	})
+
+	t.Run("пустой список целей не обращается к репозиторию", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+
+		relationships, err := svc.GetRelationships(context.Background(), 1, nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, relationships)
+		mockFollowRepo.AssertNotCalled(t, "GetRelationships")
+	})
+
+	t.Run("слишком много целей", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+
+		relationships, err := svc.GetRelationships(context.Background(), 1, make([]int64, model.MaxRelationshipTargets+1))
+
+		assert.Equal(t, custom_errors.ErrInvalidInput, err)
+		assert.Nil(t, relationships)
+		mockFollowRepo.AssertNotCalled(t, "GetRelationships")
+	})
+
+	t.Run("ошибка базы данных", func(t *testing.T) {
+		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockFollowRepo.On("GetRelationships", ctx, int64(1), []int64{2}).Return(nil, custom_errors.ErrDatabaseQuery)
+
+		relationships, err := svc.GetRelationships(ctx, 1, []int64{2})
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+		assert.Nil(t, relationships)
+	})
+}
+
+// relationshipsRepoStub answers GetRelationships without mock bookkeeping so the benchmark measures the service
+type relationshipsRepoStub struct {
+	repository.FollowRepository
+	relationships map[int64]model.Relationship
+}
+
+func (r *relationshipsRepoStub) GetRelationships(context.Context, int64, []int64) (map[int64]model.Relationship, error) {
+	return r.relationships, nil
+}
+
+func BenchmarkService_GetRelationships(b *testing.B) {
+	targetIDs := make([]int64, model.MaxRelationshipTargets)
+	found := make(map[int64]model.Relationship, len(targetIDs)/2)
+	for i := range targetIDs {
+		targetIDs[i] = int64(i + 2)
+		if i%2 == 0 {
+			found[targetIDs[i]] = model.Relationship{TargetID: targetIDs[i], Following: true, FollowedBy: i%4 == 0}
+		}
+	}
+
+	svc := NewFollowService(&infra_logger.Logger{Logger: slog.New(slog.DiscardHandler)}, &relationshipsRepoStub{relationships: found}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, model.FollowLimits{}, model.AudienceListPolicy{}, testIdempotencyTTL)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		relationships, err := svc.GetRelationships(ctx, 1, targetIDs)
+		if err != nil || len(relationships) != len(targetIDs) {
+			b.Fatalf("unexpected result: %d relationships, err %v", len(relationships), err)
+		}
+	}
+}
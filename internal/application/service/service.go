@@ -7,6 +7,9 @@ import (
 	"log/slog"
 	model "pinstack-relation-service/internal/domain/models"
 	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/domain/ports/output/cache"
+	"pinstack-relation-service/internal/domain/ports/output/outbox"
+	"pinstack-relation-service/internal/domain/ports/output/post_client"
 	"pinstack-relation-service/internal/domain/ports/output/repository"
 	"pinstack-relation-service/internal/domain/ports/output/uow"
 	user_client "pinstack-relation-service/internal/domain/ports/output/user_client"
@@ -19,48 +22,108 @@ import (
 )
 
 type Service struct {
-	followRepo repository.FollowRepository
-	userClient user_client.Client
-	uow        uow.UnitOfWork
-	log        ports.Logger
+	followRepo      repository.FollowRepository
+	suggestionRepo  repository.SuggestionRepository
+	profileRepo     repository.ProfileRepository
+	historyRepo     repository.RelationHistoryRepository
+	idempotencyRepo repository.IdempotencyRepository
+	muteRepo        repository.MuteRepository
+	audienceRepo    repository.AudienceListRepository
+	statsRepo       repository.FollowStatsRepository
+	growthRepo      repository.FollowerGrowthRepository
+	suggestionCache cache.SuggestionCache
+	userClient      user_client.Client
+	postClient      post_client.Client
+	uow             uow.UnitOfWork
+	limits          model.FollowLimits
+	audience        model.AudienceListPolicy
+	idempotencyTTL  time.Duration
+	log             ports.Logger
 }
 
-func NewFollowService(log ports.Logger, followRepo repository.FollowRepository, uow uow.UnitOfWork, userClient user_client.Client) *Service {
+func NewFollowService(
+	log ports.Logger,
+	followRepo repository.FollowRepository,
+	suggestionRepo repository.SuggestionRepository,
+	profileRepo repository.ProfileRepository,
+	historyRepo repository.RelationHistoryRepository,
+	idempotencyRepo repository.IdempotencyRepository,
+	muteRepo repository.MuteRepository,
+	audienceRepo repository.AudienceListRepository,
+	statsRepo repository.FollowStatsRepository,
+	growthRepo repository.FollowerGrowthRepository,
+	uow uow.UnitOfWork,
+	userClient user_client.Client,
+	postClient post_client.Client,
+	suggestionCache cache.SuggestionCache,
+	limits model.FollowLimits,
+	audience model.AudienceListPolicy,
+	idempotencyTTL time.Duration,
+) *Service {
 	return &Service{
-		log:        log,
-		followRepo: followRepo,
-		userClient: userClient,
-		uow:        uow,
+		log:             log,
+		followRepo:      followRepo,
+		suggestionRepo:  suggestionRepo,
+		profileRepo:     profileRepo,
+		historyRepo:     historyRepo,
+		idempotencyRepo: idempotencyRepo,
+		muteRepo:        muteRepo,
+		audienceRepo:    audienceRepo,
+		statsRepo:       statsRepo,
+		growthRepo:      growthRepo,
+		suggestionCache: suggestionCache,
+		userClient:      userClient,
+		postClient:      postClient,
+		uow:             uow,
+		limits:          limits,
+		audience:        audience,
+		idempotencyTTL:  idempotencyTTL,
 	}
 }
 
+// logger returns the request scoped logger so service logs carry the request id
+func (s *Service) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, s.log)
+}
+
 func (s *Service) Follow(ctx context.Context, followerID, followeeID int64) (err error) {
-	s.log.Info("Follow request received", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
+	s.logger(ctx).Info("Follow request received", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
 
 	if followerID == followeeID {
 		return custom_errors.ErrSelfFollow
 	}
 
+	if err = s.authorizeActor(ctx, followerID); err != nil {
+		return err
+	}
+
+	replay, err := s.replayIdempotent(ctx, followerID, model.IdempotentOperationFollow, followeeID)
+	if err != nil || replay {
+		return err
+	}
+
 	_, err = s.userClient.GetUser(ctx, followeeID)
 	if err != nil {
-		s.log.Error("Failed to get user", slog.Int64("followeeID", followeeID))
+		s.logger(ctx).Error("Failed to get user", slog.Int64("followeeID", followeeID))
 		switch {
 		case errors.Is(err, custom_errors.ErrUserNotFound):
-			s.log.Debug("User not found in follow", slog.Int64("followeeID", followeeID), slog.String("error", err.Error()))
+			s.logger(ctx).Debug("User not found in follow", slog.Int64("followeeID", followeeID), slog.String("error", err.Error()))
 			return custom_errors.ErrUserNotFound
 		default:
-			s.log.Error("Failed to get user", slog.Int64("followeeID", followeeID))
+			s.logger(ctx).Error("Failed to get user", slog.Int64("followeeID", followeeID))
 			return err
 		}
 	}
 
 	tx, err := s.uow.Begin(ctx)
 	if err != nil {
-		s.log.Error("Failed to start transaction", slog.String("error", err.Error()))
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
 		return custom_errors.ErrDatabaseQuery
 	}
+	// A rejected follow commits the violation event and still returns an error
+	committed := false
 	defer func() {
-		if err != nil {
+		if err != nil && !committed {
 			_ = tx.Rollback(ctx)
 		}
 	}()
@@ -70,26 +133,87 @@ func (s *Service) Follow(ctx context.Context, followerID, followeeID int64) (err
 
 	exists, err := followRepo.Exists(ctx, followerID, followeeID)
 	if err != nil {
-		s.log.Error("Error checking follow existence", slog.String("error", err.Error()))
+		s.logger(ctx).Error("Error checking follow existence", slog.String("error", err.Error()))
 		return err
 	}
 	if exists {
 		return custom_errors.ErrAlreadyFollowing
 	}
 
-	follower, err := followRepo.Create(ctx, followerID, followeeID)
+	if s.limits.Enabled() {
+		var violation *model.FollowLimitViolation
+		violation, err = s.checkFollowLimits(ctx, tx, followerID, followeeID, 0)
+		if err != nil {
+			return err
+		}
+		if violation != nil {
+			// The follow is not created, but trust & safety must still receive the violation event
+			if err = tx.Commit(ctx); err != nil {
+				s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+				return custom_errors.ErrDatabaseQuery
+			}
+			committed = true
+			return violation
+		}
+	}
+
+	// Claimed only now, a rejected follow commits the violation event and must not commit the key
+	claimed, err := s.claimIdempotencyKey(ctx, tx, followerID, model.IdempotentOperationFollow, followeeID)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		_ = tx.Rollback(ctx)
+		return nil
+	}
+
+	follower, created, err := followRepo.Create(ctx, followerID, followeeID, model.FollowAttributionFromContext(ctx))
 	if err != nil {
-		s.log.Error("Error creating follow relationship", slog.String("error", err.Error()))
+		s.logger(ctx).Error("Error creating follow relationship", slog.String("error", err.Error()))
+		return err
+	}
+	if !created {
+		// A concurrent request created the follow after the existence check
+		return custom_errors.ErrAlreadyFollowing
+	}
+
+	if s.limits.Enabled() {
+		err = tx.FollowActionRepository().Record(ctx, followerID, followeeID, model.FollowActionFollow)
+		if err != nil {
+			s.logger(ctx).Error("Error recording follow action", slog.String("error", err.Error()))
+			return err
+		}
+	}
+
+	if err = s.recordRelationChange(ctx, tx, historyEntry(ctx, followerID, followerID, followeeID, model.RelationActionFollow)); err != nil {
+		return err
+	}
+
+	if err = s.addFollowCreatedEvent(ctx, outboxRepo, follower); err != nil {
 		return err
 	}
 
-	payload, err := json.Marshal(events.FollowCreatedPayload{
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	s.suggestionCache.Invalidate(ctx, followerID)
+
+	s.logger(ctx).Info("Follow relationship created successfully", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
+	return nil
+}
+
+func (s *Service) addFollowCreatedEvent(ctx context.Context, outboxRepo outbox.OutboxRepository, follower model.Follower) error {
+	payload, err := json.Marshal(model.FollowCreatedPayload{
 		FollowerID:  follower.FollowerID,
 		FolloweeID:  follower.FolloweeID,
+		Source:      follower.Source,
+		ContextID:   follower.ContextID,
 		Timestamptz: time.Now(),
 	})
 	if err != nil {
-		s.log.Error("Failed to marshal payload", slog.String("error", err.Error()))
+		s.logger(ctx).Error("Failed to marshal payload", slog.String("error", err.Error()))
 		return err
 	}
 
@@ -101,54 +225,119 @@ func (s *Service) Follow(ctx context.Context, followerID, followeeID int64) (err
 
 	err = outboxRepo.AddEvent(ctx, event)
 	if err != nil {
-		s.log.Error("Error adding event to outbox", slog.String("error", err.Error()))
+		s.logger(ctx).Error("Error adding event to outbox", slog.String("error", err.Error()))
 		return err
 	}
+	return nil
+}
 
-	err = tx.Commit(ctx)
+func (s *Service) addFollowDeletedEvent(ctx context.Context, outboxRepo outbox.OutboxRepository, follower model.Follower) error {
+	payload, err := json.Marshal(model.FollowDeletedPayload{
+		FollowerID:  follower.FollowerID,
+		FolloweeID:  follower.FolloweeID,
+		Timestamptz: time.Now(),
+	})
 	if err != nil {
-		s.log.Error("Failed to commit transaction", slog.String("error", err.Error()))
-		return custom_errors.ErrDatabaseQuery
+		s.logger(ctx).Error("Failed to marshal payload", slog.String("error", err.Error()))
+		return err
+	}
+
+	event := model.OutboxEvent{
+		EventType:   events.EventTypeFollowDeleted,
+		Payload:     payload,
+		AggregateID: follower.ID,
 	}
 
-	s.log.Info("Follow relationship created successfully", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
+	err = outboxRepo.AddEvent(ctx, event)
+	if err != nil {
+		s.logger(ctx).Error("Error adding event to outbox", slog.String("error", err.Error()))
+		return err
+	}
 	return nil
 }
 
-func (s *Service) Unfollow(ctx context.Context, followerID, followeeID int64) error {
-	s.log.Info("Unfollow request received", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
+func (s *Service) Unfollow(ctx context.Context, followerID, followeeID int64) (err error) {
+	s.logger(ctx).Info("Unfollow request received", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
 
 	if followerID == followeeID {
 		return custom_errors.ErrSelfUnfollow
 	}
 
-	exists, err := s.followRepo.Exists(ctx, followerID, followeeID)
+	if err = s.authorizeActor(ctx, followerID); err != nil {
+		return err
+	}
+
+	replay, err := s.replayIdempotent(ctx, followerID, model.IdempotentOperationUnfollow, followeeID)
+	if err != nil || replay {
+		return err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to start transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	claimed, err := s.claimIdempotencyKey(ctx, tx, followerID, model.IdempotentOperationUnfollow, followeeID)
 	if err != nil {
-		s.log.Error("Error checking follow existence", slog.String("error", err.Error()))
 		return err
 	}
-	if !exists {
-		return custom_errors.ErrFollowRelationNotFound
+	if !claimed {
+		_ = tx.Rollback(ctx)
+		return nil
 	}
 
-	err = s.followRepo.Delete(ctx, followerID, followeeID)
+	// Only the transaction whose DELETE returned the row goes on to record the unfollow
+	follower, err := tx.FollowRepository().Delete(ctx, followerID, followeeID)
 	if err != nil {
-		s.log.Error("Error deleting follow relationship", slog.String("error", err.Error()))
+		s.logger(ctx).Error("Error deleting follow relationship", slog.String("error", err.Error()))
+		return err
+	}
+
+	if s.limits.Enabled() {
+		err = tx.FollowActionRepository().Record(ctx, followerID, followeeID, model.FollowActionUnfollow)
+		if err != nil {
+			s.logger(ctx).Error("Error recording unfollow action", slog.String("error", err.Error()))
+			return err
+		}
+	}
+
+	if err = s.recordRelationChange(ctx, tx, historyEntry(ctx, followerID, followerID, followeeID, model.RelationActionUnfollow)); err != nil {
 		return err
 	}
 
-	s.log.Info("Follow relationship deleted successfully", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
+	if err = s.revokeAudienceMembership(ctx, tx, followerID, followeeID); err != nil {
+		return err
+	}
+
+	if err = s.addFollowDeletedEvent(ctx, tx.OutboxRepository(), follower); err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		s.logger(ctx).Error("Failed to commit transaction", slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	s.suggestionCache.Invalidate(ctx, followerID)
+
+	s.logger(ctx).Info("Follow relationship deleted successfully", slog.Int64("followerID", followerID), slog.Int64("followeeID", followeeID))
 	return nil
 }
 
 func (s *Service) GetFollowers(ctx context.Context, followeeID int64, limit, page int32) ([]*model.User, int64, error) {
-	s.log.Info("GetFollowers request received", slog.Int64("followeeID", followeeID))
+	s.logger(ctx).Info("GetFollowers request received", slog.Int64("followeeID", followeeID))
 	_, err := s.userClient.GetUser(ctx, followeeID)
 	if err != nil {
-		s.log.Error("Failed to get user", slog.Int64("followeeID", followeeID))
+		s.logger(ctx).Error("Failed to get user", slog.Int64("followeeID", followeeID))
 		switch {
 		case errors.Is(err, custom_errors.ErrUserNotFound):
-			s.log.Debug("User not found in GetFollowers", slog.Int64("followeeID", followeeID), slog.String("error", err.Error()))
+			s.logger(ctx).Debug("User not found in GetFollowers", slog.Int64("followeeID", followeeID), slog.String("error", err.Error()))
 			return nil, 0, custom_errors.ErrUserNotFound
 		default:
 			return nil, 0, err
@@ -157,7 +346,7 @@ func (s *Service) GetFollowers(ctx context.Context, followeeID int64, limit, pag
 	limit, offset := utils.SetPaginationDefaults(limit, page)
 	followerIDs, total, err := s.followRepo.GetFollowers(ctx, followeeID, limit, offset)
 	if err != nil {
-		s.log.Error("Error getting followers", slog.String("error", err.Error()))
+		s.logger(ctx).Error("Error getting followers", slog.String("error", err.Error()))
 		return nil, 0, err
 	}
 
@@ -165,7 +354,7 @@ func (s *Service) GetFollowers(ctx context.Context, followeeID int64, limit, pag
 	for _, followerID := range followerIDs {
 		user, err := s.userClient.GetUser(ctx, followerID)
 		if err != nil {
-			s.log.Error("Failed to get follower user", slog.Int64("followerID", followerID), slog.String("error", err.Error()))
+			s.logger(ctx).Error("Failed to get follower user", slog.Int64("followerID", followerID), slog.String("error", err.Error()))
 			missingUser := &model.User{
 				ID:       followerID,
 				Username: "Missing user",
@@ -176,18 +365,18 @@ func (s *Service) GetFollowers(ctx context.Context, followeeID int64, limit, pag
 		followers = append(followers, user)
 	}
 
-	s.log.Info("Followers retrieved successfully", slog.Int64("followeeID", followeeID), slog.Int("count", len(followers)), slog.Int64("total", total))
+	s.logger(ctx).Info("Followers retrieved successfully", slog.Int64("followeeID", followeeID), slog.Int("count", len(followers)), slog.Int64("total", total))
 	return followers, total, nil
 }
 
 func (s *Service) GetFollowees(ctx context.Context, followerID int64, limit, page int32) ([]*model.User, int64, error) {
-	s.log.Info("GetFollowees request received", slog.Int64("followerID", followerID))
+	s.logger(ctx).Info("GetFollowees request received", slog.Int64("followerID", followerID))
 	_, err := s.userClient.GetUser(ctx, followerID)
 	if err != nil {
-		s.log.Error("Failed to get user", slog.Int64("followerID", followerID))
+		s.logger(ctx).Error("Failed to get user", slog.Int64("followerID", followerID))
 		switch {
 		case errors.Is(err, custom_errors.ErrUserNotFound):
-			s.log.Debug("User not found in GetFollowees", slog.Int64("followerID", followerID), slog.String("error", err.Error()))
+			s.logger(ctx).Debug("User not found in GetFollowees", slog.Int64("followerID", followerID), slog.String("error", err.Error()))
 			return nil, 0, custom_errors.ErrUserNotFound
 		default:
 			return nil, 0, err
@@ -196,7 +385,7 @@ func (s *Service) GetFollowees(ctx context.Context, followerID int64, limit, pag
 	limit, offset := utils.SetPaginationDefaults(limit, page)
 	followeeIDs, total, err := s.followRepo.GetFollowees(ctx, followerID, limit, offset)
 	if err != nil {
-		s.log.Error("Error getting followees", slog.String("error", err.Error()))
+		s.logger(ctx).Error("Error getting followees", slog.String("error", err.Error()))
 		return nil, 0, err
 	}
 
@@ -204,7 +393,7 @@ func (s *Service) GetFollowees(ctx context.Context, followerID int64, limit, pag
 	for _, followeeID := range followeeIDs {
 		user, err := s.userClient.GetUser(ctx, followeeID)
 		if err != nil {
-			s.log.Error("Failed to get followee user", slog.Int64("followeeID", followeeID), slog.String("error", err.Error()))
+			s.logger(ctx).Error("Failed to get followee user", slog.Int64("followeeID", followeeID), slog.String("error", err.Error()))
 			missingUser := &model.User{
 				ID:       followeeID,
 				Username: "Missing user",
@@ -215,6 +404,6 @@ func (s *Service) GetFollowees(ctx context.Context, followerID int64, limit, pag
 		followees = append(followees, user)
 	}
 
-	s.log.Info("Followees retrieved successfully", slog.Int64("followerID", followerID), slog.Int("count", len(followees)), slog.Int64("total", total))
+	s.logger(ctx).Info("Followees retrieved successfully", slog.Int64("followerID", followerID), slog.Int("count", len(followees)), slog.Int64("total", total))
 	return followees, total, nil
 }
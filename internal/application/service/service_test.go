@@ -2,19 +2,25 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	model "pinstack-relation-service/internal/domain/models"
 	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	memory_cache "pinstack-relation-service/internal/infrastructure/outbound/cache/memory"
 	"pinstack-relation-service/mocks"
 	"testing"
+	"time"
 
 	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/soloda1/pinstack-proto-definitions/events"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+const testIdempotencyTTL = time.Hour
+
 func setupTest(t *testing.T) (*Service, *mocks.FollowRepository, *mocks.UnitOfWork, *mocks.Transaction, *mocks.OutboxRepository, *mocks.Client) {
 	mockFollowRepo := mocks.NewFollowRepository(t)
 	mockUOW := mocks.NewUnitOfWork(t)
@@ -27,11 +33,43 @@ func setupTest(t *testing.T) (*Service, *mocks.FollowRepository, *mocks.UnitOfWo
 
 	log := infra_logger.New("test")
 
-	svc := NewFollowService(log, mockFollowRepo, mockUOW, mockUserClient)
+	svc := NewFollowService(log, mockFollowRepo, mocks.NewSuggestionRepository(t), mocks.NewProfileRepository(t), mocks.NewRelationHistoryRepository(t), mocks.NewIdempotencyRepository(t), mocks.NewMuteRepository(t), mocks.NewAudienceListRepository(t), mocks.NewFollowStatsRepository(t), mocks.NewFollowerGrowthRepository(t), mockUOW, mockUserClient, mocks.NewPostClient(t), newSuggestionCache(t), model.FollowLimits{}, model.AudienceListPolicy{}, testIdempotencyTTL)
 
 	return svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, mockUserClient
 }
 
+// expectRelationHistory makes tx record exactly entry in the relation history
+func expectRelationHistory(t *testing.T, tx *mocks.Transaction, entry model.RelationHistoryEntry) {
+	historyRepo := mocks.NewRelationHistoryRepository(t)
+	tx.On("RelationHistoryRepository").Return(historyRepo)
+	historyRepo.On("Record", mock.Anything, entry).Return(nil)
+}
+
+// newHistoryRepoForTx makes tx hand out a relation history repository mock, for changes
+// that record several entries in one transaction
+func newHistoryRepoForTx(t *testing.T, tx *mocks.Transaction) *mocks.RelationHistoryRepository {
+	historyRepo := mocks.NewRelationHistoryRepository(t)
+	tx.On("RelationHistoryRepository").Return(historyRepo)
+	return historyRepo
+}
+
+// userHistoryEntry is the history record of a change the follower made themselves
+func userHistoryEntry(followerID, followeeID int64, action model.RelationAction) model.RelationHistoryEntry {
+	return model.RelationHistoryEntry{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+		Action:     action,
+		ActorID:    &followerID,
+		Reason:     model.RelationReasonUser,
+	}
+}
+
+func newSuggestionCache(t *testing.T) *memory_cache.SuggestionCache {
+	suggestionCache := memory_cache.NewSuggestionCache(time.Minute, time.Minute)
+	t.Cleanup(suggestionCache.Close)
+	return suggestionCache
+}
+
 func TestService_Follow(t *testing.T) {
 	t.Run("успешное создание подписки", func(t *testing.T) {
 		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, mockUserClient := setupTest(t)
@@ -49,7 +87,8 @@ func TestService_Follow(t *testing.T) {
 			FollowerID: followerID,
 			FolloweeID: followeeID,
 		}
-		mockFollowRepo.On("Create", ctx, followerID, followeeID).Return(follower, nil)
+		mockFollowRepo.On("Create", ctx, followerID, followeeID, model.FollowAttribution{}).Return(follower, true, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, followeeID, model.RelationActionFollow))
 
 		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil)
 		mockTx.On("Commit", ctx).Return(nil)
@@ -166,7 +205,7 @@ func TestService_Follow(t *testing.T) {
 		mockTx.On("FollowRepository").Return(mockFollowRepo)
 		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
 		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(false, nil)
-		mockFollowRepo.On("Create", ctx, followerID, followeeID).Return(model.Follower{}, errors.New("db error"))
+		mockFollowRepo.On("Create", ctx, followerID, followeeID, model.FollowAttribution{}).Return(model.Follower{}, false, errors.New("db error"))
 		mockTx.On("Rollback", ctx).Return(nil)
 
 		err := svc.Follow(ctx, followerID, followeeID)
@@ -194,7 +233,8 @@ func TestService_Follow(t *testing.T) {
 			FollowerID: followerID,
 			FolloweeID: followeeID,
 		}
-		mockFollowRepo.On("Create", ctx, followerID, followeeID).Return(follower, nil)
+		mockFollowRepo.On("Create", ctx, followerID, followeeID, model.FollowAttribution{}).Return(follower, true, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, followeeID, model.RelationActionFollow))
 
 		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(errors.New("outbox error"))
 		mockTx.On("Rollback", ctx).Return(nil)
@@ -225,7 +265,8 @@ func TestService_Follow(t *testing.T) {
 			FollowerID: followerID,
 			FolloweeID: followeeID,
 		}
-		mockFollowRepo.On("Create", ctx, followerID, followeeID).Return(follower, nil)
+		mockFollowRepo.On("Create", ctx, followerID, followeeID, model.FollowAttribution{}).Return(follower, true, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, followeeID, model.RelationActionFollow))
 
 		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil)
 		mockTx.On("Commit", ctx).Return(errors.New("commit error"))
@@ -241,21 +282,99 @@ func TestService_Follow(t *testing.T) {
 		mockOutboxRepo.AssertExpectations(t)
 		mockUserClient.AssertExpectations(t)
 	})
+
+	t.Run("запрет подписки от имени другого пользователя", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, _, _, mockUserClient := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 3})
+		followerID, followeeID := int64(1), int64(2)
+
+		err := svc.Follow(ctx, followerID, followeeID)
+
+		assert.Error(t, err)
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+		mockFollowRepo.AssertNotCalled(t, "Exists")
+		mockUOW.AssertNotCalled(t, "Begin")
+		mockUserClient.AssertNotCalled(t, "GetUser")
+	})
+
+	t.Run("сервисный аккаунт с ролью admin подписывает другого пользователя", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, mockUserClient := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{
+			UserID:         100,
+			Roles:          []string{model.RoleAdmin},
+			ServiceAccount: true,
+		})
+		followerID, followeeID := int64(1), int64(2)
+
+		mockUserClient.On("GetUser", ctx, followeeID).Return(&model.User{ID: followeeID}, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(false, nil)
+		mockFollowRepo.On("Create", ctx, followerID, followeeID, model.FollowAttribution{}).Return(model.Follower{FollowerID: followerID, FolloweeID: followeeID}, true, nil)
+		adminID := int64(100)
+		expectRelationHistory(t, mockTx, model.RelationHistoryEntry{
+			FollowerID: followerID,
+			FolloweeID: followeeID,
+			Action:     model.RelationActionFollow,
+			ActorID:    &adminID,
+			Reason:     model.RelationReasonAdmin,
+		})
+		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
+
+		err := svc.Follow(ctx, followerID, followeeID)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("пользователь с ролью admin без сервисного аккаунта не может действовать за других", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{
+			UserID: 100,
+			Roles:  []string{model.RoleAdmin},
+		})
+
+		err := svc.Follow(ctx, 1, 2)
+
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+		mockUOW.AssertNotCalled(t, "Begin")
+	})
+}
+
+func isFollowDeletedEvent(followID, followerID, followeeID int64) func(model.OutboxEvent) bool {
+	return func(event model.OutboxEvent) bool {
+		var payload model.FollowDeletedPayload
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return false
+		}
+		return event.EventType == events.EventTypeFollowDeleted &&
+			event.AggregateID == followID &&
+			payload.FollowerID == followerID &&
+			payload.FolloweeID == followeeID
+	}
 }
 
 func TestService_Unfollow(t *testing.T) {
 	t.Run("успешное удаление подписки", func(t *testing.T) {
-		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
 		ctx := context.Background()
 		followerID, followeeID := int64(1), int64(2)
 
-		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(true, nil)
-		mockFollowRepo.On("Delete", ctx, followerID, followeeID).Return(nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Delete", ctx, followerID, followeeID).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, followeeID, model.RelationActionUnfollow))
+		mockOutboxRepo.On("AddEvent", ctx, mock.MatchedBy(isFollowDeletedEvent(10, followerID, followeeID))).Return(nil)
+		mockTx.On("Commit", ctx).Return(nil)
 
 		err := svc.Unfollow(ctx, followerID, followeeID)
 
 		assert.NoError(t, err)
 		mockFollowRepo.AssertExpectations(t)
+		mockFollowRepo.AssertNotCalled(t, "Exists", mock.Anything, mock.Anything, mock.Anything)
+		mockTx.AssertNotCalled(t, "Rollback", ctx)
 	})
 
 	t.Run("ошибка при попытке отписаться от себя", func(t *testing.T) {
@@ -269,25 +388,56 @@ func TestService_Unfollow(t *testing.T) {
 		assert.Equal(t, custom_errors.ErrSelfUnfollow, err)
 	})
 
+	t.Run("запрет отписки от имени другого пользователя", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 3})
+
+		err := svc.Unfollow(ctx, 1, 2)
+
+		assert.Error(t, err)
+		assert.Equal(t, custom_errors.ErrForbidden, err)
+		mockUOW.AssertNotCalled(t, "Begin")
+	})
+
 	t.Run("подписка не существует", func(t *testing.T) {
-		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
 		ctx := context.Background()
 		followerID, followeeID := int64(1), int64(2)
 
-		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(false, nil)
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockFollowRepo.On("Delete", ctx, followerID, followeeID).Return(model.Follower{}, custom_errors.ErrFollowRelationNotFound)
+		mockTx.On("Rollback", ctx).Return(nil)
 
 		err := svc.Unfollow(ctx, followerID, followeeID)
 
 		assert.Error(t, err)
 		assert.Equal(t, custom_errors.ErrFollowRelationNotFound, err)
+		mockTx.AssertNotCalled(t, "RelationHistoryRepository")
+		mockOutboxRepo.AssertNotCalled(t, "AddEvent", mock.Anything, mock.Anything)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("ошибка при старте транзакции", func(t *testing.T) {
+		svc, _, mockUOW, _, _, _ := setupTest(t)
+		ctx := context.Background()
+
+		mockUOW.On("Begin", ctx).Return(nil, errors.New("tx error"))
+
+		err := svc.Unfollow(ctx, 1, 2)
+
+		assert.Equal(t, custom_errors.ErrDatabaseQuery, err)
 	})
 
-	t.Run("ошибка при проверке существования подписки", func(t *testing.T) {
-		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+	t.Run("ошибка при удалении подписки", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, _, _ := setupTest(t)
 		ctx := context.Background()
 		followerID, followeeID := int64(1), int64(2)
 
-		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(false, errors.New("db error"))
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockFollowRepo.On("Delete", ctx, followerID, followeeID).Return(model.Follower{}, errors.New("db error"))
+		mockTx.On("Rollback", ctx).Return(nil)
 
 		err := svc.Unfollow(ctx, followerID, followeeID)
 
@@ -295,18 +445,42 @@ func TestService_Unfollow(t *testing.T) {
 		mockFollowRepo.AssertExpectations(t)
 	})
 
-	t.Run("ошибка при удалении подписки", func(t *testing.T) {
-		svc, mockFollowRepo, _, _, _, _ := setupTest(t)
+	t.Run("ошибка записи истории откатывает отписку", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, _, _ := setupTest(t)
 		ctx := context.Background()
 		followerID, followeeID := int64(1), int64(2)
+		historyRepo := mocks.NewRelationHistoryRepository(t)
 
-		mockFollowRepo.On("Exists", ctx, followerID, followeeID).Return(true, nil)
-		mockFollowRepo.On("Delete", ctx, followerID, followeeID).Return(errors.New("db error"))
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockFollowRepo.On("Delete", ctx, followerID, followeeID).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, nil)
+		mockTx.On("RelationHistoryRepository").Return(historyRepo)
+		historyRepo.On("Record", ctx, mock.AnythingOfType("model.RelationHistoryEntry")).Return(custom_errors.ErrDatabaseQuery)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		err := svc.Unfollow(ctx, followerID, followeeID)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
+	})
+
+	t.Run("ошибка записи события откатывает отписку", func(t *testing.T) {
+		svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
+		ctx := context.Background()
+		followerID, followeeID := int64(1), int64(2)
+
+		mockUOW.On("Begin", ctx).Return(mockTx, nil)
+		mockTx.On("FollowRepository").Return(mockFollowRepo)
+		mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+		mockFollowRepo.On("Delete", ctx, followerID, followeeID).Return(model.Follower{ID: 10, FollowerID: followerID, FolloweeID: followeeID}, nil)
+		expectRelationHistory(t, mockTx, userHistoryEntry(followerID, followeeID, model.RelationActionUnfollow))
+		mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(errors.New("outbox error"))
+		mockTx.On("Rollback", ctx).Return(nil)
 
 		err := svc.Unfollow(ctx, followerID, followeeID)
 
 		assert.Error(t, err)
-		mockFollowRepo.AssertExpectations(t)
+		mockTx.AssertNotCalled(t, "Commit", ctx)
 	})
 }
 
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+// GetSuggestions returns who-to-follow candidates for userID, best first. The full
+// ranking is cached per user, read from the precomputed table when the batch has
+// covered the user and ranked from the follow graph otherwise.
+func (s *Service) GetSuggestions(ctx context.Context, userID int64, limit int32) ([]*model.SuggestedUser, error) {
+	s.logger(ctx).Info("GetSuggestions request received", slog.Int64("userID", userID))
+
+	if err := s.authorizeActor(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	_, err := s.userClient.GetUser(ctx, userID)
+	if err != nil {
+		s.logger(ctx).Error("Failed to get user", slog.Int64("userID", userID))
+		switch {
+		case errors.Is(err, custom_errors.ErrUserNotFound):
+			s.logger(ctx).Debug("User not found in GetSuggestions", slog.Int64("userID", userID), slog.String("error", err.Error()))
+			return nil, custom_errors.ErrUserNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	if limit <= 0 || limit > model.MaxSuggestions {
+		limit = model.MaxSuggestions
+	}
+
+	ranked, err := s.loadSuggestions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(ranked) > int(limit) {
+		ranked = ranked[:limit]
+	}
+
+	candidateIDs := make([]int64, 0, len(ranked))
+	for _, suggestion := range ranked {
+		candidateIDs = append(candidateIDs, suggestion.UserID)
+	}
+	users := s.resolveUsers(ctx, candidateIDs)
+
+	suggestions := make([]*model.SuggestedUser, 0, len(ranked))
+	for i, suggestion := range ranked {
+		suggestions = append(suggestions, &model.SuggestedUser{
+			User:        users[i],
+			MutualCount: suggestion.MutualCount,
+			Score:       suggestion.Score,
+		})
+	}
+
+	s.logger(ctx).Info("Suggestions retrieved successfully", slog.Int64("userID", userID), slog.Int("count", len(suggestions)))
+	return suggestions, nil
+}
+
+func (s *Service) loadSuggestions(ctx context.Context, userID int64) ([]model.Suggestion, error) {
+	if cached, ok := s.suggestionCache.Get(ctx, userID); ok {
+		return cached, nil
+	}
+
+	ranked, err := s.suggestionRepo.GetStored(ctx, userID, model.MaxSuggestions)
+	if err != nil {
+		s.logger(ctx).Error("Error getting stored suggestions", slog.Int64("userID", userID), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if len(ranked) == 0 {
+		ranked, err = s.suggestionRepo.Rank(ctx, userID, model.MaxSuggestions)
+		if err != nil {
+			s.logger(ctx).Error("Error ranking suggestions", slog.Int64("userID", userID), slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+
+	s.suggestionCache.Set(ctx, userID, ranked)
+	return ranked, nil
+}
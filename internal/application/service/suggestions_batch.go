@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/domain/ports/output/repository"
+	"time"
+)
+
+// SuggestionPrecomputer ranks suggestions for recently active users offline and
+// stores them, so GetSuggestions rarely has to run the ranking query itself.
+type SuggestionPrecomputer struct {
+	repo      repository.SuggestionRepository
+	batchSize int32
+	log       ports.Logger
+}
+
+func NewSuggestionPrecomputer(log ports.Logger, repo repository.SuggestionRepository, batchSize int32) *SuggestionPrecomputer {
+	return &SuggestionPrecomputer{
+		repo:      repo,
+		batchSize: batchSize,
+		log:       log,
+	}
+}
+
+// Run precomputes suggestions for every user that followed someone since activeSince.
+// A user that fails is logged and skipped; only failing to list users aborts the run.
+func (p *SuggestionPrecomputer) Run(ctx context.Context, activeSince time.Time) (processed int, failed int, err error) {
+	p.log.Info("Precomputing suggestions", slog.Time("activeSince", activeSince), slog.Int("batchSize", int(p.batchSize)))
+
+	var afterID int64
+	for {
+		userIDs, err := p.repo.ListActiveUsers(ctx, activeSince, afterID, p.batchSize)
+		if err != nil {
+			p.log.Error("Failed to list active users", slog.Int64("afterID", afterID), slog.String("error", err.Error()))
+			return processed, failed, err
+		}
+
+		for _, userID := range userIDs {
+			if err := ctx.Err(); err != nil {
+				return processed, failed, err
+			}
+			if err := p.precompute(ctx, userID); err != nil {
+				p.log.Warn("Failed to precompute suggestions", slog.Int64("userID", userID), slog.String("error", err.Error()))
+				failed++
+				continue
+			}
+			processed++
+		}
+
+		if len(userIDs) < int(p.batchSize) {
+			break
+		}
+		afterID = userIDs[len(userIDs)-1]
+	}
+
+	p.log.Info("Suggestions precomputed", slog.Int("processed", processed), slog.Int("failed", failed))
+	return processed, failed, nil
+}
+
+func (p *SuggestionPrecomputer) precompute(ctx context.Context, userID int64) error {
+	ranked, err := p.repo.Rank(ctx, userID, model.MaxSuggestions)
+	if err != nil {
+		return err
+	}
+	return p.repo.Store(ctx, userID, ranked)
+}
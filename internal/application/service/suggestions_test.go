@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	model "pinstack-relation-service/internal/domain/models"
+	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	memory_cache "pinstack-relation-service/internal/infrastructure/outbound/cache/memory"
+	"pinstack-relation-service/mocks"
+	"testing"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSuggestionsTest(t *testing.T) (*Service, *mocks.SuggestionRepository, *mocks.Client, *memory_cache.SuggestionCache) {
+	mockSuggestionRepo := mocks.NewSuggestionRepository(t)
+	mockUserClient := mocks.NewClient(t)
+	suggestionCache := newSuggestionCache(t)
+
+	svc := NewFollowService(infra_logger.New("test"), mocks.NewFollowRepository(t), mockSuggestionRepo, mocks.NewProfileRepository(t), mocks.NewRelationHistoryRepository(t), mocks.NewIdempotencyRepository(t), mocks.NewMuteRepository(t), mocks.NewAudienceListRepository(t), mocks.NewFollowStatsRepository(t), mocks.NewFollowerGrowthRepository(t), mocks.NewUnitOfWork(t), mockUserClient, mocks.NewPostClient(t), suggestionCache, model.FollowLimits{}, model.AudienceListPolicy{}, testIdempotencyTTL)
+	return svc, mockSuggestionRepo, mockUserClient, suggestionCache
+}
+
+func TestService_GetSuggestions(t *testing.T) {
+	ranked := []model.Suggestion{
+		{UserID: 3, MutualCount: 4, Score: 3.5},
+		{UserID: 4, MutualCount: 2, Score: 1.9},
+		{UserID: 5, MutualCount: 1, Score: 0.4},
+	}
+
+	t.Run("предрассчитанные рекомендации", func(t *testing.T) {
+		svc, mockSuggestionRepo, mockUserClient, _ := setupSuggestionsTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockSuggestionRepo.On("GetStored", ctx, int64(1), int32(model.MaxSuggestions)).Return(ranked, nil)
+		mockUserClient.On("GetUser", ctx, int64(3)).Return(&model.User{ID: 3, Username: "alice"}, nil)
+		mockUserClient.On("GetUser", ctx, int64(4)).Return(nil, errors.New("user service unavailable"))
+
+		suggestions, err := svc.GetSuggestions(ctx, 1, 2)
+
+		require.NoError(t, err)
+		require.Len(t, suggestions, 2)
+		assert.Equal(t, "alice", suggestions[0].User.Username)
+		assert.Equal(t, int64(4), suggestions[0].MutualCount)
+		assert.Equal(t, 3.5, suggestions[0].Score)
+		assert.Equal(t, "Missing user", suggestions[1].User.Username)
+		mockSuggestionRepo.AssertNotCalled(t, "Rank", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ранжирование при отсутствии предрасчёта", func(t *testing.T) {
+		svc, mockSuggestionRepo, mockUserClient, suggestionCache := setupSuggestionsTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockSuggestionRepo.On("GetStored", ctx, int64(1), int32(model.MaxSuggestions)).Return([]model.Suggestion{}, nil)
+		mockSuggestionRepo.On("Rank", ctx, int64(1), int32(model.MaxSuggestions)).Return(ranked, nil)
+		mockUserClient.On("GetUser", ctx, int64(3)).Return(&model.User{ID: 3}, nil)
+
+		suggestions, err := svc.GetSuggestions(ctx, 1, 1)
+
+		require.NoError(t, err)
+		require.Len(t, suggestions, 1)
+		assert.Equal(t, int64(3), suggestions[0].User.ID)
+
+		cached, ok := suggestionCache.Get(ctx, 1)
+		require.True(t, ok)
+		assert.Equal(t, ranked, cached, "the full ranking is cached, not just the requested page")
+	})
+
+	t.Run("повторный запрос обслуживается из кэша", func(t *testing.T) {
+		svc, mockSuggestionRepo, mockUserClient, _ := setupSuggestionsTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, mock.AnythingOfType("int64")).Return(&model.User{}, nil)
+		mockSuggestionRepo.On("GetStored", ctx, int64(1), int32(model.MaxSuggestions)).Return(ranked, nil).Once()
+
+		_, err := svc.GetSuggestions(ctx, 1, 3)
+		require.NoError(t, err)
+		suggestions, err := svc.GetSuggestions(ctx, 1, 3)
+		require.NoError(t, err)
+
+		assert.Len(t, suggestions, 3)
+		mockSuggestionRepo.AssertNumberOfCalls(t, "GetStored", 1)
+	})
+
+	t.Run("пользователь не найден", func(t *testing.T) {
+		svc, mockSuggestionRepo, mockUserClient, _ := setupSuggestionsTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(nil, custom_errors.ErrUserNotFound)
+
+		suggestions, err := svc.GetSuggestions(ctx, 1, 10)
+
+		assert.ErrorIs(t, err, custom_errors.ErrUserNotFound)
+		assert.Nil(t, suggestions)
+		mockSuggestionRepo.AssertNotCalled(t, "GetStored", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("чужие рекомендации запрещены", func(t *testing.T) {
+		svc, _, mockUserClient, _ := setupSuggestionsTest(t)
+		ctx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 2})
+
+		suggestions, err := svc.GetSuggestions(ctx, 1, 10)
+
+		assert.ErrorIs(t, err, custom_errors.ErrForbidden)
+		assert.Nil(t, suggestions)
+		mockUserClient.AssertNotCalled(t, "GetUser", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ошибка ранжирования не кэшируется", func(t *testing.T) {
+		svc, mockSuggestionRepo, mockUserClient, suggestionCache := setupSuggestionsTest(t)
+		ctx := context.Background()
+
+		mockUserClient.On("GetUser", ctx, int64(1)).Return(&model.User{ID: 1}, nil)
+		mockSuggestionRepo.On("GetStored", ctx, int64(1), int32(model.MaxSuggestions)).Return([]model.Suggestion{}, nil)
+		mockSuggestionRepo.On("Rank", ctx, int64(1), int32(model.MaxSuggestions)).Return(nil, custom_errors.ErrDatabaseQuery)
+
+		suggestions, err := svc.GetSuggestions(ctx, 1, 10)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+		assert.Nil(t, suggestions)
+		_, ok := suggestionCache.Get(ctx, 1)
+		assert.False(t, ok)
+	})
+}
+
+func TestService_UnfollowInvalidatesSuggestions(t *testing.T) {
+	svc, mockFollowRepo, mockUOW, mockTx, mockOutboxRepo, _ := setupTest(t)
+	ctx := context.Background()
+
+	svc.suggestionCache.Set(ctx, 1, []model.Suggestion{{UserID: 3}})
+	mockUOW.On("Begin", ctx).Return(mockTx, nil)
+	mockTx.On("FollowRepository").Return(mockFollowRepo)
+	mockTx.On("OutboxRepository").Return(mockOutboxRepo)
+	mockFollowRepo.On("Delete", ctx, int64(1), int64(2)).Return(model.Follower{ID: 10, FollowerID: int64(1), FolloweeID: int64(2)}, nil)
+	expectRelationHistory(t, mockTx, userHistoryEntry(1, 2, model.RelationActionUnfollow))
+	mockOutboxRepo.On("AddEvent", ctx, mock.AnythingOfType("model.OutboxEvent")).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil)
+
+	require.NoError(t, svc.Unfollow(ctx, 1, 2))
+
+	_, ok := svc.suggestionCache.Get(ctx, 1)
+	assert.False(t, ok)
+}
+
+func TestSuggestionPrecomputer_Run(t *testing.T) {
+	activeSince := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("обход всех страниц активных пользователей", func(t *testing.T) {
+		mockSuggestionRepo := mocks.NewSuggestionRepository(t)
+		ctx := context.Background()
+		ranked := []model.Suggestion{{UserID: 9, MutualCount: 1, Score: 1}}
+
+		mockSuggestionRepo.On("ListActiveUsers", ctx, activeSince, int64(0), int32(2)).Return([]int64{1, 2}, nil)
+		mockSuggestionRepo.On("ListActiveUsers", ctx, activeSince, int64(2), int32(2)).Return([]int64{5}, nil)
+		for _, userID := range []int64{1, 2, 5} {
+			mockSuggestionRepo.On("Rank", ctx, userID, int32(model.MaxSuggestions)).Return(ranked, nil)
+			mockSuggestionRepo.On("Store", ctx, userID, ranked).Return(nil)
+		}
+
+		precomputer := NewSuggestionPrecomputer(infra_logger.New("test"), mockSuggestionRepo, 2)
+		processed, failed, err := precomputer.Run(ctx, activeSince)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, processed)
+		assert.Equal(t, 0, failed)
+	})
+
+	t.Run("ошибка одного пользователя не прерывает обход", func(t *testing.T) {
+		mockSuggestionRepo := mocks.NewSuggestionRepository(t)
+		ctx := context.Background()
+
+		mockSuggestionRepo.On("ListActiveUsers", ctx, activeSince, int64(0), int32(10)).Return([]int64{1, 2}, nil)
+		mockSuggestionRepo.On("Rank", ctx, int64(1), int32(model.MaxSuggestions)).Return(nil, custom_errors.ErrDatabaseQuery)
+		mockSuggestionRepo.On("Rank", ctx, int64(2), int32(model.MaxSuggestions)).Return([]model.Suggestion{}, nil)
+		mockSuggestionRepo.On("Store", ctx, int64(2), []model.Suggestion{}).Return(nil)
+
+		precomputer := NewSuggestionPrecomputer(infra_logger.New("test"), mockSuggestionRepo, 10)
+		processed, failed, err := precomputer.Run(ctx, activeSince)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+		assert.Equal(t, 1, failed)
+	})
+
+	t.Run("ошибка получения пользователей", func(t *testing.T) {
+		mockSuggestionRepo := mocks.NewSuggestionRepository(t)
+		ctx := context.Background()
+
+		mockSuggestionRepo.On("ListActiveUsers", ctx, activeSince, int64(0), int32(10)).Return(nil, custom_errors.ErrDatabaseQuery)
+
+		precomputer := NewSuggestionPrecomputer(infra_logger.New("test"), mockSuggestionRepo, 10)
+		_, _, err := precomputer.Run(ctx, activeSince)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
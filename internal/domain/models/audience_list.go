@@ -0,0 +1,64 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+const (
+	// MaxAudienceMembersPerRequest bounds a single bulk membership change
+	MaxAudienceMembersPerRequest = 100
+	MaxAudienceListNameLength    = 64
+)
+
+var (
+	ErrAudienceListNotFound = errors.New("audience list not found")
+	ErrAudienceListExists   = errors.New("audience list with this name already exists")
+	// ErrAudienceListLimit is returned when an owner has too many lists or a list too many members
+	ErrAudienceListLimit = errors.New("audience list limit reached")
+	// ErrAudienceMemberNotFollower is returned when followers are required and a new member does not follow the owner
+	ErrAudienceMemberNotFollower = errors.New("audience list member must follow the owner")
+)
+
+const (
+	EventTypeAudienceMemberAdded   events.EventType = "audience_member_added"
+	EventTypeAudienceMemberRemoved events.EventType = "audience_member_removed"
+	// EventTypeAudienceListDeleted drops every membership of the list at once
+	EventTypeAudienceListDeleted events.EventType = "audience_list_deleted"
+)
+
+// AudienceList is a named set of users, such as close friends, that its owner shares content with
+type AudienceList struct {
+	ID          int64     `json:"id"`
+	OwnerID     int64     `json:"owner_id"`
+	Name        string    `json:"name"`
+	MemberCount int64     `json:"member_count"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// AudienceListPolicy limits the audience lists of one owner. Zero limits disable the check.
+type AudienceListPolicy struct {
+	// RequireFollowers only lets users that follow the owner be added, and takes a member off
+	// every list of the owner once the follow ends.
+	RequireFollowers bool
+	MaxLists         int
+	MaxMembers       int
+}
+
+// AudienceMemberPayload is the outbox payload of audience_member_added and audience_member_removed events
+type AudienceMemberPayload struct {
+	ListID      int64     `json:"list_id"`
+	OwnerID     int64     `json:"owner_id"`
+	MemberID    int64     `json:"member_id"`
+	Timestamptz time.Time `json:"timestamptz"`
+}
+
+// AudienceListDeletedPayload is the outbox payload of audience_list_deleted events
+type AudienceListDeletedPayload struct {
+	ListID      int64     `json:"list_id"`
+	OwnerID     int64     `json:"owner_id"`
+	Timestamptz time.Time `json:"timestamptz"`
+}
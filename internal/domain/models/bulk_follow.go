@@ -0,0 +1,23 @@
+package model
+
+// MaxBulkFollowTargets bounds a single bulk follow or unfollow
+const MaxBulkFollowTargets = 100
+
+// BulkOutcome is what a bulk follow or unfollow did with one target
+type BulkOutcome string
+
+const (
+	BulkOutcomeCreated          BulkOutcome = "created"
+	BulkOutcomeAlreadyFollowing BulkOutcome = "already_following"
+	BulkOutcomeNotFound         BulkOutcome = "not_found"
+	// BulkOutcomeBlocked is a follow refused by the anti-spam rules
+	BulkOutcomeBlocked      BulkOutcome = "blocked"
+	BulkOutcomeRemoved      BulkOutcome = "removed"
+	BulkOutcomeNotFollowing BulkOutcome = "not_following"
+)
+
+// BulkResult is the outcome of a bulk follow or unfollow for one target user
+type BulkResult struct {
+	TargetID int64       `json:"target_id"`
+	Outcome  BulkOutcome `json:"outcome"`
+}
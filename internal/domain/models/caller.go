@@ -0,0 +1,39 @@
+package model
+
+import (
+	"context"
+	"slices"
+)
+
+const RoleAdmin = "admin"
+
+// Caller is the authenticated identity of the party invoking the service
+type Caller struct {
+	UserID         int64    `json:"user_id"`
+	Roles          []string `json:"roles"`
+	ServiceAccount bool     `json:"service_account"`
+}
+
+func (c Caller) HasRole(role string) bool {
+	return slices.Contains(c.Roles, role)
+}
+
+// CanActFor reports whether the caller may perform actions on behalf of userID.
+// Users may only act for themselves, admin service accounts may act for anyone.
+func (c Caller) CanActFor(userID int64) bool {
+	if c.UserID == userID {
+		return true
+	}
+	return c.ServiceAccount && c.HasRole(RoleAdmin)
+}
+
+type callerContextKey struct{}
+
+func ContextWithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, caller)
+}
+
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerContextKey{}).(Caller)
+	return caller, ok
+}
@@ -0,0 +1,117 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// EventTypeFollowLimitViolated is published for trust & safety whenever a follow is rejected by an anti-spam rule
+const EventTypeFollowLimitViolated events.EventType = "follow_limit_violated"
+
+var ErrFollowLimitExceeded = errors.New("follow limit exceeded")
+
+type FollowActionType string
+
+const (
+	FollowActionFollow   FollowActionType = "follow"
+	FollowActionUnfollow FollowActionType = "unfollow"
+)
+
+type FollowAction struct {
+	ID         int64            `json:"id"`
+	FollowerID int64            `json:"follower_id"`
+	FolloweeID int64            `json:"followee_id"`
+	Action     FollowActionType `json:"action"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
+
+// FollowLimits are the anti-spam rules applied to Follow. A zero value disables the rule.
+type FollowLimits struct {
+	MaxFollowsPerHour int64
+	MaxFollowsPerDay  int64
+	MaxFollowees      int64
+	RefollowCooldown  time.Duration
+}
+
+func (l FollowLimits) Enabled() bool {
+	return l.MaxFollowsPerHour > 0 || l.MaxFollowsPerDay > 0 || l.MaxFollowees > 0 || l.RefollowCooldown > 0
+}
+
+// Retention is how long follow actions have to be kept to evaluate the limits
+func (l FollowLimits) Retention() time.Duration {
+	retention := 24 * time.Hour
+	if l.RefollowCooldown > retention {
+		retention = l.RefollowCooldown
+	}
+	return retention
+}
+
+// FollowVelocity is the recent activity of a follower used to evaluate FollowLimits
+type FollowVelocity struct {
+	FollowsLastHour int64
+	FollowsLastDay  int64
+	Followees       int64
+	LastUnfollowAt  *time.Time
+}
+
+type FollowLimitRule string
+
+const (
+	FollowLimitRuleHourly    FollowLimitRule = "hourly_follows"
+	FollowLimitRuleDaily     FollowLimitRule = "daily_follows"
+	FollowLimitRuleFollowees FollowLimitRule = "max_followees"
+	FollowLimitRuleCooldown  FollowLimitRule = "refollow_cooldown"
+)
+
+// FollowLimitViolation describes which rule rejected a follow. It matches ErrFollowLimitExceeded with errors.Is.
+type FollowLimitViolation struct {
+	Rule       FollowLimitRule
+	Limit      int64
+	Observed   int64
+	RetryAfter time.Duration
+}
+
+func (v *FollowLimitViolation) Error() string {
+	return fmt.Sprintf("%s: %s", ErrFollowLimitExceeded.Error(), v.Rule)
+}
+
+func (v *FollowLimitViolation) Unwrap() error {
+	return ErrFollowLimitExceeded
+}
+
+// Check returns the first rule the velocity violates, or nil when the follow is allowed
+func (l FollowLimits) Check(velocity FollowVelocity, now time.Time) *FollowLimitViolation {
+	if l.MaxFollowees > 0 && velocity.Followees >= l.MaxFollowees {
+		return &FollowLimitViolation{Rule: FollowLimitRuleFollowees, Limit: l.MaxFollowees, Observed: velocity.Followees}
+	}
+	if l.RefollowCooldown > 0 && velocity.LastUnfollowAt != nil {
+		if elapsed := now.Sub(*velocity.LastUnfollowAt); elapsed < l.RefollowCooldown {
+			return &FollowLimitViolation{
+				Rule:       FollowLimitRuleCooldown,
+				Limit:      int64(l.RefollowCooldown.Seconds()),
+				Observed:   int64(elapsed.Seconds()),
+				RetryAfter: l.RefollowCooldown - elapsed,
+			}
+		}
+	}
+	if l.MaxFollowsPerHour > 0 && velocity.FollowsLastHour >= l.MaxFollowsPerHour {
+		return &FollowLimitViolation{Rule: FollowLimitRuleHourly, Limit: l.MaxFollowsPerHour, Observed: velocity.FollowsLastHour, RetryAfter: time.Hour}
+	}
+	if l.MaxFollowsPerDay > 0 && velocity.FollowsLastDay >= l.MaxFollowsPerDay {
+		return &FollowLimitViolation{Rule: FollowLimitRuleDaily, Limit: l.MaxFollowsPerDay, Observed: velocity.FollowsLastDay, RetryAfter: 24 * time.Hour}
+	}
+	return nil
+}
+
+type FollowLimitViolatedPayload struct {
+	FollowerID        int64           `json:"follower_id"`
+	FolloweeID        int64           `json:"followee_id"`
+	Rule              FollowLimitRule `json:"rule"`
+	Limit             int64           `json:"limit"`
+	Observed          int64           `json:"observed"`
+	RetryAfterSeconds int64           `json:"retry_after_seconds"`
+	Timestamptz       time.Time       `json:"timestamptz"`
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+type FollowListSort string
+
+const (
+	FollowListSortNewest FollowListSort = "newest"
+	FollowListSortOldest FollowListSort = "oldest"
+)
+
+// FollowListOptions narrows and orders a followers or followees listing.
+// Zero values mean newest first, no time window, no filter and enriched users.
+type FollowListOptions struct {
+	Sort FollowListSort
+	// Since keeps follows created at or after it
+	Since *time.Time
+	// Until keeps follows created before it
+	Until *time.Time
+	// MutualOnly keeps the users that are followed in both directions
+	MutualOnly bool
+	// IDsOnly skips fetching user profiles
+	IDsOnly bool
+}
+
+// FollowList is a page of a followers or followees listing. Users is nil when
+// the listing was requested with IDsOnly, UserIDs is always filled.
+type FollowList struct {
+	UserIDs []int64
+	Users   []*User
+	Total   int64
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// FollowSource is the product surface a follow was made from
+type FollowSource string
+
+const (
+	FollowSourceSuggestion FollowSource = "suggestion"
+	FollowSourceSearch     FollowSource = "search"
+	FollowSourceProfile    FollowSource = "profile"
+	FollowSourceBoard      FollowSource = "board"
+	FollowSourceImport     FollowSource = "import"
+	// FollowSourceUnknown only appears in stats, for follows made without a source
+	FollowSourceUnknown FollowSource = "unknown"
+)
+
+// MaxFollowContextIDLength bounds the context id a client may attach to a follow
+const MaxFollowContextIDLength = 128
+
+// MaxFollowSourceStatsDays bounds the day range of a single stats request
+const MaxFollowSourceStatsDays = 366
+
+// FollowSources lists every source a client may send
+var FollowSources = []FollowSource{
+	FollowSourceSuggestion,
+	FollowSourceSearch,
+	FollowSourceProfile,
+	FollowSourceBoard,
+	FollowSourceImport,
+}
+
+// Valid reports whether a client may send the source
+func (s FollowSource) Valid() bool {
+	for _, source := range FollowSources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+// FollowAttribution tells where a follow came from. ContextID is an opaque id of the surface,
+// such as the board or the search session. Both fields are optional.
+type FollowAttribution struct {
+	Source    FollowSource
+	ContextID string
+}
+
+type followAttributionContextKey struct{}
+
+func ContextWithFollowAttribution(ctx context.Context, attribution FollowAttribution) context.Context {
+	return context.WithValue(ctx, followAttributionContextKey{}, attribution)
+}
+
+// FollowAttributionFromContext returns the attribution the client sent, or a zero value when there is none
+func FollowAttributionFromContext(ctx context.Context) FollowAttribution {
+	attribution, _ := ctx.Value(followAttributionContextKey{}).(FollowAttribution)
+	return attribution
+}
+
+// FollowCreatedPayload is the outbox payload of follow_created events. It extends
+// events.FollowCreatedPayload with the attribution, which is omitted when unset.
+type FollowCreatedPayload struct {
+	FollowerID  int64        `json:"follower_id"`
+	FolloweeID  int64        `json:"followee_id"`
+	Source      FollowSource `json:"source,omitempty"`
+	ContextID   string       `json:"context_id,omitempty"`
+	Timestamptz time.Time    `json:"timestamptz"`
+}
+
+// FollowSourceStat is the number of user follows made from Source on Day, counted in UTC.
+// Follows undone before their day was last aggregated are not counted.
+type FollowSourceStat struct {
+	Day     time.Time    `json:"day"`
+	Source  FollowSource `json:"source"`
+	Follows int64        `json:"follows"`
+}
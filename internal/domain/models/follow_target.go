@@ -0,0 +1,58 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// TargetType is the kind of entity a follow points at. User follows are the
+// social graph; board and tag follows only feed content subscriptions.
+type TargetType string
+
+const (
+	TargetTypeUser  TargetType = "user"
+	TargetTypeBoard TargetType = "board"
+	TargetTypeTag   TargetType = "tag"
+)
+
+var ErrBoardNotFound = errors.New("board not found")
+
+// ErrFollowTargetsDisabled is returned for board and tag follows while no post service client
+// is configured to check that the target exists
+var ErrFollowTargetsDisabled = errors.New("board and tag follows are not available")
+
+// FollowTarget identifies what a user follows
+type FollowTarget struct {
+	Type TargetType `json:"target_type"`
+	ID   int64      `json:"target_id"`
+}
+
+const (
+	EventTypeBoardFollowCreated events.EventType = "board_follow_created"
+	EventTypeBoardFollowDeleted events.EventType = "board_follow_deleted"
+	EventTypeTagFollowCreated   events.EventType = "tag_follow_created"
+	EventTypeTagFollowDeleted   events.EventType = "tag_follow_deleted"
+)
+
+// TargetFollowPayload is the outbox payload of board and tag follow events.
+// User follows publish FollowCreatedPayload.
+type TargetFollowPayload struct {
+	FollowerID  int64      `json:"follower_id"`
+	TargetType  TargetType `json:"target_type"`
+	TargetID    int64      `json:"target_id"`
+	Timestamptz time.Time  `json:"timestamptz"`
+}
+
+// TargetFollowEventTypes returns the created and deleted event types of a non-user target type
+func TargetFollowEventTypes(targetType TargetType) (created, deleted events.EventType) {
+	switch targetType {
+	case TargetTypeBoard:
+		return EventTypeBoardFollowCreated, EventTypeBoardFollowDeleted
+	case TargetTypeTag:
+		return EventTypeTagFollowCreated, EventTypeTagFollowDeleted
+	default:
+		return "", ""
+	}
+}
@@ -3,8 +3,20 @@ package model
 import "time"
 
 type Follower struct {
-	ID         int64     `json:"id"`
-	FollowerID int64     `json:"follower_id"`
-	FolloweeID int64     `json:"followee_id"`
-	CreatedAt  time.Time `json:"created_at"`
+	ID         int64 `json:"id"`
+	FollowerID int64 `json:"follower_id"`
+	FolloweeID int64 `json:"followee_id"`
+	// TargetType says what FolloweeID refers to
+	TargetType TargetType `json:"target_type"`
+	// Source and ContextID are the attribution of user follows, empty when the client sent none
+	Source    FollowSource `json:"source,omitempty"`
+	ContextID string       `json:"context_id,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// FollowDeletedPayload is the outbox payload of follow_deleted events
+type FollowDeletedPayload struct {
+	FollowerID  int64     `json:"follower_id"`
+	FolloweeID  int64     `json:"followee_id"`
+	Timestamptz time.Time `json:"timestamptz"`
 }
@@ -0,0 +1,67 @@
+package model
+
+import "time"
+
+// FollowerGrowthGranularity is the bucket size of a follower growth series
+type FollowerGrowthGranularity string
+
+const (
+	FollowerGrowthDaily FollowerGrowthGranularity = "day"
+	// FollowerGrowthWeekly buckets start on Monday
+	FollowerGrowthWeekly  FollowerGrowthGranularity = "week"
+	FollowerGrowthMonthly FollowerGrowthGranularity = "month"
+)
+
+// MaxFollowerGrowthDays bounds the day range of a single growth request
+const MaxFollowerGrowthDays = 731
+
+// Valid reports whether g is a known granularity
+func (g FollowerGrowthGranularity) Valid() bool {
+	switch g {
+	case FollowerGrowthDaily, FollowerGrowthWeekly, FollowerGrowthMonthly:
+		return true
+	}
+	return false
+}
+
+// BucketStart truncates t to the start of the UTC bucket it falls in
+func (g FollowerGrowthGranularity) BucketStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch g {
+	case FollowerGrowthWeekly:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case FollowerGrowthMonthly:
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	return day
+}
+
+// Next returns the start of the bucket after the one starting at start
+func (g FollowerGrowthGranularity) Next(start time.Time) time.Time {
+	switch g {
+	case FollowerGrowthWeekly:
+		return start.AddDate(0, 0, 7)
+	case FollowerGrowthMonthly:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// FollowerGrowthDay is the follows a user gained and lost on one UTC day. Days without
+// changes are not stored.
+type FollowerGrowthDay struct {
+	Day    time.Time `json:"day"`
+	Gained int64     `json:"gained"`
+	Lost   int64     `json:"lost"`
+}
+
+// FollowerGrowthBucket is one point of a follower growth series. Total is the follower
+// count at the end of the bucket; the first and last buckets only cover the requested days.
+type FollowerGrowthBucket struct {
+	Start  time.Time `json:"start"`
+	Gained int64     `json:"gained"`
+	Lost   int64     `json:"lost"`
+	Net    int64     `json:"net"`
+	Total  int64     `json:"total"`
+}
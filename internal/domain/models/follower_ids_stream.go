@@ -0,0 +1,8 @@
+package model
+
+const (
+	// DefaultFollowerIDChunkSize is the number of ids per StreamFollowerIDs message when the caller asks for none
+	DefaultFollowerIDChunkSize = 1000
+	// MaxFollowerIDChunkSize bounds a StreamFollowerIDs message to keep it well under the gRPC message limit
+	MaxFollowerIDChunkSize = 10000
+)
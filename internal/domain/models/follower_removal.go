@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// MaxRemoveFollowers bounds a single bulk follower removal
+const MaxRemoveFollowers = 100
+
+// EventTypeFollowerRemoved is published when a user removes one of their followers. It is
+// distinct from follow_deleted so the removed follower is not notified.
+const EventTypeFollowerRemoved events.EventType = "follower_removed"
+
+// FollowerRemovedPayload is the outbox payload of follower_removed events
+type FollowerRemovedPayload struct {
+	OwnerID     int64     `json:"owner_id"`
+	FollowerID  int64     `json:"follower_id"`
+	Timestamptz time.Time `json:"timestamptz"`
+}
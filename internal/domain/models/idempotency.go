@@ -0,0 +1,42 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotentOperation is the request an idempotency key was used for
+type IdempotentOperation string
+
+const (
+	IdempotentOperationFollow   IdempotentOperation = "follow"
+	IdempotentOperationUnfollow IdempotentOperation = "unfollow"
+)
+
+// IdempotencyRecord remembers that the request identified by Key completed successfully,
+// so a retry with the same key returns success instead of running again
+type IdempotencyRecord struct {
+	UserID    int64               `json:"user_id"`
+	Key       string              `json:"key"`
+	Operation IdempotentOperation `json:"operation"`
+	TargetID  int64               `json:"target_id"`
+	CreatedAt time.Time           `json:"created_at"`
+	ExpiresAt time.Time           `json:"expires_at"`
+}
+
+// Matches reports whether the record was stored for the same request
+func (r IdempotencyRecord) Matches(operation IdempotentOperation, targetID int64) bool {
+	return r.Operation == operation && r.TargetID == targetID
+}
+
+type idempotencyKeyContextKey struct{}
+
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the client supplied idempotency key, or an empty string when there is none
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key
+}
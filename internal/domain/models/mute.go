@@ -0,0 +1,41 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/events"
+)
+
+// MaxFilterMutedAuthors bounds the authors of a single FilterMuted call
+const MaxFilterMutedAuthors = 500
+
+var ErrMuteNotFound = errors.New("mute not found")
+
+const (
+	EventTypeUserMuted   events.EventType = "user_muted"
+	EventTypeUserUnmuted events.EventType = "user_unmuted"
+)
+
+// Mute hides the pins of FolloweeID from FollowerID without ending the follow
+type Mute struct {
+	FollowerID int64     `json:"follower_id"`
+	FolloweeID int64     `json:"followee_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	// ExpiresAt is when the mute ends by itself, nil for a mute that lasts until unmuted
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// Active reports whether the mute is still in effect at now
+func (m Mute) Active(now time.Time) bool {
+	return m.ExpiresAt == nil || m.ExpiresAt.After(now)
+}
+
+// MutePayload is the outbox payload of user_muted and user_unmuted events. Feeds can drop
+// an expired mute on their own, no event is published when a mute expires.
+type MutePayload struct {
+	FollowerID  int64      `json:"follower_id"`
+	FolloweeID  int64      `json:"followee_id"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	Timestamptz time.Time  `json:"timestamptz"`
+}
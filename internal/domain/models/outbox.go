@@ -23,4 +23,8 @@ type OutboxEvent struct {
 	Status      OutboxStatus     `json:"status"`
 	CreatedAt   time.Time        `json:"created_at"`
 	SentAt      *time.Time       `json:"sent_at"`
+	// RequestID is the correlation id of the request that created the event
+	RequestID string `json:"request_id,omitempty"`
+	// TraceContext carries the W3C trace context of the request that created the event
+	TraceContext map[string]string `json:"trace_context,omitempty"`
 }
@@ -0,0 +1,19 @@
+package model
+
+const (
+	// DefaultProfileSearchLimit is the number of matches returned when the caller asks for none
+	DefaultProfileSearchLimit = 20
+	// MaxProfileSearchLimit bounds the matches returned by one search
+	MaxProfileSearchLimit = 50
+	// MaxProfileSearchQueryLength bounds the search text, longer input is a paste rather than a name
+	MaxProfileSearchQueryLength = 64
+)
+
+// ProfileMatch is a user found by a followers or followees search, built from the
+// locally stored profile copy so no user service call is needed to render it
+type ProfileMatch struct {
+	User *User
+	// Score ranks an exact username over a username prefix over a full name prefix,
+	// with trigram similarity breaking ties and admitting typos
+	Score float64
+}
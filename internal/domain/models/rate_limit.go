@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// RateLimit describes a token bucket: Rate tokens are added per second up to Burst
+type RateLimit struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+type RateLimitDecision struct {
+	Allowed    bool          `json:"allowed"`
+	Remaining  int           `json:"remaining"`
+	RetryAfter time.Duration `json:"retry_after"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import "time"
+
+// RelationAction is what happened to a user follow relationship
+type RelationAction string
+
+const (
+	RelationActionFollow   RelationAction = "follow"
+	RelationActionUnfollow RelationAction = "unfollow"
+	RelationActionBlock    RelationAction = "block"
+	// RelationActionRemove is a follower removed by the user they follow
+	RelationActionRemove RelationAction = "remove"
+)
+
+// RelationReason is why a relationship changed
+type RelationReason string
+
+const (
+	// RelationReasonUser is a change made by one of the two users
+	RelationReasonUser RelationReason = "user"
+	// RelationReasonAdmin is a change made by an admin on behalf of a user
+	RelationReasonAdmin RelationReason = "admin"
+	// RelationReasonCascade is a change caused by another one, such as a deleted account
+	RelationReasonCascade RelationReason = "cascade"
+	// RelationReasonAntiSpam is a change made by the anti-spam rules
+	RelationReasonAntiSpam RelationReason = "anti_spam"
+)
+
+// RelationHistoryEntry is one append-only record of a change to a user follow relationship
+type RelationHistoryEntry struct {
+	ID         int64          `json:"id"`
+	FollowerID int64          `json:"follower_id"`
+	FolloweeID int64          `json:"followee_id"`
+	Action     RelationAction `json:"action"`
+	// ActorID is the user or service account that made the change, nil for system changes
+	ActorID   *int64         `json:"actor_id,omitempty"`
+	Reason    RelationReason `json:"reason"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// RelationHistoryFilter selects the history of one user, or of one pair of users in
+// both directions when OtherUserID is set
+type RelationHistoryFilter struct {
+	UserID      int64
+	OtherUserID *int64
+}
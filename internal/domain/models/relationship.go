@@ -0,0 +1,13 @@
+package model
+
+// MaxRelationshipTargets bounds a single relationship lookup
+const MaxRelationshipTargets = 1000
+
+// Relationship describes how a viewer and a target user are connected
+type Relationship struct {
+	TargetID int64 `json:"target_id"`
+	// Following is set when the viewer follows the target
+	Following bool `json:"following"`
+	// FollowedBy is set when the target follows the viewer
+	FollowedBy bool `json:"followed_by"`
+}
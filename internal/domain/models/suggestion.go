@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+const (
+	// MaxSuggestions is how many candidates are ranked, stored and cached per user
+	MaxSuggestions = 50
+	// SuggestionSeedLimit bounds how many of the user's most recent followees are expanded
+	SuggestionSeedLimit = 500
+	// SuggestionRecencyHalfLife is the age at which a second-degree follow counts half as much
+	SuggestionRecencyHalfLife = 30 * 24 * time.Hour
+)
+
+// Suggestion is a ranked who-to-follow candidate
+type Suggestion struct {
+	UserID int64 `json:"user_id"`
+	// MutualCount is the number of the user's followees that follow the candidate
+	MutualCount int64 `json:"mutual_count"`
+	// Score sums a recency weight in (0, 1] over those followees
+	Score float64 `json:"score"`
+}
+
+// SuggestedUser is a suggestion enriched with the candidate's profile
+type SuggestedUser struct {
+	User        *User
+	MutualCount int64
+	Score       float64
+}
@@ -3,12 +3,60 @@ package service
 import (
 	"context"
 	"pinstack-relation-service/internal/domain/models"
+	"time"
 )
 
 //go:generate mockery --name=FollowService --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
 type FollowService interface {
 	Follow(ctx context.Context, followerID, followeeID int64) error
 	Unfollow(ctx context.Context, followerID, followeeID int64) error
+	// RemoveFollower ends the follow of followerID on ownerID at the request of ownerID
+	RemoveFollower(ctx context.Context, ownerID, followerID int64) error
+	// RemoveFollowers removes many followers of ownerID at once and returns the ids that were removed
+	RemoveFollowers(ctx context.Context, ownerID int64, followerIDs []int64) ([]int64, error)
+	// BulkFollow follows many users at once and reports the outcome for each of them
+	BulkFollow(ctx context.Context, followerID int64, followeeIDs []int64) ([]model.BulkResult, error)
+	// BulkUnfollow unfollows many users at once and reports the outcome for each of them
+	BulkUnfollow(ctx context.Context, followerID int64, followeeIDs []int64) ([]model.BulkResult, error)
 	GetFollowers(ctx context.Context, followeeID int64, limit, page int32) ([]*model.User, int64, error)
 	GetFollowees(ctx context.Context, followerID int64, limit, page int32) ([]*model.User, int64, error)
+	ListFollowers(ctx context.Context, followeeID int64, opts model.FollowListOptions, limit, page int32) (*model.FollowList, error)
+	ListFollowees(ctx context.Context, followerID int64, opts model.FollowListOptions, limit, page int32) (*model.FollowList, error)
+	SearchFollowers(ctx context.Context, userID int64, query string, limit int32) ([]*model.ProfileMatch, error)
+	SearchFollowees(ctx context.Context, userID int64, query string, limit int32) ([]*model.ProfileMatch, error)
+	GetMutualFollows(ctx context.Context, userID int64, limit, page int32) ([]*model.User, int64, error)
+	IsMutual(ctx context.Context, userID, otherUserID int64) (bool, error)
+	GetFollowersYouKnow(ctx context.Context, viewerID, targetID int64, limit int32) ([]*model.User, int64, error)
+	GetRelationships(ctx context.Context, viewerID int64, targetIDs []int64) ([]model.Relationship, error)
+	GetSuggestions(ctx context.Context, userID int64, limit int32) ([]*model.SuggestedUser, error)
+	FollowTarget(ctx context.Context, followerID int64, target model.FollowTarget) error
+	UnfollowTarget(ctx context.Context, followerID int64, target model.FollowTarget) error
+	ListFollowedTargets(ctx context.Context, followerID int64, targetType model.TargetType, limit, page int32) ([]int64, int64, error)
+	// GetRelationHistory pages through the relationship changes involving userID, or only those
+	// between userID and otherUserID when it is set
+	GetRelationHistory(ctx context.Context, userID int64, otherUserID *int64, limit, page int32) ([]model.RelationHistoryEntry, int64, error)
+	// StreamFollowerIDs passes every follower id of userID after cursor to send, chunk by chunk,
+	// together with the cursor to resume from once that chunk is delivered
+	StreamFollowerIDs(ctx context.Context, userID, cursor int64, chunkSize int32, send func(followerIDs []int64, nextCursor int64) error) error
+	// Mute hides the pins of followeeID from followerID until expiresAt, or for good when it is nil
+	Mute(ctx context.Context, followerID, followeeID int64, expiresAt *time.Time) (model.Mute, error)
+	Unmute(ctx context.Context, followerID, followeeID int64) error
+	ListMuted(ctx context.Context, followerID int64, limit, page int32) ([]model.Mute, int64, error)
+	// FilterMuted returns the authors of authorIDs that viewerID has muted
+	FilterMuted(ctx context.Context, viewerID int64, authorIDs []int64) ([]int64, error)
+	CreateAudienceList(ctx context.Context, ownerID int64, name string) (model.AudienceList, error)
+	RenameAudienceList(ctx context.Context, ownerID, listID int64, name string) (model.AudienceList, error)
+	DeleteAudienceList(ctx context.Context, ownerID, listID int64) error
+	ListAudienceLists(ctx context.Context, ownerID int64) ([]model.AudienceList, error)
+	// AddAudienceMembers returns the members that were not on the list yet
+	AddAudienceMembers(ctx context.Context, ownerID, listID int64, memberIDs []int64) ([]int64, error)
+	// RemoveAudienceMembers returns the members that were on the list
+	RemoveAudienceMembers(ctx context.Context, ownerID, listID int64, memberIDs []int64) ([]int64, error)
+	ListAudienceMembers(ctx context.Context, ownerID, listID int64, limit, page int32) ([]int64, int64, error)
+	// IsAudienceMember reports whether userID is on a list of ownerID
+	IsAudienceMember(ctx context.Context, ownerID, listID, userID int64) (bool, error)
+	// GetFollowSourceStats returns the daily follows per source for the UTC days from through to
+	GetFollowSourceStats(ctx context.Context, from, to time.Time) ([]model.FollowSourceStat, error)
+	// GetFollowerGrowth returns the follower growth of userID over the UTC days from through to in buckets of granularity
+	GetFollowerGrowth(ctx context.Context, userID int64, from, to time.Time, granularity model.FollowerGrowthGranularity) ([]model.FollowerGrowthBucket, error)
 }
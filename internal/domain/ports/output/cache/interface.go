@@ -0,0 +1,14 @@
+package cache
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+)
+
+//go:generate mockery --name=SuggestionCache --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type SuggestionCache interface {
+	Get(ctx context.Context, userID int64) ([]model.Suggestion, bool)
+	Set(ctx context.Context, userID int64, suggestions []model.Suggestion)
+	// Invalidate drops the cached suggestions of userID after their followees change
+	Invalidate(ctx context.Context, userID int64)
+}
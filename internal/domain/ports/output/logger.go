@@ -1,5 +1,7 @@
 package output
 
+import "context"
+
 // Logger is a minimal abstraction used across application layer and adapters
 type Logger interface {
 	Debug(msg string, args ...any)
@@ -8,3 +10,18 @@ type Logger interface {
 	Error(msg string, args ...any)
 	With(args ...any) Logger
 }
+
+type loggerContextKey struct{}
+
+// ContextWithLogger stores a request scoped logger, e.g. one carrying the request id
+func ContextWithLogger(ctx context.Context, log Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, log)
+}
+
+// LoggerFromContext returns the request scoped logger, or fallback when there is none
+func LoggerFromContext(ctx context.Context, fallback Logger) Logger {
+	if log, ok := ctx.Value(loggerContextKey{}).(Logger); ok {
+		return log
+	}
+	return fallback
+}
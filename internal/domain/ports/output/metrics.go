@@ -16,6 +16,8 @@ type MetricsProvider interface {
 
 	IncrementOutboxOperations(operation string, success bool)
 
+	IncrementRateLimitedRequests(method, keyType string)
+
 	SetActiveConnections(count int)
 	SetServiceHealth(healthy bool)
 }
@@ -0,0 +1,13 @@
+package post_client
+
+import (
+	"context"
+)
+
+//go:generate mockery --name Client --dir . --output ../../../mocks --outpkg mocks --with-expecter --structname PostClient --filename PostClient.go
+type Client interface {
+	// BoardExists reports whether the board is known to the post service
+	BoardExists(ctx context.Context, boardID int64) (bool, error)
+	// TagExists reports whether the tag is known to the post service
+	TagExists(ctx context.Context, tagID int64) (bool, error)
+}
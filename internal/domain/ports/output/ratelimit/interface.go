@@ -0,0 +1,12 @@
+package ratelimit
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+)
+
+//go:generate mockery --name=Limiter --output=../../../mocks --outpkg=mocks --case=underscore --with-expecter
+type Limiter interface {
+	// Allow takes a single token from the bucket identified by key
+	Allow(ctx context.Context, key string, limit model.RateLimit) (model.RateLimitDecision, error)
+}
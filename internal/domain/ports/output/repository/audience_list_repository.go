@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+)
+
+//go:generate mockery --name=AudienceListRepository --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
+type AudienceListRepository interface {
+	// Create adds a list named name for ownerID, or returns ErrAudienceListExists
+	Create(ctx context.Context, ownerID int64, name string) (model.AudienceList, error)
+	// Rename renames a list of ownerID, or returns ErrAudienceListNotFound
+	Rename(ctx context.Context, ownerID, listID int64, name string) (model.AudienceList, error)
+	// Delete removes a list of ownerID together with its members, or returns ErrAudienceListNotFound
+	Delete(ctx context.Context, ownerID, listID int64) error
+	// Get returns a list of ownerID with its member count, or ErrAudienceListNotFound
+	Get(ctx context.Context, ownerID, listID int64) (model.AudienceList, error)
+	// GetForUpdate is Get that also locks the list until the transaction ends
+	GetForUpdate(ctx context.Context, ownerID, listID int64) (model.AudienceList, error)
+	// ListByOwner returns every list of ownerID with its member count, by name
+	ListByOwner(ctx context.Context, ownerID int64) ([]model.AudienceList, error)
+	CountByOwner(ctx context.Context, ownerID int64) (int64, error)
+	// AddMembers adds memberIDs to the list and returns the ids that were not members yet
+	AddMembers(ctx context.Context, listID int64, memberIDs []int64) ([]int64, error)
+	// RemoveMembers removes memberIDs from the list and returns the ids that were members
+	RemoveMembers(ctx context.Context, listID int64, memberIDs []int64) ([]int64, error)
+	// RemoveMemberFromOwnerLists removes memberID from every list of ownerID and returns the ids of
+	// the lists it was on
+	RemoveMemberFromOwnerLists(ctx context.Context, ownerID, memberID int64) ([]int64, error)
+	// ListMembers returns the member ids of the list, most recently added first
+	ListMembers(ctx context.Context, listID int64, limit, offset int32) ([]int64, int64, error)
+	IsMember(ctx context.Context, listID, userID int64) (bool, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+	"time"
+)
+
+//go:generate mockery --name=FollowActionRepository --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
+type FollowActionRepository interface {
+	Record(ctx context.Context, followerID, followeeID int64, action model.FollowActionType) error
+	GetVelocity(ctx context.Context, followerID, followeeID int64, now time.Time) (model.FollowVelocity, error)
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
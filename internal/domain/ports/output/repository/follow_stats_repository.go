@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+	"time"
+)
+
+//go:generate mockery --name=FollowStatsRepository --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
+type FollowStatsRepository interface {
+	// AggregateDay recounts the user follows of every source made on the UTC day of day
+	AggregateDay(ctx context.Context, day time.Time) error
+	// ListDaily returns the stats of the days from through to, inclusive, by day and source
+	ListDaily(ctx context.Context, from, to time.Time) ([]model.FollowSourceStat, error)
+}
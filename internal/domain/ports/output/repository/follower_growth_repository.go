@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+	"time"
+)
+
+//go:generate mockery --name=FollowerGrowthRepository --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
+type FollowerGrowthRepository interface {
+	// AggregateDay recounts the followers every user gained and lost on the UTC day of day
+	AggregateDay(ctx context.Context, day time.Time) error
+	// ListDaily returns the days from through to, inclusive, on which userID gained or lost followers
+	ListDaily(ctx context.Context, userID int64, from, to time.Time) ([]model.FollowerGrowthDay, error)
+	// TotalAt returns the follower count of userID at the end of the UTC day of day
+	TotalAt(ctx context.Context, userID int64, day time.Time) (int64, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+	"time"
+)
+
+//go:generate mockery --name=IdempotencyRepository --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
+type IdempotencyRepository interface {
+	// Get returns the unexpired record of key for userID, found is false when there is none
+	Get(ctx context.Context, userID int64, key string) (record model.IdempotencyRecord, found bool, err error)
+	// Claim stores record unless an unexpired record with the same key exists, claimed is false then.
+	// A concurrent claim of the same key waits until the transaction holding it finishes.
+	Claim(ctx context.Context, record model.IdempotencyRecord) (claimed bool, err error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
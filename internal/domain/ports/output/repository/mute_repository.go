@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+	"time"
+)
+
+//go:generate mockery --name=MuteRepository --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
+type MuteRepository interface {
+	// Upsert mutes the pair or replaces the expiry of an existing mute
+	Upsert(ctx context.Context, mute model.Mute) (model.Mute, error)
+	// Delete removes an active mute and returns it, or ErrMuteNotFound
+	Delete(ctx context.Context, followerID, followeeID int64) (model.Mute, error)
+	// List returns the active mutes of followerID, newest first
+	List(ctx context.Context, followerID int64, limit, offset int32) ([]model.Mute, int64, error)
+	// FilterMuted returns the ids of authorIDs that followerID has an active mute on
+	FilterMuted(ctx context.Context, followerID int64, authorIDs []int64) ([]int64, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+	"time"
+)
+
+// ProfileRepository keeps a local copy of the user profile fields needed to search
+// followers and followees by name without a user service call per follower.
+//
+//go:generate mockery --name=ProfileRepository --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
+type ProfileRepository interface {
+	// Upsert stores the latest known username, full name and avatar of each user
+	Upsert(ctx context.Context, users []*model.User) error
+	// SearchFollowers matches query against the stored profiles of userID's followers, best match first
+	SearchFollowers(ctx context.Context, userID int64, query string, limit int32) ([]model.ProfileMatch, error)
+	// SearchFollowees matches query against the stored profiles of the users userID follows, best match first
+	SearchFollowees(ctx context.Context, userID int64, query string, limit int32) ([]model.ProfileMatch, error)
+	// ListUnsynced pages through users of the follow graph, ordered by id, whose profile
+	// is missing or was last synced before staleBefore
+	ListUnsynced(ctx context.Context, staleBefore time.Time, afterID int64, limit int32) ([]int64, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+)
+
+//go:generate mockery --name=RelationHistoryRepository --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
+type RelationHistoryRepository interface {
+	Record(ctx context.Context, entry model.RelationHistoryEntry) error
+	// List returns the entries matching filter, newest first, and their total count
+	List(ctx context.Context, filter model.RelationHistoryFilter, limit, offset int32) ([]model.RelationHistoryEntry, int64, error)
+}
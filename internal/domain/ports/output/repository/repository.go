@@ -7,9 +7,38 @@ import (
 
 //go:generate mockery --name=FollowRepository --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
 type FollowRepository interface {
-	Create(ctx context.Context, followerID, followeeID int64) (model.Follower, error)
-	Delete(ctx context.Context, followerID, followeeID int64) error
+	// Create follows followeeID unless the follow exists, created tells which of the two happened
+	Create(ctx context.Context, followerID, followeeID int64, attribution model.FollowAttribution) (follower model.Follower, created bool, err error)
+	// Delete unfollows followeeID and returns the removed follow, or ErrFollowRelationNotFound
+	Delete(ctx context.Context, followerID, followeeID int64) (model.Follower, error)
+	// CreateMany follows every user of followeeIDs in one statement and returns only the follows it created
+	CreateMany(ctx context.Context, followerID int64, followeeIDs []int64, attribution model.FollowAttribution) ([]model.Follower, error)
+	// DeleteMany unfollows every user of followeeIDs in one statement and returns the follows it removed
+	DeleteMany(ctx context.Context, followerID int64, followeeIDs []int64) ([]model.Follower, error)
 	Exists(ctx context.Context, followerID, followeeID int64) (bool, error)
 	GetFollowers(ctx context.Context, followeeID int64, limit, offset int32) ([]int64, int64, error)
 	GetFollowees(ctx context.Context, followerID int64, limit, offset int32) ([]int64, int64, error)
+	// ListFollowers is GetFollowers with the ordering, time window and mutual filter of opts
+	ListFollowers(ctx context.Context, followeeID int64, opts model.FollowListOptions, limit, offset int32) ([]int64, int64, error)
+	// ListFollowees is GetFollowees with the ordering, time window and mutual filter of opts
+	ListFollowees(ctx context.Context, followerID int64, opts model.FollowListOptions, limit, offset int32) ([]int64, int64, error)
+	// GetMutualFollows returns the users that userID follows and that follow userID back
+	GetMutualFollows(ctx context.Context, userID int64, limit, offset int32) ([]int64, int64, error)
+	IsMutual(ctx context.Context, userID, otherUserID int64) (bool, error)
+	// GetFollowersYouKnow returns up to limit followers of targetID that viewerID follows and the size of that intersection
+	GetFollowersYouKnow(ctx context.Context, viewerID, targetID int64, limit int32) ([]int64, int64, error)
+	// GetRelationships returns the relationships of viewerID with the targets that have one, keyed by target id
+	GetRelationships(ctx context.Context, viewerID int64, targetIDs []int64) (map[int64]model.Relationship, error)
+	// CreateTarget follows a target of any type, failing with ErrFollowRelationExists when already followed
+	CreateTarget(ctx context.Context, followerID int64, target model.FollowTarget) (model.Follower, error)
+	DeleteTarget(ctx context.Context, followerID int64, target model.FollowTarget) error
+	// GetFollowedTargets lists the ids of the targets of one type that followerID follows, newest first
+	GetFollowedTargets(ctx context.Context, followerID int64, targetType model.TargetType, limit, offset int32) ([]int64, int64, error)
+	// GetFollowerIDsAfter returns up to limit follower ids of followeeID greater than afterID, in ascending order
+	GetFollowerIDsAfter(ctx context.Context, followeeID, afterID int64, limit int32) ([]int64, error)
+	// DeleteFollowers removes the given followers of followeeID and returns the ids that were following
+	DeleteFollowers(ctx context.Context, followeeID int64, followerIDs []int64) ([]int64, error)
+	// LockFollowers returns the users of followerIDs that follow followeeID and locks those follows
+	// until the transaction ends, so they can not be removed before it commits
+	LockFollowers(ctx context.Context, followeeID int64, followerIDs []int64) ([]int64, error)
 }
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"pinstack-relation-service/internal/domain/models"
+	"time"
+)
+
+//go:generate mockery --name=SuggestionRepository --output=../../mocks --outpkg=mocks --case=underscore --with-expecter
+type SuggestionRepository interface {
+	// Rank computes the best candidates for userID from the follow graph
+	Rank(ctx context.Context, userID int64, limit int32) ([]model.Suggestion, error)
+	// GetStored returns precomputed candidates, skipping users userID already follows
+	GetStored(ctx context.Context, userID int64, limit int32) ([]model.Suggestion, error)
+	// Store replaces the precomputed candidates of userID
+	Store(ctx context.Context, userID int64, suggestions []model.Suggestion) error
+	// ListActiveUsers pages through users that followed someone since the given time, ordered by id
+	ListActiveUsers(ctx context.Context, since time.Time, afterID int64, limit int32) ([]int64, error)
+}
@@ -15,6 +15,11 @@ type UnitOfWork interface {
 type Transaction interface {
 	OutboxRepository() outbox.OutboxRepository
 	FollowRepository() repository.FollowRepository
+	FollowActionRepository() repository.FollowActionRepository
+	RelationHistoryRepository() repository.RelationHistoryRepository
+	IdempotencyRepository() repository.IdempotencyRepository
+	MuteRepository() repository.MuteRepository
+	AudienceListRepository() repository.AudienceListRepository
 	Commit(ctx context.Context) error
 	Rollback(ctx context.Context) error
 }
@@ -10,4 +10,6 @@ type Client interface {
 	GetUser(ctx context.Context, id int64) (*model.User, error)
 	GetUserByUsername(ctx context.Context, username string) (*model.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	// GetUsers looks up many users in one call, users that do not exist are missing from the result
+	GetUsers(ctx context.Context, ids []int64) (map[int64]*model.User, error)
 }
@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/infrastructure/config"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationHeader  = "authorization"
+	gatewayUserIDHeader  = "x-user-id"
+	gatewayRolesHeader   = "x-user-roles"
+	gatewayServiceHeader = "x-service-account"
+	gatewaySecretHeader  = "x-gateway-secret"
+	bearerPrefix         = "bearer "
+)
+
+// Claims is the set of JWT claims the relation service understands
+type Claims struct {
+	UserID         int64    `json:"user_id,omitempty"`
+	Roles          []string `json:"roles,omitempty"`
+	ServiceAccount bool     `json:"service_account,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Authenticator resolves the caller identity from incoming gRPC metadata.
+// A bearer JWT takes precedence, trusted gateway metadata is used as a fallback when enabled.
+type Authenticator struct {
+	hmacSecret    []byte
+	rsaKey        *rsa.PublicKey
+	jwksKeys      map[string]*rsa.PublicKey
+	parser        *jwt.Parser
+	trustGateway  bool
+	gatewaySecret string
+	log           ports.Logger
+}
+
+func NewAuthenticator(cfg config.Auth, log ports.Logger) (*Authenticator, error) {
+	a := &Authenticator{
+		trustGateway:  cfg.TrustGatewayMetadata,
+		gatewaySecret: cfg.GatewaySecret,
+		log:           log,
+	}
+
+	var methods []string
+	if cfg.HS256Secret != "" {
+		a.hmacSecret = []byte(cfg.HS256Secret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if cfg.RS256PublicKeyPath != "" {
+		key, err := loadRSAPublicKey(cfg.RS256PublicKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		a.rsaKey = key
+	}
+	if cfg.JWKSPath != "" {
+		keys, err := loadJWKS(cfg.JWKSPath)
+		if err != nil {
+			return nil, err
+		}
+		a.jwksKeys = keys
+	}
+	if a.rsaKey != nil || a.jwksKeys != nil {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	if a.trustGateway && a.gatewaySecret == "" {
+		// Without the secret any client could claim to be the gateway and send an admin identity
+		return nil, fmt.Errorf("trusted gateway metadata requires a gateway secret: %w", custom_errors.ErrConfigInvalid)
+	}
+	if len(methods) == 0 && !a.trustGateway {
+		return nil, fmt.Errorf("auth is enabled but no verification keys or trusted gateway are configured: %w", custom_errors.ErrConfigInvalid)
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	a.parser = jwt.NewParser(opts...)
+
+	return a, nil
+}
+
+func (a *Authenticator) Authenticate(ctx context.Context) (model.Caller, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return model.Caller{}, custom_errors.ErrUnauthenticated
+	}
+
+	if values := md.Get(authorizationHeader); len(values) > 0 {
+		return a.authenticateToken(values[0])
+	}
+
+	if a.trustGateway {
+		return a.authenticateGateway(md)
+	}
+
+	return model.Caller{}, custom_errors.ErrUnauthenticated
+}
+
+func (a *Authenticator) authenticateToken(header string) (model.Caller, error) {
+	if len(header) < len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return model.Caller{}, custom_errors.ErrInvalidToken
+	}
+	raw := strings.TrimSpace(header[len(bearerPrefix):])
+
+	claims := &Claims{}
+	_, err := a.parser.ParseWithClaims(raw, claims, a.keyFunc)
+	if err != nil {
+		a.log.Debug("Token validation failed", slog.String("error", err.Error()))
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return model.Caller{}, custom_errors.ErrTokenExpired
+		}
+		return model.Caller{}, custom_errors.ErrInvalidToken
+	}
+
+	userID := claims.UserID
+	if userID == 0 {
+		userID, err = strconv.ParseInt(claims.Subject, 10, 64)
+		if err != nil || userID <= 0 {
+			return model.Caller{}, custom_errors.ErrInvalidToken
+		}
+	}
+
+	return model.Caller{
+		UserID:         userID,
+		Roles:          claims.Roles,
+		ServiceAccount: claims.ServiceAccount,
+	}, nil
+}
+
+func (a *Authenticator) keyFunc(token *jwt.Token) (any, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		if a.hmacSecret == nil {
+			return nil, custom_errors.ErrInvalidToken
+		}
+		return a.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		if kid, ok := token.Header["kid"].(string); ok && a.jwksKeys != nil {
+			if key, found := a.jwksKeys[kid]; found {
+				return key, nil
+			}
+		}
+		if a.rsaKey != nil {
+			return a.rsaKey, nil
+		}
+		return nil, custom_errors.ErrInvalidToken
+	default:
+		return nil, custom_errors.ErrInvalidToken
+	}
+}
+
+func (a *Authenticator) authenticateGateway(md metadata.MD) (model.Caller, error) {
+	secrets := md.Get(gatewaySecretHeader)
+	if a.gatewaySecret == "" || len(secrets) == 0 || subtle.ConstantTimeCompare([]byte(secrets[0]), []byte(a.gatewaySecret)) != 1 {
+		return model.Caller{}, custom_errors.ErrUnauthenticated
+	}
+
+	ids := md.Get(gatewayUserIDHeader)
+	if len(ids) == 0 {
+		return model.Caller{}, custom_errors.ErrUnauthenticated
+	}
+	userID, err := strconv.ParseInt(ids[0], 10, 64)
+	if err != nil || userID <= 0 {
+		return model.Caller{}, custom_errors.ErrUnauthenticated
+	}
+
+	var roles []string
+	for _, value := range md.Get(gatewayRolesHeader) {
+		for _, role := range strings.Split(value, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	var serviceAccount bool
+	if values := md.Get(gatewayServiceHeader); len(values) > 0 {
+		serviceAccount, _ = strconv.ParseBool(values[0])
+	}
+
+	return model.Caller{
+		UserID:         userID,
+		Roles:          roles,
+		ServiceAccount: serviceAccount,
+	}, nil
+}
@@ -0,0 +1,198 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/auth"
+	"pinstack-relation-service/internal/infrastructure/config"
+	"pinstack-relation-service/internal/infrastructure/logger"
+)
+
+const testSecret = "test-secret"
+
+func signHS256(t *testing.T, claims auth.Claims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	require.NoError(t, err)
+	return token
+}
+
+func bearerContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthenticator_HS256(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(config.Auth{HS256Secret: testSecret, Issuer: "pinstack-auth"}, logger.New("test"))
+	require.NoError(t, err)
+
+	validClaims := auth.Claims{
+		UserID: 42,
+		Roles:  []string{"user"},
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "pinstack-auth",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		expected    model.Caller
+		expectedErr error
+	}{
+		{
+			name:     "valid token",
+			ctx:      bearerContext(signHS256(t, validClaims)),
+			expected: model.Caller{UserID: 42, Roles: []string{"user"}},
+		},
+		{
+			name: "user id taken from subject",
+			ctx: bearerContext(signHS256(t, auth.Claims{RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   "7",
+				Issuer:    "pinstack-auth",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			}})),
+			expected: model.Caller{UserID: 7},
+		},
+		{
+			name: "expired token",
+			ctx: bearerContext(signHS256(t, auth.Claims{UserID: 42, RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "pinstack-auth",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			}})),
+			expectedErr: custom_errors.ErrTokenExpired,
+		},
+		{
+			name: "wrong issuer",
+			ctx: bearerContext(signHS256(t, auth.Claims{UserID: 42, RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    "someone-else",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			}})),
+			expectedErr: custom_errors.ErrInvalidToken,
+		},
+		{
+			name:        "malformed token",
+			ctx:         bearerContext("not-a-jwt"),
+			expectedErr: custom_errors.ErrInvalidToken,
+		},
+		{
+			name:        "missing metadata",
+			ctx:         context.Background(),
+			expectedErr: custom_errors.ErrUnauthenticated,
+		},
+		{
+			name:        "gateway metadata is not trusted",
+			ctx:         metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-user-id", "42")),
+			expectedErr: custom_errors.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller, err := authenticator.Authenticate(tt.ctx)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, caller)
+		})
+	}
+}
+
+func TestAuthenticator_RS256WithJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwksPath := filepath.Join(t.TempDir(), "jwks.json")
+	document, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(jwksPath, document, 0o600))
+
+	authenticator, err := auth.NewAuthenticator(config.Auth{JWKSPath: jwksPath}, logger.New("test"))
+	require.NoError(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, auth.Claims{
+		UserID:         1,
+		Roles:          []string{model.RoleAdmin},
+		ServiceAccount: true,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+	token.Header["kid"] = "key-1"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	caller, err := authenticator.Authenticate(bearerContext(signed))
+	require.NoError(t, err)
+	assert.True(t, caller.CanActFor(99))
+
+	hsToken := signHS256(t, auth.Claims{UserID: 1, RegisteredClaims: jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}})
+	_, err = authenticator.Authenticate(bearerContext(hsToken))
+	assert.ErrorIs(t, err, custom_errors.ErrInvalidToken)
+}
+
+func TestAuthenticator_GatewayMetadata(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(config.Auth{TrustGatewayMetadata: true, GatewaySecret: "s3cret"}, logger.New("test"))
+	require.NoError(t, err)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", "5",
+		"x-user-roles", "admin, moderator",
+		"x-service-account", "true",
+		"x-gateway-secret", "s3cret",
+	))
+	caller, err := authenticator.Authenticate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, model.Caller{UserID: 5, Roles: []string{"admin", "moderator"}, ServiceAccount: true}, caller)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", "5",
+		"x-gateway-secret", "wrong",
+	))
+	_, err = authenticator.Authenticate(ctx)
+	assert.ErrorIs(t, err, custom_errors.ErrUnauthenticated)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"x-user-id", "5",
+		"x-service-account", "true",
+	))
+	_, err = authenticator.Authenticate(ctx)
+	assert.ErrorIs(t, err, custom_errors.ErrUnauthenticated)
+}
+
+func TestNewAuthenticator_RequiresKeys(t *testing.T) {
+	_, err := auth.NewAuthenticator(config.Auth{Enabled: true}, logger.New("test"))
+	assert.ErrorIs(t, err, custom_errors.ErrConfigInvalid)
+}
+
+func TestNewAuthenticator_GatewayRequiresSecret(t *testing.T) {
+	_, err := auth.NewAuthenticator(config.Auth{Enabled: true, HS256Secret: "key", TrustGatewayMetadata: true}, logger.New("test"))
+	assert.ErrorIs(t, err, custom_errors.ErrConfigInvalid)
+}
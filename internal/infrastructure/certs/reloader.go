@@ -0,0 +1,254 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	ports "pinstack-relation-service/internal/domain/ports/output"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/grpc/credentials"
+)
+
+// reloadDebounce groups the burst of events produced by a single certificate rotation
+const reloadDebounce = 200 * time.Millisecond
+
+// Reloader keeps a certificate, its private key and a CA bundle in memory and
+// re-reads them whenever the files change on disk.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	log      ports.Logger
+
+	mu     sync.RWMutex
+	cert   *tls.Certificate
+	caPool *x509.CertPool
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewReloader loads the given files and starts watching them for changes.
+// certFile and keyFile must be set together, caFile is optional.
+func NewReloader(certFile, keyFile, caFile string, log ports.Logger) (*Reloader, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("certificate and key files must be configured together")
+	}
+
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		log:      log,
+		done:     make(chan struct{}),
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("create file watcher: %w", err)
+	}
+	dirs := make(map[string]struct{})
+	for _, file := range []string{certFile, keyFile, caFile} {
+		if file != "" {
+			dirs[filepath.Dir(file)] = struct{}{}
+		}
+	}
+	for dir := range dirs {
+		// Directories are watched instead of files so that atomic renames and
+		// symlink swaps (as done for Kubernetes secrets) are picked up too.
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return nil, fmt.Errorf("watch %s: %w", dir, err)
+		}
+	}
+	r.watcher = watcher
+
+	r.wg.Add(1)
+	go r.watch()
+
+	return r, nil
+}
+
+// Reload re-reads all files. On failure the previously loaded material is kept.
+func (r *Reloader) Reload() error {
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		pair, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return fmt.Errorf("load key pair: %w", err)
+		}
+		cert = &pair
+	}
+
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		data, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("read ca file: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no certificates found in %s", r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert = cert
+	r.caPool = pool
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (r *Reloader) Close() error {
+	close(r.done)
+	err := r.watcher.Close()
+	r.wg.Wait()
+	return err
+}
+
+func (r *Reloader) watch() {
+	defer r.wg.Done()
+
+	var timer *time.Timer
+	var timerC <-chan time.Time
+	for {
+		select {
+		case <-r.done:
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case event, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op == fsnotify.Chmod || !r.isWatched(event.Name) {
+				continue
+			}
+			if timer == nil {
+				timer = time.NewTimer(reloadDebounce)
+			} else {
+				timer.Reset(reloadDebounce)
+			}
+			timerC = timer.C
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+			r.log.Error("Certificate watcher error", slog.String("error", err.Error()))
+		case <-timerC:
+			timerC = nil
+			if err := r.Reload(); err != nil {
+				r.log.Error("Failed to reload certificates, keeping previous ones", slog.String("error", err.Error()))
+				continue
+			}
+			r.log.Info("Certificates reloaded", slog.String("cert_file", r.certFile), slog.String("ca_file", r.caFile))
+		}
+	}
+}
+
+// isWatched reports whether an event in a watched directory concerns our files.
+// Kubernetes rotates secrets by swapping a "..data" symlink, so those events count as well.
+func (r *Reloader) isWatched(name string) bool {
+	base := filepath.Base(name)
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		if file != "" && (filepath.Clean(name) == filepath.Clean(file) || base == filepath.Base(file)) {
+			return true
+		}
+	}
+	return base == "..data"
+}
+
+func (r *Reloader) snapshot() (*tls.Certificate, *x509.CertPool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, r.caPool
+}
+
+// ServerConfig returns a TLS configuration for an inbound listener. When
+// requireClientCert is set, clients must present a certificate signed by the CA bundle.
+func (r *Reloader) ServerConfig(requireClientCert bool) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := r.snapshot()
+			if cert == nil {
+				return nil, errors.New("server certificate is not configured")
+			}
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientCAs:    pool,
+				ClientAuth:   tls.NoClientCert,
+			}
+			if pool != nil {
+				cfg.ClientAuth = tls.VerifyClientCertIfGiven
+			}
+			if requireClientCert {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// ClientConfig returns a TLS configuration for an outbound connection. The peer
+// is verified against the current CA bundle (or the system roots when none is set).
+func (r *Reloader) ClientConfig(serverName string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.snapshot()
+			if cert == nil {
+				return &tls.Certificate{}, nil
+			}
+			return cert, nil
+		},
+		// The standard verification is replaced by VerifyConnection because
+		// RootCAs can not be swapped on a live config.
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			_, pool := r.snapshot()
+			return verifyPeer(state, pool)
+		},
+	}
+}
+
+func verifyPeer(state tls.ConnectionState, roots *x509.CertPool) error {
+	if len(state.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       state.ServerName,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	return err
+}
+
+func (r *Reloader) ServerCredentials(requireClientCert bool) credentials.TransportCredentials {
+	return credentials.NewTLS(r.ServerConfig(requireClientCert))
+}
+
+func (r *Reloader) ClientCredentials(serverName string) credentials.TransportCredentials {
+	return credentials.NewTLS(r.ClientConfig(serverName))
+}
@@ -0,0 +1,239 @@
+package certs_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"pinstack-relation-service/internal/infrastructure/certs"
+	"pinstack-relation-service/internal/infrastructure/logger"
+)
+
+var serial atomic.Int64
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial.Add(1)),
+		Subject:               pkix.Name{CommonName: "pinstack test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue returns a PEM encoded leaf certificate and key signed by the CA
+func (ca *testCA) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage) (certPEM, keyPEM []byte, serialNumber *big.Int) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serialNumber = big.NewInt(serial.Add(1))
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		serialNumber
+}
+
+type certFiles struct {
+	cert, key, ca string
+}
+
+func writeCertFiles(t *testing.T, dir string, certPEM, keyPEM, caPEM []byte) certFiles {
+	t.Helper()
+	files := certFiles{
+		cert: filepath.Join(dir, "tls.crt"),
+		key:  filepath.Join(dir, "tls.key"),
+		ca:   filepath.Join(dir, "ca.crt"),
+	}
+	if certPEM != nil {
+		writeAtomically(t, files.key, keyPEM)
+		writeAtomically(t, files.cert, certPEM)
+	} else {
+		files.cert, files.key = "", ""
+	}
+	writeAtomically(t, files.ca, caPEM)
+	return files
+}
+
+func writeAtomically(t *testing.T, path string, data []byte) {
+	t.Helper()
+	tmp := path + ".tmp"
+	require.NoError(t, os.WriteFile(tmp, data, 0o600))
+	require.NoError(t, os.Rename(tmp, path))
+}
+
+func startHealthServer(t *testing.T, reloader *certs.Reloader) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(grpc.Creds(reloader.ServerCredentials(true)))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func newReloader(t *testing.T, files certFiles) *certs.Reloader {
+	t.Helper()
+	reloader, err := certs.NewReloader(files.cert, files.key, files.ca, logger.New("test"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = reloader.Close() })
+	return reloader
+}
+
+func healthCheck(addr string, reloader *certs.Reloader) error {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(reloader.ClientCredentials("localhost")))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func servedSerial(t *testing.T, addr string, reloader *certs.Reloader) *big.Int {
+	t.Helper()
+	cfg := reloader.ClientConfig("localhost")
+	cfg.NextProtos = []string{"h2"}
+	conn, err := tls.Dial("tcp", addr, cfg)
+	require.NoError(t, err)
+	defer conn.Close()
+	return conn.ConnectionState().PeerCertificates[0].SerialNumber
+}
+
+func TestReloader_MutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	serverCert, serverKey, _ := ca.issue(t, "relation-service", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey, _ := ca.issue(t, "api-gateway", x509.ExtKeyUsageClientAuth)
+
+	serverReloader := newReloader(t, writeCertFiles(t, t.TempDir(), serverCert, serverKey, ca.pem))
+	addr := startHealthServer(t, serverReloader)
+
+	t.Run("client with certificate from trusted ca", func(t *testing.T) {
+		clientReloader := newReloader(t, writeCertFiles(t, t.TempDir(), clientCert, clientKey, ca.pem))
+		assert.NoError(t, healthCheck(addr, clientReloader))
+	})
+
+	t.Run("client without certificate is rejected", func(t *testing.T) {
+		clientReloader := newReloader(t, writeCertFiles(t, t.TempDir(), nil, nil, ca.pem))
+		assert.Error(t, healthCheck(addr, clientReloader))
+	})
+
+	t.Run("client certificate from unknown ca is rejected", func(t *testing.T) {
+		otherCA := newTestCA(t)
+		otherCert, otherKey, _ := otherCA.issue(t, "intruder", x509.ExtKeyUsageClientAuth)
+		clientReloader := newReloader(t, writeCertFiles(t, t.TempDir(), otherCert, otherKey, ca.pem))
+		assert.Error(t, healthCheck(addr, clientReloader))
+	})
+
+	t.Run("client not trusting server ca fails", func(t *testing.T) {
+		otherCA := newTestCA(t)
+		clientReloader := newReloader(t, writeCertFiles(t, t.TempDir(), clientCert, clientKey, otherCA.pem))
+		assert.Error(t, healthCheck(addr, clientReloader))
+	})
+}
+
+func TestReloader_ReloadsOnChange(t *testing.T) {
+	ca := newTestCA(t)
+	serverCert, serverKey, firstSerial := ca.issue(t, "relation-service", x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey, _ := ca.issue(t, "api-gateway", x509.ExtKeyUsageClientAuth)
+
+	serverDir := t.TempDir()
+	serverReloader := newReloader(t, writeCertFiles(t, serverDir, serverCert, serverKey, ca.pem))
+	addr := startHealthServer(t, serverReloader)
+
+	clientDir := t.TempDir()
+	clientReloader := newReloader(t, writeCertFiles(t, clientDir, clientCert, clientKey, ca.pem))
+	require.Equal(t, firstSerial, servedSerial(t, addr, clientReloader))
+
+	t.Run("rotated server certificate is served", func(t *testing.T) {
+		rotatedCert, rotatedKey, rotatedSerial := ca.issue(t, "relation-service", x509.ExtKeyUsageServerAuth)
+		writeCertFiles(t, serverDir, rotatedCert, rotatedKey, ca.pem)
+
+		require.Eventually(t, func() bool {
+			return servedSerial(t, addr, clientReloader).Cmp(rotatedSerial) == 0
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("new ca is trusted after ca bundle update", func(t *testing.T) {
+		newCA := newTestCA(t)
+		bundle := append(append([]byte{}, ca.pem...), newCA.pem...)
+
+		newServerCert, newServerKey, _ := newCA.issue(t, "relation-service", x509.ExtKeyUsageServerAuth)
+		writeCertFiles(t, serverDir, newServerCert, newServerKey, bundle)
+
+		require.Eventually(t, func() bool {
+			return healthCheck(addr, clientReloader) != nil
+		}, 5*time.Second, 50*time.Millisecond)
+
+		writeCertFiles(t, clientDir, clientCert, clientKey, bundle)
+		require.Eventually(t, func() bool {
+			return healthCheck(addr, clientReloader) == nil
+		}, 5*time.Second, 50*time.Millisecond)
+	})
+
+	t.Run("broken files keep previous certificate", func(t *testing.T) {
+		writeAtomically(t, filepath.Join(serverDir, "tls.crt"), []byte("garbage"))
+		time.Sleep(500 * time.Millisecond)
+		assert.NoError(t, healthCheck(addr, clientReloader))
+	})
+}
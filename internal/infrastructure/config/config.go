@@ -8,19 +8,50 @@ import (
 )
 
 type Config struct {
-	Env         string
-	GRPCServer  GRPCServer
-	Database    Database
-	UserService UserService
-	EventTypes  EventTypes
-	Kafka       Kafka
-	Outbox      OutboxConfig
-	Prometheus  Prometheus
+	Env          string
+	GRPCServer   GRPCServer
+	RESTServer   RESTServer
+	Database     Database
+	UserService  UserService
+	PostService  PostService
+	EventTypes   EventTypes
+	Kafka        Kafka
+	Outbox       OutboxConfig
+	Prometheus   Prometheus
+	Auth         Auth
+	Redis        Redis
+	RateLimit    RateLimit
+	FollowLimits FollowLimits
+	Suggestions  Suggestions
+	Profiles     Profiles
+	Idempotency  Idempotency
+	Mutes        Mutes
+	Audience     AudienceLists
+	FollowStats  FollowStats
+	Growth       FollowerGrowth
+	Tracing      Tracing
 }
 
 type GRPCServer struct {
 	Address string
 	Port    int
+	TLS     TLS
+}
+
+type RESTServer struct {
+	Enabled bool
+	Address string
+	Port    int
+	TLS     TLS
+}
+
+type TLS struct {
+	Enabled           bool
+	CertFile          string
+	KeyFile           string
+	CAFile            string
+	RequireClientCert bool
+	ServerName        string
 }
 
 type EventTypes struct {
@@ -40,6 +71,14 @@ type Database struct {
 type UserService struct {
 	Address string
 	Port    int
+	TLS     TLS
+}
+
+// PostService selects how board and tag follow targets are checked. The post service has no
+// lookup RPCs yet, so without FakeClient board and tag follows are disabled.
+type PostService struct {
+	// FakeClient accepts every positive board and tag id, it is only allowed in dev
+	FakeClient bool
 }
 
 type Kafka struct {
@@ -67,6 +106,171 @@ type Prometheus struct {
 	Port    int
 }
 
+type Auth struct {
+	Enabled              bool
+	HS256Secret          string
+	RS256PublicKeyPath   string
+	JWKSPath             string
+	Issuer               string
+	Audience             string
+	TrustGatewayMetadata bool
+	GatewaySecret        string
+}
+
+type Redis struct {
+	Address  string
+	Port     int
+	Password string
+	DB       int
+}
+
+const (
+	RateLimitBackendMemory = "memory"
+	RateLimitBackendRedis  = "redis"
+
+	RateLimitKeyCaller = "caller"
+	RateLimitKeyPeer   = "peer"
+	RateLimitKeyTarget = "target"
+)
+
+type RateLimit struct {
+	Enabled           bool
+	Backend           string
+	KeyPrefix         string
+	CleanupIntervalMs int
+	// Default applies to methods without their own policy. A zero rate disables it.
+	Default MethodRateLimit
+	Methods []MethodRateLimit
+}
+
+type MethodRateLimit struct {
+	Method string `mapstructure:"method"`
+	// Key selects what the bucket is keyed by: caller, peer or target
+	Key string `mapstructure:"key"`
+	// TargetField is the request field holding the target user when Key is target
+	TargetField string  `mapstructure:"target_field"`
+	Rate        float64 `mapstructure:"rate"`
+	Burst       int     `mapstructure:"burst"`
+}
+
+type Tracing struct {
+	Enabled       bool
+	ServiceName   string
+	Environment   string
+	SampleRatio   float64
+	OTLPEnabled   bool
+	OTLPEndpoint  string
+	OTLPInsecure  bool
+	StdoutEnabled bool
+}
+
+// FollowLimits are the anti-spam rules for Follow. A zero value disables the rule.
+type FollowLimits struct {
+	MaxFollowsPerHour       int64
+	MaxFollowsPerDay        int64
+	MaxFollowees            int64
+	RefollowCooldownMinutes int
+	CleanupIntervalMs       int
+}
+
+// Suggestions configures the who-to-follow cache and the offline precompute batch
+type Suggestions struct {
+	CacheTTLSeconds        int
+	CacheCleanupIntervalMs int
+	// ActiveWindowDays is how far back the batch looks for users that followed someone
+	ActiveWindowDays int
+	BatchSize        int
+}
+
+func (s Suggestions) CacheTTL() time.Duration {
+	return time.Duration(s.CacheTTLSeconds) * time.Second
+}
+
+func (s Suggestions) CacheCleanupInterval() time.Duration {
+	return time.Duration(s.CacheCleanupIntervalMs) * time.Millisecond
+}
+
+func (s Suggestions) ActiveWindow() time.Duration {
+	return time.Duration(s.ActiveWindowDays) * 24 * time.Hour
+}
+
+// Profiles configures the local copy of user profiles that follower search runs against
+type Profiles struct {
+	SyncBatchSize       int
+	SyncFlushIntervalMs int
+	BackfillBatchSize   int
+	// RefreshAfterDays is the age at which the backfill batch fetches a stored profile again
+	RefreshAfterDays int
+}
+
+// Idempotency configures how long the idempotency keys of Follow and Unfollow are remembered
+type Idempotency struct {
+	TTLSeconds        int
+	CleanupIntervalMs int
+}
+
+func (i Idempotency) TTL() time.Duration {
+	return time.Duration(i.TTLSeconds) * time.Second
+}
+
+func (i Idempotency) CleanupInterval() time.Duration {
+	return time.Duration(i.CleanupIntervalMs) * time.Millisecond
+}
+
+// Mutes configures how often expired mutes are removed
+type Mutes struct {
+	CleanupIntervalMs int
+}
+
+func (m Mutes) CleanupInterval() time.Duration {
+	return time.Duration(m.CleanupIntervalMs) * time.Millisecond
+}
+
+// FollowStats configures how often the daily follows per source are recounted
+type FollowStats struct {
+	AggregateIntervalMs int
+}
+
+func (f FollowStats) AggregateInterval() time.Duration {
+	return time.Duration(f.AggregateIntervalMs) * time.Millisecond
+}
+
+// FollowerGrowth configures how often the daily follower growth is recounted from the relation history
+type FollowerGrowth struct {
+	AggregateIntervalMs int
+}
+
+func (f FollowerGrowth) AggregateInterval() time.Duration {
+	return time.Duration(f.AggregateIntervalMs) * time.Millisecond
+}
+
+// AudienceLists limits the audience lists a user can own. A zero limit disables it.
+type AudienceLists struct {
+	RequireFollowers bool
+	MaxLists         int
+	MaxMembers       int
+}
+
+func (p Profiles) SyncFlushInterval() time.Duration {
+	return time.Duration(p.SyncFlushIntervalMs) * time.Millisecond
+}
+
+func (p Profiles) RefreshAfter() time.Duration {
+	return time.Duration(p.RefreshAfterDays) * 24 * time.Hour
+}
+
+func (f FollowLimits) RefollowCooldown() time.Duration {
+	return time.Duration(f.RefollowCooldownMinutes) * time.Minute
+}
+
+func (f FollowLimits) CleanupInterval() time.Duration {
+	return time.Duration(f.CleanupIntervalMs) * time.Millisecond
+}
+
+func (r RateLimit) CleanupInterval() time.Duration {
+	return time.Duration(r.CleanupIntervalMs) * time.Millisecond
+}
+
 func (o OutboxConfig) TickInterval() time.Duration {
 	return time.Duration(o.TickIntervalMs) * time.Millisecond
 }
@@ -80,6 +284,14 @@ func MustLoad() *Config {
 
 	viper.SetDefault("grpc_server.address", "0.0.0.0")
 	viper.SetDefault("grpc_server.port", 50054)
+	viper.SetDefault("grpc_server.tls.enabled", false)
+	viper.SetDefault("grpc_server.tls.require_client_cert", false)
+
+	viper.SetDefault("rest_server.enabled", false)
+	viper.SetDefault("rest_server.address", "127.0.0.1")
+	viper.SetDefault("rest_server.port", 8083)
+	viper.SetDefault("rest_server.tls.enabled", false)
+	viper.SetDefault("rest_server.tls.require_client_cert", false)
 
 	viper.SetDefault("database.username", "postgres")
 	viper.SetDefault("database.password", "admin")
@@ -93,6 +305,9 @@ func MustLoad() *Config {
 
 	viper.SetDefault("user_service.address", "user-service")
 	viper.SetDefault("user_service.port", 50051)
+	viper.SetDefault("user_service.tls.enabled", false)
+
+	viper.SetDefault("post_service.fake_client", false)
 
 	viper.SetDefault("kafka.brokers", "kafka1:9092,kafka2:9092,kafka3:9092")
 	viper.SetDefault("kafka.topic", "relation-events")
@@ -113,16 +328,88 @@ func MustLoad() *Config {
 	viper.SetDefault("prometheus.address", "0.0.0.0")
 	viper.SetDefault("prometheus.port", 9104)
 
+	viper.SetDefault("auth.enabled", false)
+	viper.SetDefault("auth.hs256_secret", "")
+	viper.SetDefault("auth.rs256_public_key_path", "")
+	viper.SetDefault("auth.jwks_path", "")
+	viper.SetDefault("auth.issuer", "")
+	viper.SetDefault("auth.audience", "")
+	viper.SetDefault("auth.trust_gateway_metadata", false)
+	viper.SetDefault("auth.gateway_secret", "")
+
+	viper.SetDefault("redis.address", "redis")
+	viper.SetDefault("redis.port", 6379)
+	viper.SetDefault("redis.password", "")
+	viper.SetDefault("redis.db", 0)
+
+	viper.SetDefault("rate_limit.enabled", false)
+	viper.SetDefault("rate_limit.backend", RateLimitBackendMemory)
+	viper.SetDefault("rate_limit.key_prefix", "relation:ratelimit:")
+	viper.SetDefault("rate_limit.cleanup_interval_ms", 60000)
+	viper.SetDefault("rate_limit.default.key", RateLimitKeyPeer)
+	viper.SetDefault("rate_limit.default.rate", 0)
+	viper.SetDefault("rate_limit.default.burst", 0)
+
+	viper.SetDefault("follow_limits.max_follows_per_hour", 0)
+	viper.SetDefault("follow_limits.max_follows_per_day", 0)
+	viper.SetDefault("follow_limits.max_followees", 0)
+	viper.SetDefault("follow_limits.refollow_cooldown_minutes", 0)
+	viper.SetDefault("follow_limits.cleanup_interval_ms", 600000)
+
+	viper.SetDefault("suggestions.cache_ttl_seconds", 900)
+	viper.SetDefault("suggestions.cache_cleanup_interval_ms", 60000)
+	viper.SetDefault("suggestions.active_window_days", 7)
+	viper.SetDefault("suggestions.batch_size", 500)
+
+	viper.SetDefault("profiles.sync_batch_size", 100)
+	viper.SetDefault("profiles.sync_flush_interval_ms", 1000)
+	viper.SetDefault("profiles.backfill_batch_size", 500)
+	viper.SetDefault("profiles.refresh_after_days", 7)
+
+	viper.SetDefault("idempotency.ttl_seconds", 86400)
+	viper.SetDefault("idempotency.cleanup_interval_ms", 600000)
+
+	viper.SetDefault("mutes.cleanup_interval_ms", 600000)
+
+	viper.SetDefault("audience_lists.require_followers", true)
+	viper.SetDefault("audience_lists.max_lists", 50)
+	viper.SetDefault("audience_lists.max_members", 1000)
+
+	viper.SetDefault("follow_stats.aggregate_interval_ms", 900000)
+
+	viper.SetDefault("follower_growth.aggregate_interval_ms", 300000)
+
+	viper.SetDefault("tracing.enabled", false)
+	viper.SetDefault("tracing.service_name", "relation-service")
+	viper.SetDefault("tracing.sample_ratio", 1.0)
+	viper.SetDefault("tracing.otlp.enabled", true)
+	viper.SetDefault("tracing.otlp.endpoint", "otel-collector:4317")
+	viper.SetDefault("tracing.otlp.insecure", true)
+	viper.SetDefault("tracing.stdout.enabled", false)
+
 	if err := viper.ReadInConfig(); err != nil {
 		log.Printf("Error reading config file: %s", err)
 		os.Exit(1)
 	}
 
+	var rateLimitMethods []MethodRateLimit
+	if err := viper.UnmarshalKey("rate_limit.methods", &rateLimitMethods); err != nil {
+		log.Printf("Error reading rate limit methods: %s", err)
+		os.Exit(1)
+	}
+
 	config := &Config{
 		Env: viper.GetString("env"),
 		GRPCServer: GRPCServer{
 			Address: viper.GetString("grpc_server.address"),
 			Port:    viper.GetInt("grpc_server.port"),
+			TLS:     loadTLS("grpc_server.tls"),
+		},
+		RESTServer: RESTServer{
+			Enabled: viper.GetBool("rest_server.enabled"),
+			Address: viper.GetString("rest_server.address"),
+			Port:    viper.GetInt("rest_server.port"),
+			TLS:     loadTLS("rest_server.tls"),
 		},
 		Database: Database{
 			Username:       viper.GetString("database.username"),
@@ -135,6 +422,10 @@ func MustLoad() *Config {
 		UserService: UserService{
 			Address: viper.GetString("user_service.address"),
 			Port:    viper.GetInt("user_service.port"),
+			TLS:     loadTLS("user_service.tls"),
+		},
+		PostService: PostService{
+			FakeClient: viper.GetBool("post_service.fake_client"),
 		},
 		EventTypes: EventTypes{
 			FollowCreated: viper.GetString("event_types.follow_created"),
@@ -162,7 +453,105 @@ func MustLoad() *Config {
 			Address: viper.GetString("prometheus.address"),
 			Port:    viper.GetInt("prometheus.port"),
 		},
+		Auth: Auth{
+			Enabled:              viper.GetBool("auth.enabled"),
+			HS256Secret:          viper.GetString("auth.hs256_secret"),
+			RS256PublicKeyPath:   viper.GetString("auth.rs256_public_key_path"),
+			JWKSPath:             viper.GetString("auth.jwks_path"),
+			Issuer:               viper.GetString("auth.issuer"),
+			Audience:             viper.GetString("auth.audience"),
+			TrustGatewayMetadata: viper.GetBool("auth.trust_gateway_metadata"),
+			GatewaySecret:        viper.GetString("auth.gateway_secret"),
+		},
+		Redis: Redis{
+			Address:  viper.GetString("redis.address"),
+			Port:     viper.GetInt("redis.port"),
+			Password: viper.GetString("redis.password"),
+			DB:       viper.GetInt("redis.db"),
+		},
+		RateLimit: RateLimit{
+			Enabled:           viper.GetBool("rate_limit.enabled"),
+			Backend:           viper.GetString("rate_limit.backend"),
+			KeyPrefix:         viper.GetString("rate_limit.key_prefix"),
+			CleanupIntervalMs: viper.GetInt("rate_limit.cleanup_interval_ms"),
+			Default: MethodRateLimit{
+				Key:         viper.GetString("rate_limit.default.key"),
+				TargetField: viper.GetString("rate_limit.default.target_field"),
+				Rate:        viper.GetFloat64("rate_limit.default.rate"),
+				Burst:       viper.GetInt("rate_limit.default.burst"),
+			},
+			Methods: rateLimitMethods,
+		},
+		FollowLimits: FollowLimits{
+			MaxFollowsPerHour:       viper.GetInt64("follow_limits.max_follows_per_hour"),
+			MaxFollowsPerDay:        viper.GetInt64("follow_limits.max_follows_per_day"),
+			MaxFollowees:            viper.GetInt64("follow_limits.max_followees"),
+			RefollowCooldownMinutes: viper.GetInt("follow_limits.refollow_cooldown_minutes"),
+			CleanupIntervalMs:       viper.GetInt("follow_limits.cleanup_interval_ms"),
+		},
+		Suggestions: Suggestions{
+			CacheTTLSeconds:        viper.GetInt("suggestions.cache_ttl_seconds"),
+			CacheCleanupIntervalMs: viper.GetInt("suggestions.cache_cleanup_interval_ms"),
+			ActiveWindowDays:       viper.GetInt("suggestions.active_window_days"),
+			BatchSize:              viper.GetInt("suggestions.batch_size"),
+		},
+		Profiles: Profiles{
+			SyncBatchSize:       viper.GetInt("profiles.sync_batch_size"),
+			SyncFlushIntervalMs: viper.GetInt("profiles.sync_flush_interval_ms"),
+			BackfillBatchSize:   viper.GetInt("profiles.backfill_batch_size"),
+			RefreshAfterDays:    viper.GetInt("profiles.refresh_after_days"),
+		},
+		Idempotency: Idempotency{
+			TTLSeconds:        viper.GetInt("idempotency.ttl_seconds"),
+			CleanupIntervalMs: viper.GetInt("idempotency.cleanup_interval_ms"),
+		},
+		Mutes: Mutes{
+			CleanupIntervalMs: viper.GetInt("mutes.cleanup_interval_ms"),
+		},
+		Audience: AudienceLists{
+			RequireFollowers: viper.GetBool("audience_lists.require_followers"),
+			MaxLists:         viper.GetInt("audience_lists.max_lists"),
+			MaxMembers:       viper.GetInt("audience_lists.max_members"),
+		},
+		FollowStats: FollowStats{
+			AggregateIntervalMs: viper.GetInt("follow_stats.aggregate_interval_ms"),
+		},
+		Growth: FollowerGrowth{
+			AggregateIntervalMs: viper.GetInt("follower_growth.aggregate_interval_ms"),
+		},
+		Tracing: Tracing{
+			Enabled:       viper.GetBool("tracing.enabled"),
+			ServiceName:   viper.GetString("tracing.service_name"),
+			Environment:   viper.GetString("env"),
+			SampleRatio:   viper.GetFloat64("tracing.sample_ratio"),
+			OTLPEnabled:   viper.GetBool("tracing.otlp.enabled"),
+			OTLPEndpoint:  viper.GetString("tracing.otlp.endpoint"),
+			OTLPInsecure:  viper.GetBool("tracing.otlp.insecure"),
+			StdoutEnabled: viper.GetBool("tracing.stdout.enabled"),
+		},
+	}
+
+	if config.PostService.FakeClient && config.Env != "dev" {
+		log.Printf("post_service.fake_client is only allowed when env is dev")
+		os.Exit(1)
+	}
+
+	// The gateway must not be a plaintext way around a TLS protected gRPC listener
+	if config.RESTServer.Enabled && config.GRPCServer.TLS.Enabled && !config.RESTServer.TLS.Enabled {
+		log.Printf("rest_server.tls must be enabled when grpc_server.tls is enabled")
+		os.Exit(1)
 	}
 
 	return config
 }
+
+func loadTLS(prefix string) TLS {
+	return TLS{
+		Enabled:           viper.GetBool(prefix + ".enabled"),
+		CertFile:          viper.GetString(prefix + ".cert_file"),
+		KeyFile:           viper.GetString(prefix + ".key_file"),
+		CAFile:            viper.GetString(prefix + ".ca_file"),
+		RequireClientCert: viper.GetBool(prefix + ".require_client_cert"),
+		ServerName:        viper.GetString(prefix + ".server_name"),
+	}
+}
@@ -0,0 +1,259 @@
+// Package errmapper converts domain errors and validator output into gRPC
+// statuses carrying google.rpc error details, so every inbound adapter
+// reports failures the same way.
+package errmapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	model "pinstack-relation-service/internal/domain/models"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Domain is reported in ErrorInfo so clients can tell relation service errors apart
+const Domain = "relation.pinstack"
+
+const (
+	ReasonInternal        = "INTERNAL"
+	ReasonCanceled        = "CANCELED"
+	ReasonDeadline        = "DEADLINE_EXCEEDED"
+	internalErrorMessage  = "internal server error"
+	defaultRetryDelay     = time.Second
+	unavailableRetryDelay = 2 * time.Second
+)
+
+type rule struct {
+	err    error
+	code   codes.Code
+	reason string
+	// retryAfter is the hint sent in RetryInfo, zero means the error is not retryable
+	retryAfter time.Duration
+}
+
+// rules are matched in order with errors.Is
+var rules = []rule{
+	{err: custom_errors.ErrValidationFailed, code: codes.InvalidArgument, reason: "VALIDATION_FAILED"},
+	{err: custom_errors.ErrInvalidInput, code: codes.InvalidArgument, reason: "INVALID_INPUT"},
+	{err: custom_errors.ErrRequiredField, code: codes.InvalidArgument, reason: "REQUIRED_FIELD"},
+	{err: custom_errors.ErrSelfFollow, code: codes.InvalidArgument, reason: "SELF_FOLLOW"},
+	{err: custom_errors.ErrSelfUnfollow, code: codes.InvalidArgument, reason: "SELF_UNFOLLOW"},
+	{err: custom_errors.ErrAlreadyFollowing, code: codes.AlreadyExists, reason: "ALREADY_FOLLOWING"},
+	{err: custom_errors.ErrFollowRelationExists, code: codes.AlreadyExists, reason: "FOLLOW_RELATION_EXISTS"},
+	{err: model.ErrAudienceListExists, code: codes.AlreadyExists, reason: "AUDIENCE_LIST_EXISTS"},
+	{err: custom_errors.ErrFollowRelationNotFound, code: codes.NotFound, reason: "FOLLOW_RELATION_NOT_FOUND"},
+	{err: custom_errors.ErrUserNotFound, code: codes.NotFound, reason: "USER_NOT_FOUND"},
+	{err: model.ErrBoardNotFound, code: codes.NotFound, reason: "BOARD_NOT_FOUND"},
+	{err: model.ErrMuteNotFound, code: codes.NotFound, reason: "MUTE_NOT_FOUND"},
+	{err: model.ErrAudienceListNotFound, code: codes.NotFound, reason: "AUDIENCE_LIST_NOT_FOUND"},
+	{err: model.ErrAudienceMemberNotFollower, code: codes.FailedPrecondition, reason: "AUDIENCE_MEMBER_NOT_FOLLOWER"},
+	{err: model.ErrFollowTargetsDisabled, code: codes.Unimplemented, reason: "FOLLOW_TARGETS_DISABLED"},
+	{err: custom_errors.ErrTagNotFound, code: codes.NotFound, reason: "TAG_NOT_FOUND"},
+	{err: custom_errors.ErrForbidden, code: codes.PermissionDenied, reason: "FORBIDDEN"},
+	{err: custom_errors.ErrInsufficientRights, code: codes.PermissionDenied, reason: "INSUFFICIENT_RIGHTS"},
+	{err: custom_errors.ErrUnauthenticated, code: codes.Unauthenticated, reason: "UNAUTHENTICATED"},
+	{err: custom_errors.ErrInvalidToken, code: codes.Unauthenticated, reason: "INVALID_TOKEN"},
+	{err: custom_errors.ErrTokenExpired, code: codes.Unauthenticated, reason: "TOKEN_EXPIRED"},
+	{err: model.ErrFollowLimitExceeded, code: codes.ResourceExhausted, reason: "FOLLOW_LIMIT_EXCEEDED"},
+	{err: model.ErrAudienceListLimit, code: codes.ResourceExhausted, reason: "AUDIENCE_LIST_LIMIT"},
+	{err: custom_errors.ErrRateLimitExceeded, code: codes.ResourceExhausted, reason: "RATE_LIMIT_EXCEEDED", retryAfter: defaultRetryDelay},
+	{err: custom_errors.ErrTooManyRequests, code: codes.ResourceExhausted, reason: "TOO_MANY_REQUESTS", retryAfter: defaultRetryDelay},
+	{err: custom_errors.ErrFollowRelationCreateFail, code: codes.Internal, reason: "FOLLOW_RELATION_CREATE_FAILED"},
+	{err: custom_errors.ErrFollowRelationDeleteFail, code: codes.Internal, reason: "FOLLOW_RELATION_DELETE_FAILED"},
+	{err: custom_errors.ErrDatabaseQuery, code: codes.Internal, reason: "DATABASE_QUERY"},
+	{err: custom_errors.ErrDatabaseTransaction, code: codes.Aborted, reason: "DATABASE_TRANSACTION", retryAfter: defaultRetryDelay},
+	{err: custom_errors.ErrDatabaseConnection, code: codes.Unavailable, reason: "DATABASE_UNAVAILABLE", retryAfter: unavailableRetryDelay},
+	{err: custom_errors.ErrExternalServiceUnavailable, code: codes.Unavailable, reason: "EXTERNAL_SERVICE_UNAVAILABLE", retryAfter: unavailableRetryDelay},
+	{err: custom_errors.ErrExternalServiceTimeout, code: codes.Unavailable, reason: "EXTERNAL_SERVICE_TIMEOUT", retryAfter: unavailableRetryDelay},
+	{err: custom_errors.ErrExternalServiceError, code: codes.Unavailable, reason: "EXTERNAL_SERVICE_ERROR", retryAfter: unavailableRetryDelay},
+}
+
+// retryableError attaches an explicit retry delay to an error
+type retryableError struct {
+	err   error
+	delay time.Duration
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+// WithRetryAfter marks err as retryable after delay, overriding the default hint of its rule
+func WithRetryAfter(err error, delay time.Duration) error {
+	return &retryableError{err: err, delay: delay}
+}
+
+// Error converts err into a gRPC status error. Errors that already carry a status are returned unchanged.
+func Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	return Status(err).Err()
+}
+
+// Status builds the gRPC status for err including ErrorInfo and, for retryable errors, RetryInfo
+func Status(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return validationStatus(validationErrs)
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return withDetails(status.New(codes.Canceled, context.Canceled.Error()), errorInfo(ReasonCanceled, nil))
+	case errors.Is(err, context.DeadlineExceeded):
+		return withDetails(status.New(codes.DeadlineExceeded, context.DeadlineExceeded.Error()), errorInfo(ReasonDeadline, nil))
+	}
+
+	for _, r := range rules {
+		if !errors.Is(err, r.err) {
+			continue
+		}
+
+		message := r.err.Error()
+		metadata := map[string]string{}
+		retryAfter := r.retryAfter
+
+		var violation *model.FollowLimitViolation
+		if errors.As(err, &violation) {
+			message = violation.Error()
+			metadata["rule"] = string(violation.Rule)
+			metadata["limit"] = strconv.FormatInt(violation.Limit, 10)
+			retryAfter = violation.RetryAfter
+		}
+
+		var retryable *retryableError
+		if errors.As(err, &retryable) {
+			retryAfter = retryable.delay
+		}
+
+		st := withDetails(status.New(r.code, message), errorInfo(r.reason, metadata))
+		if retryAfter > 0 {
+			st = withDetails(st, &errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+		}
+		return st
+	}
+
+	return withDetails(status.New(codes.Internal, internalErrorMessage), errorInfo(ReasonInternal, nil))
+}
+
+// ValidationError converts validator output into InvalidArgument with BadRequest field violations.
+// Any other error is reported as a plain validation failure.
+func ValidationError(err error) error {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return validationStatus(validationErrs).Err()
+	}
+	return withDetails(
+		status.New(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error()),
+		errorInfo("VALIDATION_FAILED", nil),
+	).Err()
+}
+
+// InvalidField reports a single malformed field, for input that fails before it reaches the validator
+// such as a path parameter that is not a number.
+func InvalidField(field, description string) error {
+	return withDetails(
+		status.New(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error()),
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: description,
+			Reason:      "INVALID_FORMAT",
+		}}},
+		errorInfo("VALIDATION_FAILED", nil),
+	).Err()
+}
+
+func validationStatus(validationErrs validator.ValidationErrors) *status.Status {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       FieldName(fieldErr.Field()),
+			Description: describe(fieldErr),
+			Reason:      strings.ToUpper(fieldErr.Tag()),
+		})
+	}
+
+	return withDetails(
+		status.New(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error()),
+		&errdetails.BadRequest{FieldViolations: violations},
+		errorInfo("VALIDATION_FAILED", nil),
+	)
+}
+
+func describe(fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "required":
+		return "must be set"
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", fieldErr.Param())
+	case "gte":
+		return fmt.Sprintf("must be greater than or equal to %s", fieldErr.Param())
+	case "lt":
+		return fmt.Sprintf("must be less than %s", fieldErr.Param())
+	case "lte":
+		return fmt.Sprintf("must be less than or equal to %s", fieldErr.Param())
+	case "min":
+		return fmt.Sprintf("must have at least %s elements", fieldErr.Param())
+	case "max":
+		return fmt.Sprintf("must have at most %s elements", fieldErr.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of [%s]", fieldErr.Param())
+	default:
+		return fmt.Sprintf("failed on the %q rule", fieldErr.Tag())
+	}
+}
+
+// FieldName converts a Go struct field name such as FolloweeID into the proto field name followee_id
+func FieldName(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1]) && !isPluralSuffix(runes, i+1)
+			if i > 0 && (prevLower || (nextLower && unicode.IsUpper(runes[i-1]))) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// isPluralSuffix reports whether runes[i] is the "s" of a pluralised acronym such as IDs
+func isPluralSuffix(runes []rune, i int) bool {
+	return runes[i] == 's' && (i+1 == len(runes) || unicode.IsUpper(runes[i+1]))
+}
+
+func errorInfo(reason string, metadata map[string]string) *errdetails.ErrorInfo {
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+	return &errdetails.ErrorInfo{Reason: reason, Domain: Domain, Metadata: metadata}
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
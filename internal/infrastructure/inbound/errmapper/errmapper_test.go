@@ -0,0 +1,197 @@
+package errmapper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func details(t *testing.T, err error) (*status.Status, *errdetails.ErrorInfo, *errdetails.RetryInfo, *errdetails.BadRequest) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+
+	var info *errdetails.ErrorInfo
+	var retry *errdetails.RetryInfo
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.RetryInfo:
+			retry = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+	return st, info, retry, badRequest
+}
+
+func TestError_DomainErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantMsg    string
+		wantReason string
+		wantRetry  time.Duration
+	}{
+		{"validation failed", custom_errors.ErrValidationFailed, codes.InvalidArgument, "validation failed", "VALIDATION_FAILED", 0},
+		{"invalid input", custom_errors.ErrInvalidInput, codes.InvalidArgument, "invalid input", "INVALID_INPUT", 0},
+		{"required field", custom_errors.ErrRequiredField, codes.InvalidArgument, "required field is missing", "REQUIRED_FIELD", 0},
+		{"self follow", custom_errors.ErrSelfFollow, codes.InvalidArgument, "cannot follow yourself", "SELF_FOLLOW", 0},
+		{"self unfollow", custom_errors.ErrSelfUnfollow, codes.InvalidArgument, "cannot unfollow yourself", "SELF_UNFOLLOW", 0},
+		{"already following", custom_errors.ErrAlreadyFollowing, codes.AlreadyExists, "already following this user", "ALREADY_FOLLOWING", 0},
+		{"relation exists", custom_errors.ErrFollowRelationExists, codes.AlreadyExists, "follow relation already exists", "FOLLOW_RELATION_EXISTS", 0},
+		{"audience list exists", model.ErrAudienceListExists, codes.AlreadyExists, "audience list with this name already exists", "AUDIENCE_LIST_EXISTS", 0},
+		{"relation not found", custom_errors.ErrFollowRelationNotFound, codes.NotFound, "follow relation not found", "FOLLOW_RELATION_NOT_FOUND", 0},
+		{"user not found", custom_errors.ErrUserNotFound, codes.NotFound, "user not found", "USER_NOT_FOUND", 0},
+		{"board not found", model.ErrBoardNotFound, codes.NotFound, "board not found", "BOARD_NOT_FOUND", 0},
+		{"mute not found", model.ErrMuteNotFound, codes.NotFound, "mute not found", "MUTE_NOT_FOUND", 0},
+		{"audience list not found", model.ErrAudienceListNotFound, codes.NotFound, "audience list not found", "AUDIENCE_LIST_NOT_FOUND", 0},
+		{"audience member not follower", model.ErrAudienceMemberNotFollower, codes.FailedPrecondition, "audience list member must follow the owner", "AUDIENCE_MEMBER_NOT_FOLLOWER", 0},
+		{"follow targets disabled", model.ErrFollowTargetsDisabled, codes.Unimplemented, "board and tag follows are not available", "FOLLOW_TARGETS_DISABLED", 0},
+		{"tag not found", custom_errors.ErrTagNotFound, codes.NotFound, "tag not found", "TAG_NOT_FOUND", 0},
+		{"forbidden", custom_errors.ErrForbidden, codes.PermissionDenied, "forbidden", "FORBIDDEN", 0},
+		{"insufficient rights", custom_errors.ErrInsufficientRights, codes.PermissionDenied, "insufficient rights", "INSUFFICIENT_RIGHTS", 0},
+		{"unauthenticated", custom_errors.ErrUnauthenticated, codes.Unauthenticated, "unauthenticated", "UNAUTHENTICATED", 0},
+		{"invalid token", custom_errors.ErrInvalidToken, codes.Unauthenticated, "invalid token", "INVALID_TOKEN", 0},
+		{"token expired", custom_errors.ErrTokenExpired, codes.Unauthenticated, "token expired", "TOKEN_EXPIRED", 0},
+		{"follow limit exceeded", model.ErrFollowLimitExceeded, codes.ResourceExhausted, "follow limit exceeded", "FOLLOW_LIMIT_EXCEEDED", 0},
+		{"audience list limit", model.ErrAudienceListLimit, codes.ResourceExhausted, "audience list limit reached", "AUDIENCE_LIST_LIMIT", 0},
+		{"rate limit exceeded", custom_errors.ErrRateLimitExceeded, codes.ResourceExhausted, "rate limit exceeded", "RATE_LIMIT_EXCEEDED", time.Second},
+		{"too many requests", custom_errors.ErrTooManyRequests, codes.ResourceExhausted, "too many requests", "TOO_MANY_REQUESTS", time.Second},
+		{"relation create failed", custom_errors.ErrFollowRelationCreateFail, codes.Internal, "failed to create follow relation", "FOLLOW_RELATION_CREATE_FAILED", 0},
+		{"relation delete failed", custom_errors.ErrFollowRelationDeleteFail, codes.Internal, "failed to delete follow relation", "FOLLOW_RELATION_DELETE_FAILED", 0},
+		{"database query", custom_errors.ErrDatabaseQuery, codes.Internal, "database query error", "DATABASE_QUERY", 0},
+		{"database transaction", custom_errors.ErrDatabaseTransaction, codes.Aborted, "database transaction error", "DATABASE_TRANSACTION", time.Second},
+		{"database unavailable", custom_errors.ErrDatabaseConnection, codes.Unavailable, "database connection error", "DATABASE_UNAVAILABLE", 2 * time.Second},
+		{"external service unavailable", custom_errors.ErrExternalServiceUnavailable, codes.Unavailable, "external service unavailable", "EXTERNAL_SERVICE_UNAVAILABLE", 2 * time.Second},
+		{"external service timeout", custom_errors.ErrExternalServiceTimeout, codes.Unavailable, "external service timeout", "EXTERNAL_SERVICE_TIMEOUT", 2 * time.Second},
+		{"external service error", custom_errors.ErrExternalServiceError, codes.Unavailable, "external service error", "EXTERNAL_SERVICE_ERROR", 2 * time.Second},
+		{"wrapped domain error", fmt.Errorf("get user: %w", custom_errors.ErrUserNotFound), codes.NotFound, "user not found", "USER_NOT_FOUND", 0},
+		{"context canceled", context.Canceled, codes.Canceled, "context canceled", errmapper.ReasonCanceled, 0},
+		{"deadline exceeded", context.DeadlineExceeded, codes.DeadlineExceeded, "context deadline exceeded", errmapper.ReasonDeadline, 0},
+		{"unknown error is not leaked", errors.New("pq: connection refused on 10.0.0.1"), codes.Internal, "internal server error", errmapper.ReasonInternal, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, info, retry, _ := details(t, errmapper.Error(tt.err))
+
+			assert.Equal(t, tt.wantCode, st.Code())
+			assert.Equal(t, tt.wantMsg, st.Message())
+			require.NotNil(t, info)
+			assert.Equal(t, tt.wantReason, info.GetReason())
+			assert.Equal(t, errmapper.Domain, info.GetDomain())
+
+			if tt.wantRetry == 0 {
+				assert.Nil(t, retry)
+			} else {
+				require.NotNil(t, retry)
+				assert.Equal(t, tt.wantRetry, retry.GetRetryDelay().AsDuration())
+			}
+		})
+	}
+}
+
+func TestError_FollowLimitViolation(t *testing.T) {
+	err := &model.FollowLimitViolation{Rule: model.FollowLimitRuleCooldown, Limit: 3600, Observed: 600, RetryAfter: 50 * time.Minute}
+
+	st, info, retry, _ := details(t, errmapper.Error(err))
+
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Equal(t, "follow limit exceeded: refollow_cooldown", st.Message())
+	assert.Equal(t, "FOLLOW_LIMIT_EXCEEDED", info.GetReason())
+	assert.Equal(t, map[string]string{"rule": "refollow_cooldown", "limit": "3600"}, info.GetMetadata())
+	require.NotNil(t, retry)
+	assert.Equal(t, 50*time.Minute, retry.GetRetryDelay().AsDuration())
+}
+
+func TestError_WithRetryAfter(t *testing.T) {
+	st, _, retry, _ := details(t, errmapper.Error(errmapper.WithRetryAfter(custom_errors.ErrRateLimitExceeded, 7*time.Second)))
+
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	require.NotNil(t, retry)
+	assert.Equal(t, 7*time.Second, retry.GetRetryDelay().AsDuration())
+}
+
+func TestError_StatusPassthrough(t *testing.T) {
+	original := status.Error(codes.FailedPrecondition, "already handled")
+	assert.Equal(t, original, errmapper.Error(original))
+	assert.NoError(t, errmapper.Error(nil))
+}
+
+func TestValidationError(t *testing.T) {
+	type request struct {
+		FollowerID int64 `validate:"required,gt=0"`
+		Limit      int32 `validate:"required,gt=0,lte=100"`
+		Page       int32 `validate:"gte=1"`
+	}
+
+	err := validator.New().Struct(&request{FollowerID: 0, Limit: 500, Page: 0})
+	require.Error(t, err)
+
+	st, info, _, badRequest := details(t, errmapper.ValidationError(err))
+
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, custom_errors.ErrValidationFailed.Error(), st.Message())
+	assert.Equal(t, "VALIDATION_FAILED", info.GetReason())
+	require.NotNil(t, badRequest)
+
+	violations := map[string]*errdetails.BadRequest_FieldViolation{}
+	for _, v := range badRequest.GetFieldViolations() {
+		violations[v.GetField()] = v
+	}
+	require.Len(t, violations, 3)
+	assert.Equal(t, "must be set", violations["follower_id"].GetDescription())
+	assert.Equal(t, "REQUIRED", violations["follower_id"].GetReason())
+	assert.Equal(t, "must be less than or equal to 100", violations["limit"].GetDescription())
+	assert.Equal(t, "must be greater than or equal to 1", violations["page"].GetDescription())
+}
+
+func TestValidationError_NonValidatorError(t *testing.T) {
+	st, info, _, badRequest := details(t, errmapper.ValidationError(errors.New("bad input")))
+
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "VALIDATION_FAILED", info.GetReason())
+	assert.Nil(t, badRequest)
+}
+
+func TestInvalidField(t *testing.T) {
+	st, info, _, badRequest := details(t, errmapper.InvalidField("follower_id", "must be an integer"))
+
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, "VALIDATION_FAILED", info.GetReason())
+	require.NotNil(t, badRequest)
+	require.Len(t, badRequest.GetFieldViolations(), 1)
+	assert.Equal(t, "follower_id", badRequest.GetFieldViolations()[0].GetField())
+	assert.Equal(t, "INVALID_FORMAT", badRequest.GetFieldViolations()[0].GetReason())
+}
+
+func TestFieldName(t *testing.T) {
+	tests := map[string]string{
+		"FollowerID": "follower_id",
+		"FolloweeID": "followee_id",
+		"Limit":      "limit",
+		"IDsOnly":    "ids_only",
+		"TargetIDs":  "target_ids",
+		"HTTPStatus": "http_status",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, errmapper.FieldName(in), in)
+	}
+}
@@ -0,0 +1,50 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type AudienceMemberAdder interface {
+	AddAudienceMembers(ctx context.Context, ownerID, listID int64, memberIDs []int64) ([]int64, error)
+}
+
+type AddAudienceMembersHandler struct {
+	relationService AudienceMemberAdder
+	validate        *validator.Validate
+}
+
+func NewAddAudienceMembersHandler(relationService AudienceMemberAdder, validate *validator.Validate) *AddAudienceMembersHandler {
+	return &AddAudienceMembersHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type AudienceMembersRequestInternal struct {
+	OwnerID   int64   `validate:"required,gt=0"`
+	ListID    int64   `validate:"required,gt=0"`
+	MemberIDs []int64 `validate:"required,min=1,max=100,dive,gt=0"`
+}
+
+func (h *AddAudienceMembersHandler) AddAudienceMembers(ctx context.Context, req *extpb.AudienceMembersRequest) (*extpb.AudienceMembersResponse, error) {
+	validationReq := &AudienceMembersRequestInternal{
+		OwnerID:   req.GetOwnerId(),
+		ListID:    req.GetListId(),
+		MemberIDs: req.GetMemberIds(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	added, err := h.relationService.AddAudienceMembers(ctx, req.GetOwnerId(), req.GetListId(), req.GetMemberIds())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.AudienceMembersResponse{ChangedMemberIds: added}, nil
+}
@@ -0,0 +1,79 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type BulkFollower interface {
+	BulkFollow(ctx context.Context, followerID int64, followeeIDs []int64) ([]model.BulkResult, error)
+}
+
+type BulkFollowHandler struct {
+	relationService BulkFollower
+	validate        *validator.Validate
+}
+
+func NewBulkFollowHandler(relationService BulkFollower, validate *validator.Validate) *BulkFollowHandler {
+	return &BulkFollowHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type BulkFollowRequestInternal struct {
+	FollowerID  int64   `validate:"required,gt=0"`
+	FolloweeIDs []int64 `validate:"required,min=1,max=100,dive,gt=0"`
+}
+
+func (h *BulkFollowHandler) BulkFollow(ctx context.Context, req *extpb.BulkFollowRequest) (*extpb.BulkFollowResponse, error) {
+	validationReq := &BulkFollowRequestInternal{
+		FollowerID:  req.GetFollowerId(),
+		FolloweeIDs: req.GetFolloweeIds(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	results, err := h.relationService.BulkFollow(ctx, req.GetFollowerId(), req.GetFolloweeIds())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.BulkFollowResponse{Results: bulkResultsToProto(results)}, nil
+}
+
+func bulkResultsToProto(results []model.BulkResult) []*extpb.BulkFollowResult {
+	resp := make([]*extpb.BulkFollowResult, 0, len(results))
+	for _, result := range results {
+		resp = append(resp, &extpb.BulkFollowResult{
+			FolloweeId: result.TargetID,
+			Outcome:    bulkOutcomeToProto(result.Outcome),
+		})
+	}
+	return resp
+}
+
+func bulkOutcomeToProto(outcome model.BulkOutcome) extpb.BulkOutcome {
+	switch outcome {
+	case model.BulkOutcomeCreated:
+		return extpb.BulkOutcome_BULK_OUTCOME_CREATED
+	case model.BulkOutcomeAlreadyFollowing:
+		return extpb.BulkOutcome_BULK_OUTCOME_ALREADY_FOLLOWING
+	case model.BulkOutcomeNotFound:
+		return extpb.BulkOutcome_BULK_OUTCOME_NOT_FOUND
+	case model.BulkOutcomeBlocked:
+		return extpb.BulkOutcome_BULK_OUTCOME_BLOCKED
+	case model.BulkOutcomeRemoved:
+		return extpb.BulkOutcome_BULK_OUTCOME_REMOVED
+	case model.BulkOutcomeNotFollowing:
+		return extpb.BulkOutcome_BULK_OUTCOME_NOT_FOLLOWING
+	default:
+		return extpb.BulkOutcome_BULK_OUTCOME_UNSPECIFIED
+	}
+}
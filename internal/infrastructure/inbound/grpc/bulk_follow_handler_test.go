@@ -0,0 +1,126 @@
+package follow_grpc_test
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestBulkFollowHandler_BulkFollow(t *testing.T) {
+	tooMany := make([]int64, 101)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 2)
+	}
+
+	tests := []struct {
+		name            string
+		req             *extpb.BulkFollowRequest
+		mockSetup       func(*mocks.FollowService)
+		wantErr         bool
+		expectedCode    codes.Code
+		expectedErrMsg  string
+		expectedResults []*extpb.BulkFollowResult
+	}{
+		{
+			name: "successful bulk follow",
+			req:  &extpb.BulkFollowRequest{FollowerId: 1, FolloweeIds: []int64{2, 3, 4, 5}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("BulkFollow", context.Background(), int64(1), []int64{2, 3, 4, 5}).Return([]model.BulkResult{
+					{TargetID: 2, Outcome: model.BulkOutcomeCreated},
+					{TargetID: 3, Outcome: model.BulkOutcomeAlreadyFollowing},
+					{TargetID: 4, Outcome: model.BulkOutcomeNotFound},
+					{TargetID: 5, Outcome: model.BulkOutcomeBlocked},
+				}, nil)
+			},
+			expectedResults: []*extpb.BulkFollowResult{
+				{FolloweeId: 2, Outcome: extpb.BulkOutcome_BULK_OUTCOME_CREATED},
+				{FolloweeId: 3, Outcome: extpb.BulkOutcome_BULK_OUTCOME_ALREADY_FOLLOWING},
+				{FolloweeId: 4, Outcome: extpb.BulkOutcome_BULK_OUTCOME_NOT_FOUND},
+				{FolloweeId: 5, Outcome: extpb.BulkOutcome_BULK_OUTCOME_BLOCKED},
+			},
+		},
+		{
+			name:           "validation error - empty followee list",
+			req:            &extpb.BulkFollowRequest{FollowerId: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - too many followees",
+			req:            &extpb.BulkFollowRequest{FollowerId: 1, FolloweeIds: tooMany},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - follower ID zero",
+			req:            &extpb.BulkFollowRequest{FollowerId: 0, FolloweeIds: []int64{2}},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "self follow",
+			req:  &extpb.BulkFollowRequest{FollowerId: 1, FolloweeIds: []int64{1, 2}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("BulkFollow", mock.Anything, int64(1), []int64{1, 2}).Return(nil, custom_errors.ErrSelfFollow)
+			},
+			wantErr:      true,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "forbidden",
+			req:  &extpb.BulkFollowRequest{FollowerId: 1, FolloweeIds: []int64{2}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("BulkFollow", mock.Anything, int64(1), []int64{2}).Return(nil, custom_errors.ErrForbidden)
+			},
+			wantErr:      true,
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewBulkFollowHandler(mockService, validate)
+			resp, err := handler.BulkFollow(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetResults(), len(tt.expectedResults))
+			for i, expected := range tt.expectedResults {
+				assert.Equal(t, expected.GetFolloweeId(), resp.GetResults()[i].GetFolloweeId())
+				assert.Equal(t, expected.GetOutcome(), resp.GetResults()[i].GetOutcome())
+			}
+		})
+	}
+}
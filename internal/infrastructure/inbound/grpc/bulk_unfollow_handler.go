@@ -0,0 +1,44 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type BulkUnfollower interface {
+	BulkUnfollow(ctx context.Context, followerID int64, followeeIDs []int64) ([]model.BulkResult, error)
+}
+
+type BulkUnfollowHandler struct {
+	relationService BulkUnfollower
+	validate        *validator.Validate
+}
+
+func NewBulkUnfollowHandler(relationService BulkUnfollower, validate *validator.Validate) *BulkUnfollowHandler {
+	return &BulkUnfollowHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+func (h *BulkUnfollowHandler) BulkUnfollow(ctx context.Context, req *extpb.BulkFollowRequest) (*extpb.BulkFollowResponse, error) {
+	validationReq := &BulkFollowRequestInternal{
+		FollowerID:  req.GetFollowerId(),
+		FolloweeIDs: req.GetFolloweeIds(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	results, err := h.relationService.BulkUnfollow(ctx, req.GetFollowerId(), req.GetFolloweeIds())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.BulkFollowResponse{Results: bulkResultsToProto(results)}, nil
+}
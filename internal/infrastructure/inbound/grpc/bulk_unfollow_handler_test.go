@@ -0,0 +1,96 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestBulkUnfollowHandler_BulkUnfollow(t *testing.T) {
+	tests := []struct {
+		name            string
+		req             *extpb.BulkFollowRequest
+		mockSetup       func(*mocks.FollowService)
+		wantErr         bool
+		expectedCode    codes.Code
+		expectedErrMsg  string
+		expectedResults []*extpb.BulkFollowResult
+	}{
+		{
+			name: "successful bulk unfollow",
+			req:  &extpb.BulkFollowRequest{FollowerId: 1, FolloweeIds: []int64{2, 3}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("BulkUnfollow", context.Background(), int64(1), []int64{2, 3}).Return([]model.BulkResult{
+					{TargetID: 2, Outcome: model.BulkOutcomeRemoved},
+					{TargetID: 3, Outcome: model.BulkOutcomeNotFollowing},
+				}, nil)
+			},
+			expectedResults: []*extpb.BulkFollowResult{
+				{FolloweeId: 2, Outcome: extpb.BulkOutcome_BULK_OUTCOME_REMOVED},
+				{FolloweeId: 3, Outcome: extpb.BulkOutcome_BULK_OUTCOME_NOT_FOLLOWING},
+			},
+		},
+		{
+			name:           "validation error - followee ID zero",
+			req:            &extpb.BulkFollowRequest{FollowerId: 1, FolloweeIds: []int64{2, 0}},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "internal error",
+			req:  &extpb.BulkFollowRequest{FollowerId: 1, FolloweeIds: []int64{2}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("BulkUnfollow", mock.Anything, int64(1), []int64{2}).Return(nil, errors.New("db down"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewBulkUnfollowHandler(mockService, validate)
+			resp, err := handler.BulkUnfollow(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetResults(), len(tt.expectedResults))
+			for i, expected := range tt.expectedResults {
+				assert.Equal(t, expected.GetFolloweeId(), resp.GetResults()[i].GetFolloweeId())
+				assert.Equal(t, expected.GetOutcome(), resp.GetResults()[i].GetOutcome())
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AudienceListCreator interface {
+	CreateAudienceList(ctx context.Context, ownerID int64, name string) (model.AudienceList, error)
+}
+
+type CreateAudienceListHandler struct {
+	relationService AudienceListCreator
+	validate        *validator.Validate
+}
+
+func NewCreateAudienceListHandler(relationService AudienceListCreator, validate *validator.Validate) *CreateAudienceListHandler {
+	return &CreateAudienceListHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type CreateAudienceListRequestInternal struct {
+	OwnerID int64  `validate:"required,gt=0"`
+	Name    string `validate:"required,max=64"`
+}
+
+func (h *CreateAudienceListHandler) CreateAudienceList(ctx context.Context, req *extpb.CreateAudienceListRequest) (*extpb.CreateAudienceListResponse, error) {
+	validationReq := &CreateAudienceListRequestInternal{
+		OwnerID: req.GetOwnerId(),
+		Name:    req.GetName(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	list, err := h.relationService.CreateAudienceList(ctx, req.GetOwnerId(), req.GetName())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.CreateAudienceListResponse{List: audienceListToProto(list)}, nil
+}
+
+func audienceListToProto(list model.AudienceList) *extpb.AudienceList {
+	return &extpb.AudienceList{
+		Id:          list.ID,
+		OwnerId:     list.OwnerID,
+		Name:        list.Name,
+		MemberCount: list.MemberCount,
+		CreatedAt:   timestamppb.New(list.CreatedAt),
+		UpdatedAt:   timestamppb.New(list.UpdatedAt),
+	}
+}
@@ -0,0 +1,120 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestCreateAudienceListHandler_CreateAudienceList(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	list := model.AudienceList{ID: 7, OwnerID: 1, Name: "Close friends", MemberCount: 2, CreatedAt: createdAt, UpdatedAt: createdAt}
+	pbList := &extpb.AudienceList{Id: 7, OwnerId: 1, Name: "Close friends", MemberCount: 2, CreatedAt: timestamppb.New(createdAt), UpdatedAt: timestamppb.New(createdAt)}
+
+	tests := []struct {
+		name           string
+		req            *extpb.CreateAudienceListRequest
+		mockSetup      func(*mocks.FollowService)
+		want           *extpb.CreateAudienceListResponse
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful create",
+			req:  &extpb.CreateAudienceListRequest{OwnerId: 1, Name: "Close friends"},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("CreateAudienceList", context.Background(), int64(1), "Close friends").Return(list, nil)
+			},
+			want: &extpb.CreateAudienceListResponse{List: pbList},
+		},
+		{
+			name:           "validation error - empty name",
+			req:            &extpb.CreateAudienceListRequest{OwnerId: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - name too long",
+			req:            &extpb.CreateAudienceListRequest{OwnerId: 1, Name: strings.Repeat("a", 65)},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "name already taken",
+			req:  &extpb.CreateAudienceListRequest{OwnerId: 1, Name: "Close friends"},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("CreateAudienceList", mock.Anything, int64(1), "Close friends").Return(model.AudienceList{}, model.ErrAudienceListExists)
+			},
+			wantErr:        true,
+			expectedCode:   codes.AlreadyExists,
+			expectedErrMsg: model.ErrAudienceListExists.Error(),
+		},
+		{
+			name: "list limit reached",
+			req:  &extpb.CreateAudienceListRequest{OwnerId: 1, Name: "Close friends"},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("CreateAudienceList", mock.Anything, int64(1), "Close friends").Return(model.AudienceList{}, model.ErrAudienceListLimit)
+			},
+			wantErr:        true,
+			expectedCode:   codes.ResourceExhausted,
+			expectedErrMsg: model.ErrAudienceListLimit.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.CreateAudienceListRequest{OwnerId: 1, Name: "Close friends"},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("CreateAudienceList", mock.Anything, int64(1), "Close friends").Return(model.AudienceList{}, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewCreateAudienceListHandler(mockService, validate)
+			resp, err := handler.CreateAudienceList(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type AudienceListDeleter interface {
+	DeleteAudienceList(ctx context.Context, ownerID, listID int64) error
+}
+
+type DeleteAudienceListHandler struct {
+	relationService AudienceListDeleter
+	validate        *validator.Validate
+}
+
+func NewDeleteAudienceListHandler(relationService AudienceListDeleter, validate *validator.Validate) *DeleteAudienceListHandler {
+	return &DeleteAudienceListHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type DeleteAudienceListRequestInternal struct {
+	OwnerID int64 `validate:"required,gt=0"`
+	ListID  int64 `validate:"required,gt=0"`
+}
+
+func (h *DeleteAudienceListHandler) DeleteAudienceList(ctx context.Context, req *extpb.DeleteAudienceListRequest) (*extpb.DeleteAudienceListResponse, error) {
+	validationReq := &DeleteAudienceListRequestInternal{
+		OwnerID: req.GetOwnerId(),
+		ListID:  req.GetListId(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	if err := h.relationService.DeleteAudienceList(ctx, req.GetOwnerId(), req.GetListId()); err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.DeleteAudienceListResponse{}, nil
+}
@@ -0,0 +1,216 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	inport "pinstack-relation-service/internal/domain/ports/input/service"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+
+	"google.golang.org/grpc"
+)
+
+// RelationExtGRPCService serves the RPCs defined in the local relation_ext proto
+type RelationExtGRPCService struct {
+	extpb.UnimplementedRelationExtServiceServer
+	relationService         inport.FollowService
+	log                     ports.Logger
+	getRelationshipsHandler *GetRelationshipsHandler
+	getMutualFollowsHandler *GetMutualFollowsHandler
+	isMutualHandler         *IsMutualHandler
+	followersYouKnowHandler *GetFollowersYouKnowHandler
+	getSuggestionsHandler   *GetSuggestionsHandler
+	followTargetHandler     *FollowTargetHandler
+	unfollowTargetHandler   *UnfollowTargetHandler
+	followedTargetsHandler  *ListFollowedTargetsHandler
+	followerIDsHandler      *StreamFollowerIDsHandler
+	listFollowersHandler    *ListFollowersHandler
+	listFolloweesHandler    *ListFolloweesHandler
+	searchFollowersHandler  *SearchFollowersHandler
+	searchFolloweesHandler  *SearchFolloweesHandler
+	relationHistoryHandler  *GetRelationHistoryHandler
+	removeFollowerHandler   *RemoveFollowerHandler
+	removeFollowersHandler  *RemoveFollowersHandler
+	bulkFollowHandler       *BulkFollowHandler
+	bulkUnfollowHandler     *BulkUnfollowHandler
+	muteHandler             *MuteHandler
+	unmuteHandler           *UnmuteHandler
+	listMutedHandler        *ListMutedHandler
+	filterMutedHandler      *FilterMutedHandler
+	createAudienceHandler   *CreateAudienceListHandler
+	renameAudienceHandler   *RenameAudienceListHandler
+	deleteAudienceHandler   *DeleteAudienceListHandler
+	listAudiencesHandler    *ListAudienceListsHandler
+	addMembersHandler       *AddAudienceMembersHandler
+	removeMembersHandler    *RemoveAudienceMembersHandler
+	listMembersHandler      *ListAudienceMembersHandler
+	isMemberHandler         *IsAudienceMemberHandler
+	followSourceHandler     *GetFollowSourceStatsHandler
+	followerGrowthHandler   *GetFollowerGrowthHandler
+}
+
+func NewRelationExtGRPCService(relationService inport.FollowService, log ports.Logger) *RelationExtGRPCService {
+	return &RelationExtGRPCService{
+		relationService:         relationService,
+		log:                     log,
+		getRelationshipsHandler: NewGetRelationshipsHandler(relationService, validate),
+		getMutualFollowsHandler: NewGetMutualFollowsHandler(relationService, validate),
+		isMutualHandler:         NewIsMutualHandler(relationService, validate),
+		followersYouKnowHandler: NewGetFollowersYouKnowHandler(relationService, validate),
+		getSuggestionsHandler:   NewGetSuggestionsHandler(relationService, validate),
+		followTargetHandler:     NewFollowTargetHandler(relationService, validate),
+		unfollowTargetHandler:   NewUnfollowTargetHandler(relationService, validate),
+		followedTargetsHandler:  NewListFollowedTargetsHandler(relationService, validate),
+		followerIDsHandler:      NewStreamFollowerIDsHandler(relationService, validate),
+		listFollowersHandler:    NewListFollowersHandler(relationService, validate),
+		listFolloweesHandler:    NewListFolloweesHandler(relationService, validate),
+		searchFollowersHandler:  NewSearchFollowersHandler(relationService, validate),
+		searchFolloweesHandler:  NewSearchFolloweesHandler(relationService, validate),
+		relationHistoryHandler:  NewGetRelationHistoryHandler(relationService, validate),
+		removeFollowerHandler:   NewRemoveFollowerHandler(relationService, validate),
+		removeFollowersHandler:  NewRemoveFollowersHandler(relationService, validate),
+		bulkFollowHandler:       NewBulkFollowHandler(relationService, validate),
+		bulkUnfollowHandler:     NewBulkUnfollowHandler(relationService, validate),
+		muteHandler:             NewMuteHandler(relationService, validate),
+		unmuteHandler:           NewUnmuteHandler(relationService, validate),
+		listMutedHandler:        NewListMutedHandler(relationService, validate),
+		filterMutedHandler:      NewFilterMutedHandler(relationService, validate),
+		createAudienceHandler:   NewCreateAudienceListHandler(relationService, validate),
+		renameAudienceHandler:   NewRenameAudienceListHandler(relationService, validate),
+		deleteAudienceHandler:   NewDeleteAudienceListHandler(relationService, validate),
+		listAudiencesHandler:    NewListAudienceListsHandler(relationService, validate),
+		addMembersHandler:       NewAddAudienceMembersHandler(relationService, validate),
+		removeMembersHandler:    NewRemoveAudienceMembersHandler(relationService, validate),
+		listMembersHandler:      NewListAudienceMembersHandler(relationService, validate),
+		isMemberHandler:         NewIsAudienceMemberHandler(relationService, validate),
+		followSourceHandler:     NewGetFollowSourceStatsHandler(relationService, validate),
+		followerGrowthHandler:   NewGetFollowerGrowthHandler(relationService, validate),
+	}
+}
+
+func (s *RelationExtGRPCService) GetRelationships(ctx context.Context, req *extpb.GetRelationshipsRequest) (*extpb.GetRelationshipsResponse, error) {
+	return s.getRelationshipsHandler.GetRelationships(ctx, req)
+}
+
+func (s *RelationExtGRPCService) GetMutualFollows(ctx context.Context, req *extpb.GetMutualFollowsRequest) (*extpb.GetMutualFollowsResponse, error) {
+	return s.getMutualFollowsHandler.GetMutualFollows(ctx, req)
+}
+
+func (s *RelationExtGRPCService) IsMutual(ctx context.Context, req *extpb.IsMutualRequest) (*extpb.IsMutualResponse, error) {
+	return s.isMutualHandler.IsMutual(ctx, req)
+}
+
+func (s *RelationExtGRPCService) GetFollowersYouKnow(ctx context.Context, req *extpb.GetFollowersYouKnowRequest) (*extpb.GetFollowersYouKnowResponse, error) {
+	return s.followersYouKnowHandler.GetFollowersYouKnow(ctx, req)
+}
+
+func (s *RelationExtGRPCService) GetSuggestions(ctx context.Context, req *extpb.GetSuggestionsRequest) (*extpb.GetSuggestionsResponse, error) {
+	return s.getSuggestionsHandler.GetSuggestions(ctx, req)
+}
+
+func (s *RelationExtGRPCService) FollowTarget(ctx context.Context, req *extpb.FollowTargetRequest) (*extpb.FollowTargetResponse, error) {
+	return s.followTargetHandler.FollowTarget(ctx, req)
+}
+
+func (s *RelationExtGRPCService) UnfollowTarget(ctx context.Context, req *extpb.UnfollowTargetRequest) (*extpb.UnfollowTargetResponse, error) {
+	return s.unfollowTargetHandler.UnfollowTarget(ctx, req)
+}
+
+func (s *RelationExtGRPCService) ListFollowedTargets(ctx context.Context, req *extpb.ListFollowedTargetsRequest) (*extpb.ListFollowedTargetsResponse, error) {
+	return s.followedTargetsHandler.ListFollowedTargets(ctx, req)
+}
+
+func (s *RelationExtGRPCService) StreamFollowerIDs(req *extpb.StreamFollowerIDsRequest, stream grpc.ServerStreamingServer[extpb.StreamFollowerIDsResponse]) error {
+	return s.followerIDsHandler.StreamFollowerIDs(req, stream)
+}
+
+func (s *RelationExtGRPCService) ListFollowers(ctx context.Context, req *extpb.ListFollowsRequest) (*extpb.ListFollowsResponse, error) {
+	return s.listFollowersHandler.ListFollowers(ctx, req)
+}
+
+func (s *RelationExtGRPCService) ListFollowees(ctx context.Context, req *extpb.ListFollowsRequest) (*extpb.ListFollowsResponse, error) {
+	return s.listFolloweesHandler.ListFollowees(ctx, req)
+}
+
+func (s *RelationExtGRPCService) SearchFollowers(ctx context.Context, req *extpb.SearchFollowsRequest) (*extpb.SearchFollowsResponse, error) {
+	return s.searchFollowersHandler.SearchFollowers(ctx, req)
+}
+
+func (s *RelationExtGRPCService) SearchFollowees(ctx context.Context, req *extpb.SearchFollowsRequest) (*extpb.SearchFollowsResponse, error) {
+	return s.searchFolloweesHandler.SearchFollowees(ctx, req)
+}
+
+func (s *RelationExtGRPCService) GetRelationHistory(ctx context.Context, req *extpb.GetRelationHistoryRequest) (*extpb.GetRelationHistoryResponse, error) {
+	return s.relationHistoryHandler.GetRelationHistory(ctx, req)
+}
+
+func (s *RelationExtGRPCService) RemoveFollower(ctx context.Context, req *extpb.RemoveFollowerRequest) (*extpb.RemoveFollowerResponse, error) {
+	return s.removeFollowerHandler.RemoveFollower(ctx, req)
+}
+
+func (s *RelationExtGRPCService) RemoveFollowers(ctx context.Context, req *extpb.RemoveFollowersRequest) (*extpb.RemoveFollowersResponse, error) {
+	return s.removeFollowersHandler.RemoveFollowers(ctx, req)
+}
+
+func (s *RelationExtGRPCService) BulkFollow(ctx context.Context, req *extpb.BulkFollowRequest) (*extpb.BulkFollowResponse, error) {
+	return s.bulkFollowHandler.BulkFollow(ctx, req)
+}
+
+func (s *RelationExtGRPCService) BulkUnfollow(ctx context.Context, req *extpb.BulkFollowRequest) (*extpb.BulkFollowResponse, error) {
+	return s.bulkUnfollowHandler.BulkUnfollow(ctx, req)
+}
+
+func (s *RelationExtGRPCService) Mute(ctx context.Context, req *extpb.MuteRequest) (*extpb.MuteResponse, error) {
+	return s.muteHandler.Mute(ctx, req)
+}
+
+func (s *RelationExtGRPCService) Unmute(ctx context.Context, req *extpb.UnmuteRequest) (*extpb.UnmuteResponse, error) {
+	return s.unmuteHandler.Unmute(ctx, req)
+}
+
+func (s *RelationExtGRPCService) ListMuted(ctx context.Context, req *extpb.ListMutedRequest) (*extpb.ListMutedResponse, error) {
+	return s.listMutedHandler.ListMuted(ctx, req)
+}
+
+func (s *RelationExtGRPCService) FilterMuted(ctx context.Context, req *extpb.FilterMutedRequest) (*extpb.FilterMutedResponse, error) {
+	return s.filterMutedHandler.FilterMuted(ctx, req)
+}
+
+func (s *RelationExtGRPCService) CreateAudienceList(ctx context.Context, req *extpb.CreateAudienceListRequest) (*extpb.CreateAudienceListResponse, error) {
+	return s.createAudienceHandler.CreateAudienceList(ctx, req)
+}
+
+func (s *RelationExtGRPCService) RenameAudienceList(ctx context.Context, req *extpb.RenameAudienceListRequest) (*extpb.RenameAudienceListResponse, error) {
+	return s.renameAudienceHandler.RenameAudienceList(ctx, req)
+}
+
+func (s *RelationExtGRPCService) DeleteAudienceList(ctx context.Context, req *extpb.DeleteAudienceListRequest) (*extpb.DeleteAudienceListResponse, error) {
+	return s.deleteAudienceHandler.DeleteAudienceList(ctx, req)
+}
+
+func (s *RelationExtGRPCService) ListAudienceLists(ctx context.Context, req *extpb.ListAudienceListsRequest) (*extpb.ListAudienceListsResponse, error) {
+	return s.listAudiencesHandler.ListAudienceLists(ctx, req)
+}
+
+func (s *RelationExtGRPCService) AddAudienceMembers(ctx context.Context, req *extpb.AudienceMembersRequest) (*extpb.AudienceMembersResponse, error) {
+	return s.addMembersHandler.AddAudienceMembers(ctx, req)
+}
+
+func (s *RelationExtGRPCService) RemoveAudienceMembers(ctx context.Context, req *extpb.AudienceMembersRequest) (*extpb.AudienceMembersResponse, error) {
+	return s.removeMembersHandler.RemoveAudienceMembers(ctx, req)
+}
+
+func (s *RelationExtGRPCService) ListAudienceMembers(ctx context.Context, req *extpb.ListAudienceMembersRequest) (*extpb.ListAudienceMembersResponse, error) {
+	return s.listMembersHandler.ListAudienceMembers(ctx, req)
+}
+
+func (s *RelationExtGRPCService) IsAudienceMember(ctx context.Context, req *extpb.IsAudienceMemberRequest) (*extpb.IsAudienceMemberResponse, error) {
+	return s.isMemberHandler.IsAudienceMember(ctx, req)
+}
+
+func (s *RelationExtGRPCService) GetFollowSourceStats(ctx context.Context, req *extpb.GetFollowSourceStatsRequest) (*extpb.GetFollowSourceStatsResponse, error) {
+	return s.followSourceHandler.GetFollowSourceStats(ctx, req)
+}
+
+func (s *RelationExtGRPCService) GetFollowerGrowth(ctx context.Context, req *extpb.GetFollowerGrowthRequest) (*extpb.GetFollowerGrowthResponse, error) {
+	return s.followerGrowthHandler.GetFollowerGrowth(ctx, req)
+}
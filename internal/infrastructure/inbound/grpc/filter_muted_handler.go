@@ -0,0 +1,48 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type MutedFilter interface {
+	FilterMuted(ctx context.Context, viewerID int64, authorIDs []int64) ([]int64, error)
+}
+
+type FilterMutedHandler struct {
+	relationService MutedFilter
+	validate        *validator.Validate
+}
+
+func NewFilterMutedHandler(relationService MutedFilter, validate *validator.Validate) *FilterMutedHandler {
+	return &FilterMutedHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type FilterMutedRequestInternal struct {
+	ViewerID  int64   `validate:"required,gt=0"`
+	AuthorIDs []int64 `validate:"max=500,dive,gt=0"`
+}
+
+func (h *FilterMutedHandler) FilterMuted(ctx context.Context, req *extpb.FilterMutedRequest) (*extpb.FilterMutedResponse, error) {
+	validationReq := &FilterMutedRequestInternal{
+		ViewerID:  req.GetViewerId(),
+		AuthorIDs: req.GetAuthorIds(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	muted, err := h.relationService.FilterMuted(ctx, req.GetViewerId(), req.GetAuthorIds())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.FilterMutedResponse{MutedAuthorIds: muted}, nil
+}
@@ -0,0 +1,92 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestFilterMutedHandler_FilterMuted(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.FilterMutedRequest
+		mockSetup      func(*mocks.FollowService)
+		want           []int64
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "muted authors returned",
+			req:  &extpb.FilterMutedRequest{ViewerId: 1, AuthorIds: []int64{2, 3}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("FilterMuted", context.Background(), int64(1), []int64{2, 3}).Return([]int64{3}, nil)
+			},
+			want: []int64{3},
+		},
+		{
+			name:           "validation error - author ID zero",
+			req:            &extpb.FilterMutedRequest{ViewerId: 1, AuthorIds: []int64{2, 0}},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - too many authors",
+			req:            &extpb.FilterMutedRequest{ViewerId: 1, AuthorIds: make([]int64, 501)},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.FilterMutedRequest{ViewerId: 1, AuthorIds: []int64{2}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("FilterMuted", mock.Anything, int64(1), []int64{2}).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewFilterMutedHandler(mockService, validate)
+			resp, err := handler.FilterMuted(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp.GetMutedAuthorIds())
+		})
+	}
+}
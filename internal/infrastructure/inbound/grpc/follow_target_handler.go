@@ -0,0 +1,65 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type TargetFollower interface {
+	FollowTarget(ctx context.Context, followerID int64, target model.FollowTarget) error
+}
+
+type FollowTargetHandler struct {
+	relationService TargetFollower
+	validate        *validator.Validate
+}
+
+func NewFollowTargetHandler(relationService TargetFollower, validate *validator.Validate) *FollowTargetHandler {
+	return &FollowTargetHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type FollowTargetRequestInternal struct {
+	FollowerID int64            `validate:"required,gt=0"`
+	TargetType model.TargetType `validate:"required,oneof=user board tag"`
+	TargetID   int64            `validate:"required,gt=0"`
+}
+
+func (h *FollowTargetHandler) FollowTarget(ctx context.Context, req *extpb.FollowTargetRequest) (*extpb.FollowTargetResponse, error) {
+	validationReq := &FollowTargetRequestInternal{
+		FollowerID: req.GetFollowerId(),
+		TargetType: targetTypeFromProto(req.GetTargetType()),
+		TargetID:   req.GetTargetId(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	target := model.FollowTarget{Type: validationReq.TargetType, ID: req.GetTargetId()}
+	if err := h.relationService.FollowTarget(ctx, req.GetFollowerId(), target); err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.FollowTargetResponse{}, nil
+}
+
+// targetTypeFromProto maps the proto enum to the domain type, unspecified and unknown values map to ""
+func targetTypeFromProto(targetType extpb.TargetType) model.TargetType {
+	switch targetType {
+	case extpb.TargetType_TARGET_TYPE_USER:
+		return model.TargetTypeUser
+	case extpb.TargetType_TARGET_TYPE_BOARD:
+		return model.TargetTypeBoard
+	case extpb.TargetType_TARGET_TYPE_TAG:
+		return model.TargetTypeTag
+	default:
+		return ""
+	}
+}
@@ -2,14 +2,10 @@ package follow_grpc
 
 import (
 	"context"
-	"errors"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
 
 	"github.com/go-playground/validator/v10"
 	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/relation/v1"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
-	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
 )
 
 type FollowCreator interface {
@@ -41,21 +37,12 @@ func (h *FollowHandler) Follow(ctx context.Context, req *pb.FollowRequest) (*pb.
 	}
 
 	if err := h.validate.Struct(validationReq); err != nil {
-		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+		return nil, errmapper.ValidationError(err)
 	}
 
 	err := h.relationService.Follow(ctx, req.GetFollowerId(), req.GetFolloweeId())
 	if err != nil {
-		switch {
-		case errors.Is(err, custom_errors.ErrSelfFollow):
-			return nil, status.Error(codes.InvalidArgument, custom_errors.ErrSelfFollow.Error())
-		case errors.Is(err, custom_errors.ErrAlreadyFollowing):
-			return nil, status.Error(codes.AlreadyExists, custom_errors.ErrAlreadyFollowing.Error())
-		case errors.Is(err, custom_errors.ErrUserNotFound):
-			return nil, status.Error(codes.NotFound, custom_errors.ErrUserNotFound.Error())
-		default:
-			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
-		}
+		return nil, errmapper.Error(err)
 	}
 
 	return &pb.FollowResponse{}, nil
@@ -3,6 +3,7 @@ package follow_grpc_test
 import (
 	"context"
 	"errors"
+	model "pinstack-relation-service/internal/domain/models"
 	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
 	"testing"
 
@@ -123,7 +124,34 @@ func TestFollowHandler_Follow(t *testing.T) {
 			},
 			wantErr:        true,
 			expectedCode:   codes.Internal,
-			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+			expectedErrMsg: "internal server error",
+		},
+		{
+			name: "caller not allowed to act for follower",
+			req: &pb.FollowRequest{
+				FollowerId: 1,
+				FolloweeId: 2,
+			},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("Follow", mock.Anything, int64(1), int64(2)).Return(custom_errors.ErrForbidden)
+			},
+			wantErr:        true,
+			expectedCode:   codes.PermissionDenied,
+			expectedErrMsg: custom_errors.ErrForbidden.Error(),
+		},
+		{
+			name: "follow limit exceeded",
+			req: &pb.FollowRequest{
+				FollowerId: 1,
+				FolloweeId: 2,
+			},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("Follow", mock.Anything, int64(1), int64(2)).
+					Return(&model.FollowLimitViolation{Rule: model.FollowLimitRuleHourly, Limit: 100, Observed: 100})
+			},
+			wantErr:        true,
+			expectedCode:   codes.ResourceExhausted,
+			expectedErrMsg: "follow limit exceeded: hourly_follows",
 		},
 	}
 
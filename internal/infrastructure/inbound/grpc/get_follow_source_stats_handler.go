@@ -0,0 +1,59 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type FollowSourceStatsGetter interface {
+	GetFollowSourceStats(ctx context.Context, from, to time.Time) ([]model.FollowSourceStat, error)
+}
+
+type GetFollowSourceStatsHandler struct {
+	relationService FollowSourceStatsGetter
+	validate        *validator.Validate
+}
+
+func NewGetFollowSourceStatsHandler(relationService FollowSourceStatsGetter, validate *validator.Validate) *GetFollowSourceStatsHandler {
+	return &GetFollowSourceStatsHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+func (h *GetFollowSourceStatsHandler) GetFollowSourceStats(ctx context.Context, req *extpb.GetFollowSourceStatsRequest) (*extpb.GetFollowSourceStatsResponse, error) {
+	if req.From == nil {
+		return nil, errmapper.InvalidField("from", "is required")
+	}
+	if err := req.From.CheckValid(); err != nil {
+		return nil, errmapper.InvalidField("from", err.Error())
+	}
+	if req.To == nil {
+		return nil, errmapper.InvalidField("to", "is required")
+	}
+	if err := req.To.CheckValid(); err != nil {
+		return nil, errmapper.InvalidField("to", err.Error())
+	}
+
+	stats, err := h.relationService.GetFollowSourceStats(ctx, req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	pbStats := make([]*extpb.FollowSourceStat, 0, len(stats))
+	for _, stat := range stats {
+		pbStats = append(pbStats, &extpb.FollowSourceStat{
+			Day:     timestamppb.New(stat.Day),
+			Source:  string(stat.Source),
+			Follows: stat.Follows,
+		})
+	}
+
+	return &extpb.GetFollowSourceStatsResponse{Stats: pbStats}, nil
+}
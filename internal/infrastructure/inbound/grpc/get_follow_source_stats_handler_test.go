@@ -0,0 +1,124 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestGetFollowSourceStatsHandler_GetFollowSourceStats(t *testing.T) {
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *extpb.GetFollowSourceStatsRequest
+		mockSetup      func(*mocks.FollowService)
+		want           *extpb.GetFollowSourceStatsResponse
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful get",
+			req:  &extpb.GetFollowSourceStatsRequest{From: timestamppb.New(from), To: timestamppb.New(to)},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowSourceStats", context.Background(), from, to).Return([]model.FollowSourceStat{
+					{Day: from, Source: model.FollowSourceSearch, Follows: 12},
+					{Day: from, Source: model.FollowSourceUnknown, Follows: 3},
+				}, nil)
+			},
+			want: &extpb.GetFollowSourceStatsResponse{Stats: []*extpb.FollowSourceStat{
+				{Day: timestamppb.New(from), Source: "search", Follows: 12},
+				{Day: timestamppb.New(from), Source: "unknown", Follows: 3},
+			}},
+		},
+		{
+			name:           "validation error - from missing",
+			req:            &extpb.GetFollowSourceStatsRequest{To: timestamppb.New(to)},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - to out of range",
+			req:            &extpb.GetFollowSourceStatsRequest{From: timestamppb.New(from), To: &timestamppb.Timestamp{Seconds: -62135596801}},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "range too long",
+			req:  &extpb.GetFollowSourceStatsRequest{From: timestamppb.New(from), To: timestamppb.New(to)},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowSourceStats", mock.Anything, from, to).Return(nil, custom_errors.ErrInvalidInput)
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrInvalidInput.Error(),
+		},
+		{
+			name: "not a service account",
+			req:  &extpb.GetFollowSourceStatsRequest{From: timestamppb.New(from), To: timestamppb.New(to)},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowSourceStats", mock.Anything, from, to).Return(nil, custom_errors.ErrForbidden)
+			},
+			wantErr:        true,
+			expectedCode:   codes.PermissionDenied,
+			expectedErrMsg: custom_errors.ErrForbidden.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.GetFollowSourceStatsRequest{From: timestamppb.New(from), To: timestamppb.New(to)},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowSourceStats", mock.Anything, from, to).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewGetFollowSourceStatsHandler(mockService, validate)
+			resp, err := handler.GetFollowSourceStats(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -162,7 +162,7 @@ func TestGetFolloweesHandler_GetFollowees(t *testing.T) {
 			},
 			wantErr:        true,
 			expectedCode:   codes.Internal,
-			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+			expectedErrMsg: "internal server error",
 		},
 	}
 
@@ -0,0 +1,89 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type FollowerGrowthGetter interface {
+	GetFollowerGrowth(ctx context.Context, userID int64, from, to time.Time, granularity model.FollowerGrowthGranularity) ([]model.FollowerGrowthBucket, error)
+}
+
+type GetFollowerGrowthHandler struct {
+	relationService FollowerGrowthGetter
+	validate        *validator.Validate
+}
+
+func NewGetFollowerGrowthHandler(relationService FollowerGrowthGetter, validate *validator.Validate) *GetFollowerGrowthHandler {
+	return &GetFollowerGrowthHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type GetFollowerGrowthRequestInternal struct {
+	UserID      int64  `validate:"required,gt=0"`
+	Granularity string `validate:"required,oneof=day week month"`
+}
+
+func (h *GetFollowerGrowthHandler) GetFollowerGrowth(ctx context.Context, req *extpb.GetFollowerGrowthRequest) (*extpb.GetFollowerGrowthResponse, error) {
+	validationReq := GetFollowerGrowthRequestInternal{
+		UserID:      req.GetUserId(),
+		Granularity: string(followerGrowthGranularityFromProto(req.GetGranularity())),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+	if req.From == nil {
+		return nil, errmapper.InvalidField("from", "is required")
+	}
+	if err := req.From.CheckValid(); err != nil {
+		return nil, errmapper.InvalidField("from", err.Error())
+	}
+	if req.To == nil {
+		return nil, errmapper.InvalidField("to", "is required")
+	}
+	if err := req.To.CheckValid(); err != nil {
+		return nil, errmapper.InvalidField("to", err.Error())
+	}
+
+	buckets, err := h.relationService.GetFollowerGrowth(ctx, validationReq.UserID, req.From.AsTime(), req.To.AsTime(), model.FollowerGrowthGranularity(validationReq.Granularity))
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	pbBuckets := make([]*extpb.FollowerGrowthBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		pbBuckets = append(pbBuckets, &extpb.FollowerGrowthBucket{
+			Start:  timestamppb.New(bucket.Start),
+			Gained: bucket.Gained,
+			Lost:   bucket.Lost,
+			Net:    bucket.Net,
+			Total:  bucket.Total,
+		})
+	}
+
+	return &extpb.GetFollowerGrowthResponse{Buckets: pbBuckets}, nil
+}
+
+// followerGrowthGranularityFromProto maps the proto enum to the domain granularity, unspecified
+// means days and unknown values map to "" so validation rejects them
+func followerGrowthGranularityFromProto(granularity extpb.FollowerGrowthGranularity) model.FollowerGrowthGranularity {
+	switch granularity {
+	case extpb.FollowerGrowthGranularity_FOLLOWER_GROWTH_GRANULARITY_UNSPECIFIED, extpb.FollowerGrowthGranularity_FOLLOWER_GROWTH_GRANULARITY_DAY:
+		return model.FollowerGrowthDaily
+	case extpb.FollowerGrowthGranularity_FOLLOWER_GROWTH_GRANULARITY_WEEK:
+		return model.FollowerGrowthWeekly
+	case extpb.FollowerGrowthGranularity_FOLLOWER_GROWTH_GRANULARITY_MONTH:
+		return model.FollowerGrowthMonthly
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,143 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestGetFollowerGrowthHandler_GetFollowerGrowth(t *testing.T) {
+	from := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *extpb.GetFollowerGrowthRequest
+		mockSetup      func(*mocks.FollowService)
+		want           *extpb.GetFollowerGrowthResponse
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful get by week",
+			req: &extpb.GetFollowerGrowthRequest{
+				UserId:      1,
+				From:        timestamppb.New(from),
+				To:          timestamppb.New(to),
+				Granularity: extpb.FollowerGrowthGranularity_FOLLOWER_GROWTH_GRANULARITY_WEEK,
+			},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowerGrowth", context.Background(), int64(1), from, to, model.FollowerGrowthWeekly).Return([]model.FollowerGrowthBucket{
+					{Start: from, Gained: 5, Lost: 1, Net: 4, Total: 40},
+					{Start: from.AddDate(0, 0, 7), Gained: 2, Lost: 3, Net: -1, Total: 39},
+				}, nil)
+			},
+			want: &extpb.GetFollowerGrowthResponse{Buckets: []*extpb.FollowerGrowthBucket{
+				{Start: timestamppb.New(from), Gained: 5, Lost: 1, Net: 4, Total: 40},
+				{Start: timestamppb.New(from.AddDate(0, 0, 7)), Gained: 2, Lost: 3, Net: -1, Total: 39},
+			}},
+		},
+		{
+			name: "unspecified granularity means days",
+			req:  &extpb.GetFollowerGrowthRequest{UserId: 1, From: timestamppb.New(from), To: timestamppb.New(from)},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowerGrowth", context.Background(), int64(1), from, from, model.FollowerGrowthDaily).Return([]model.FollowerGrowthBucket{
+					{Start: from, Total: 7},
+				}, nil)
+			},
+			want: &extpb.GetFollowerGrowthResponse{Buckets: []*extpb.FollowerGrowthBucket{
+				{Start: timestamppb.New(from), Total: 7},
+			}},
+		},
+		{
+			name:           "validation error - user id missing",
+			req:            &extpb.GetFollowerGrowthRequest{From: timestamppb.New(from), To: timestamppb.New(to)},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "validation error - unknown granularity",
+			req: &extpb.GetFollowerGrowthRequest{
+				UserId:      1,
+				From:        timestamppb.New(from),
+				To:          timestamppb.New(to),
+				Granularity: extpb.FollowerGrowthGranularity(42),
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - to missing",
+			req:            &extpb.GetFollowerGrowthRequest{UserId: 1, From: timestamppb.New(from)},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "forbidden",
+			req:  &extpb.GetFollowerGrowthRequest{UserId: 1, From: timestamppb.New(from), To: timestamppb.New(to)},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowerGrowth", mock.Anything, int64(1), from, to, model.FollowerGrowthDaily).Return(nil, custom_errors.ErrForbidden)
+			},
+			wantErr:        true,
+			expectedCode:   codes.PermissionDenied,
+			expectedErrMsg: custom_errors.ErrForbidden.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.GetFollowerGrowthRequest{UserId: 1, From: timestamppb.New(from), To: timestamppb.New(to)},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowerGrowth", mock.Anything, int64(1), from, to, model.FollowerGrowthDaily).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewGetFollowerGrowthHandler(mockService, validate)
+			resp, err := handler.GetFollowerGrowth(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
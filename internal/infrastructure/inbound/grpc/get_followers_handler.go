@@ -2,15 +2,11 @@ package follow_grpc
 
 import (
 	"context"
-	"errors"
 	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
 
 	"github.com/go-playground/validator/v10"
 	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/relation/v1"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
-	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
 )
 
 type FollowersGetter interface {
@@ -44,19 +40,12 @@ func (h *GetFollowersHandler) GetFollowers(ctx context.Context, req *pb.GetFollo
 	}
 
 	if err := h.validate.Struct(validationReq); err != nil {
-		return nil, status.Error(codes.InvalidArgument, custom_errors.ErrValidationFailed.Error())
+		return nil, errmapper.ValidationError(err)
 	}
 
 	followers, total, err := h.relationService.GetFollowers(ctx, req.GetFolloweeId(), req.GetLimit(), req.GetPage())
 	if err != nil {
-		switch {
-		case errors.Is(err, custom_errors.ErrUserNotFound):
-			return nil, status.Error(codes.NotFound, custom_errors.ErrUserNotFound.Error())
-		case errors.Is(err, custom_errors.ErrDatabaseQuery):
-			return nil, status.Error(codes.Internal, custom_errors.ErrDatabaseQuery.Error())
-		default:
-			return nil, status.Error(codes.Internal, custom_errors.ErrExternalServiceError.Error())
-		}
+		return nil, errmapper.Error(err)
 	}
 
 	pbFollowers := make([]*pb.User, 0, len(followers))
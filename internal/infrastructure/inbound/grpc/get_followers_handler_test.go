@@ -162,7 +162,7 @@ func TestGetFollowersHandler_GetFollowers(t *testing.T) {
 			},
 			wantErr:        true,
 			expectedCode:   codes.Internal,
-			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+			expectedErrMsg: "internal server error",
 		},
 	}
 
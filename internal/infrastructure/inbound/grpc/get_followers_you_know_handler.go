@@ -0,0 +1,54 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type FollowersYouKnowGetter interface {
+	GetFollowersYouKnow(ctx context.Context, viewerID, targetID int64, limit int32) ([]*model.User, int64, error)
+}
+
+type GetFollowersYouKnowHandler struct {
+	relationService FollowersYouKnowGetter
+	validate        *validator.Validate
+}
+
+func NewGetFollowersYouKnowHandler(relationService FollowersYouKnowGetter, validate *validator.Validate) *GetFollowersYouKnowHandler {
+	return &GetFollowersYouKnowHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type GetFollowersYouKnowRequestInternal struct {
+	ViewerID int64 `validate:"required,gt=0"`
+	TargetID int64 `validate:"required,gt=0"`
+	Limit    int32 `validate:"required,gt=0,lte=20"`
+}
+
+func (h *GetFollowersYouKnowHandler) GetFollowersYouKnow(ctx context.Context, req *extpb.GetFollowersYouKnowRequest) (*extpb.GetFollowersYouKnowResponse, error) {
+	validationReq := &GetFollowersYouKnowRequestInternal{
+		ViewerID: req.GetViewerId(),
+		TargetID: req.GetTargetId(),
+		Limit:    req.GetLimit(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	known, total, err := h.relationService.GetFollowersYouKnow(ctx, req.GetViewerId(), req.GetTargetId(), req.GetLimit())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.GetFollowersYouKnowResponse{
+		Users: toExtUsers(known),
+		Total: total,
+	}, nil
+}
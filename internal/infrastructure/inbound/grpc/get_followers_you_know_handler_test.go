@@ -0,0 +1,138 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"pinstack-relation-service/internal/infrastructure/utils"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestGetFollowersYouKnowHandler_GetFollowersYouKnow(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.GetFollowersYouKnowRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedUsers  []*model.User
+		expectedTotal  int64
+	}{
+		{
+			name: "sample with total",
+			req:  &extpb.GetFollowersYouKnowRequest{ViewerId: 1, TargetId: 2, Limit: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowersYouKnow", mock.Anything, int64(1), int64(2), int32(2)).
+					Return([]*model.User{
+						{ID: 3, Username: "alice", AvatarURL: utils.StringPtr("alice.jpg")},
+						{ID: 4, Username: "bob"},
+					}, int64(14), nil)
+			},
+			expectedUsers: []*model.User{
+				{ID: 3, Username: "alice", AvatarURL: utils.StringPtr("alice.jpg")},
+				{ID: 4, Username: "bob"},
+			},
+			expectedTotal: 14,
+		},
+		{
+			name: "nobody in common",
+			req:  &extpb.GetFollowersYouKnowRequest{ViewerId: 1, TargetId: 2, Limit: 3},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowersYouKnow", mock.Anything, int64(1), int64(2), int32(3)).
+					Return([]*model.User{}, int64(0), nil)
+			},
+			expectedUsers: []*model.User{},
+			expectedTotal: 0,
+		},
+		{
+			name:           "validation error - target ID zero",
+			req:            &extpb.GetFollowersYouKnowRequest{ViewerId: 1, Limit: 3},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - limit zero",
+			req:            &extpb.GetFollowersYouKnowRequest{ViewerId: 1, TargetId: 2},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - sample too large",
+			req:            &extpb.GetFollowersYouKnowRequest{ViewerId: 1, TargetId: 2, Limit: 21},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "database query error",
+			req:  &extpb.GetFollowersYouKnowRequest{ViewerId: 1, TargetId: 2, Limit: 3},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowersYouKnow", mock.Anything, int64(1), int64(2), int32(3)).
+					Return(nil, int64(0), custom_errors.ErrDatabaseQuery)
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrDatabaseQuery.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.GetFollowersYouKnowRequest{ViewerId: 1, TargetId: 2, Limit: 3},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetFollowersYouKnow", mock.Anything, int64(1), int64(2), int32(3)).
+					Return(nil, int64(0), errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewGetFollowersYouKnowHandler(mockService, validate)
+			resp, err := handler.GetFollowersYouKnow(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetUsers(), len(tt.expectedUsers))
+			assert.Equal(t, tt.expectedTotal, resp.GetTotal())
+			for i, expectedUser := range tt.expectedUsers {
+				assert.Equal(t, expectedUser.ID, resp.GetUsers()[i].GetUserId())
+				assert.Equal(t, expectedUser.Username, resp.GetUsers()[i].GetUsername())
+				assert.Equal(t, expectedUser.AvatarURL, resp.GetUsers()[i].AvatarUrl)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type MutualFollowsGetter interface {
+	GetMutualFollows(ctx context.Context, userID int64, limit, page int32) ([]*model.User, int64, error)
+}
+
+type GetMutualFollowsHandler struct {
+	relationService MutualFollowsGetter
+	validate        *validator.Validate
+}
+
+func NewGetMutualFollowsHandler(relationService MutualFollowsGetter, validate *validator.Validate) *GetMutualFollowsHandler {
+	return &GetMutualFollowsHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type GetMutualFollowsRequestInternal struct {
+	UserID int64 `validate:"required,gt=0"`
+	Limit  int32 `validate:"required,gt=0,lte=100"`
+	Page   int32 `validate:"required,gte=1"`
+}
+
+func (h *GetMutualFollowsHandler) GetMutualFollows(ctx context.Context, req *extpb.GetMutualFollowsRequest) (*extpb.GetMutualFollowsResponse, error) {
+	validationReq := &GetMutualFollowsRequestInternal{
+		UserID: req.GetUserId(),
+		Limit:  req.GetLimit(),
+		Page:   req.GetPage(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	mutuals, total, err := h.relationService.GetMutualFollows(ctx, req.GetUserId(), req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.GetMutualFollowsResponse{
+		Users: toExtUsers(mutuals),
+		Total: total,
+	}, nil
+}
+
+func toExtUsers(users []*model.User) []*extpb.User {
+	pbUsers := make([]*extpb.User, 0, len(users))
+	for _, user := range users {
+		pbUsers = append(pbUsers, toExtUser(user))
+	}
+	return pbUsers
+}
+
+func toExtUser(user *model.User) *extpb.User {
+	return &extpb.User{
+		UserId:    user.ID,
+		Username:  user.Username,
+		AvatarUrl: user.AvatarURL,
+	}
+}
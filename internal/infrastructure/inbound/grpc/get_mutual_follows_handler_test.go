@@ -0,0 +1,128 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"pinstack-relation-service/internal/infrastructure/utils"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestGetMutualFollowsHandler_GetMutualFollows(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.GetMutualFollowsRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedUsers  []*model.User
+		expectedTotal  int64
+	}{
+		{
+			name: "successful get mutual follows",
+			req:  &extpb.GetMutualFollowsRequest{UserId: 1, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetMutualFollows", mock.Anything, int64(1), int32(10), int32(1)).
+					Return([]*model.User{
+						{ID: 2, Username: "user2", AvatarURL: utils.StringPtr("avatar2.jpg")},
+						{ID: 3, Username: "user3"},
+					}, int64(2), nil)
+			},
+			expectedUsers: []*model.User{
+				{ID: 2, Username: "user2", AvatarURL: utils.StringPtr("avatar2.jpg")},
+				{ID: 3, Username: "user3"},
+			},
+			expectedTotal: 2,
+		},
+		{
+			name:           "validation error - user ID zero",
+			req:            &extpb.GetMutualFollowsRequest{UserId: 0, Limit: 10, Page: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - limit too large",
+			req:            &extpb.GetMutualFollowsRequest{UserId: 1, Limit: 101, Page: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - page zero",
+			req:            &extpb.GetMutualFollowsRequest{UserId: 1, Limit: 10, Page: 0},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "user not found error",
+			req:  &extpb.GetMutualFollowsRequest{UserId: 1, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetMutualFollows", mock.Anything, int64(1), int32(10), int32(1)).
+					Return(nil, int64(0), custom_errors.ErrUserNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: custom_errors.ErrUserNotFound.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.GetMutualFollowsRequest{UserId: 1, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetMutualFollows", mock.Anything, int64(1), int32(10), int32(1)).
+					Return(nil, int64(0), errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewGetMutualFollowsHandler(mockService, validate)
+			resp, err := handler.GetMutualFollows(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetUsers(), len(tt.expectedUsers))
+			assert.Equal(t, tt.expectedTotal, resp.GetTotal())
+			for i, expectedUser := range tt.expectedUsers {
+				assert.Equal(t, expectedUser.ID, resp.GetUsers()[i].GetUserId())
+				assert.Equal(t, expectedUser.Username, resp.GetUsers()[i].GetUsername())
+				assert.Equal(t, expectedUser.AvatarURL, resp.GetUsers()[i].AvatarUrl)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RelationHistoryGetter interface {
+	GetRelationHistory(ctx context.Context, userID int64, otherUserID *int64, limit, page int32) ([]model.RelationHistoryEntry, int64, error)
+}
+
+type GetRelationHistoryHandler struct {
+	relationService RelationHistoryGetter
+	validate        *validator.Validate
+}
+
+func NewGetRelationHistoryHandler(relationService RelationHistoryGetter, validate *validator.Validate) *GetRelationHistoryHandler {
+	return &GetRelationHistoryHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type GetRelationHistoryRequestInternal struct {
+	UserID      int64  `validate:"required,gt=0"`
+	OtherUserID *int64 `validate:"omitempty,gt=0"`
+	Limit       int32  `validate:"required,gt=0,lte=100"`
+	Page        int32  `validate:"required,gte=1"`
+}
+
+func (h *GetRelationHistoryHandler) GetRelationHistory(ctx context.Context, req *extpb.GetRelationHistoryRequest) (*extpb.GetRelationHistoryResponse, error) {
+	validationReq := &GetRelationHistoryRequestInternal{
+		UserID:      req.GetUserId(),
+		OtherUserID: req.OtherUserId,
+		Limit:       req.GetLimit(),
+		Page:        req.GetPage(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	entries, total, err := h.relationService.GetRelationHistory(ctx, req.GetUserId(), req.OtherUserId, req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	pbEntries := make([]*extpb.RelationHistoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		pbEntries = append(pbEntries, &extpb.RelationHistoryEntry{
+			Id:         entry.ID,
+			FollowerId: entry.FollowerID,
+			FolloweeId: entry.FolloweeID,
+			Action:     relationActionToProto(entry.Action),
+			ActorId:    entry.ActorID,
+			Reason:     relationReasonToProto(entry.Reason),
+			CreatedAt:  timestamppb.New(entry.CreatedAt),
+		})
+	}
+
+	return &extpb.GetRelationHistoryResponse{
+		Entries: pbEntries,
+		Total:   total,
+	}, nil
+}
+
+func relationActionToProto(action model.RelationAction) extpb.RelationAction {
+	switch action {
+	case model.RelationActionFollow:
+		return extpb.RelationAction_RELATION_ACTION_FOLLOW
+	case model.RelationActionUnfollow:
+		return extpb.RelationAction_RELATION_ACTION_UNFOLLOW
+	case model.RelationActionBlock:
+		return extpb.RelationAction_RELATION_ACTION_BLOCK
+	case model.RelationActionRemove:
+		return extpb.RelationAction_RELATION_ACTION_REMOVE
+	default:
+		return extpb.RelationAction_RELATION_ACTION_UNSPECIFIED
+	}
+}
+
+func relationReasonToProto(reason model.RelationReason) extpb.RelationReason {
+	switch reason {
+	case model.RelationReasonUser:
+		return extpb.RelationReason_RELATION_REASON_USER
+	case model.RelationReasonAdmin:
+		return extpb.RelationReason_RELATION_REASON_ADMIN
+	case model.RelationReasonCascade:
+		return extpb.RelationReason_RELATION_REASON_CASCADE
+	case model.RelationReasonAntiSpam:
+		return extpb.RelationReason_RELATION_REASON_ANTI_SPAM
+	default:
+		return extpb.RelationReason_RELATION_REASON_UNSPECIFIED
+	}
+}
@@ -0,0 +1,142 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestGetRelationHistoryHandler_GetRelationHistory(t *testing.T) {
+	createdAt := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+	adminID := int64(100)
+
+	tests := []struct {
+		name           string
+		req            *extpb.GetRelationHistoryRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedResp   *extpb.GetRelationHistoryResponse
+	}{
+		{
+			name: "history of a pair",
+			req:  &extpb.GetRelationHistoryRequest{UserId: 1, OtherUserId: proto.Int64(2), Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetRelationHistory", context.Background(), int64(1), proto.Int64(2), int32(10), int32(1)).
+					Return([]model.RelationHistoryEntry{
+						{ID: 5, FollowerID: 2, FolloweeID: 1, Action: model.RelationActionUnfollow, ActorID: &adminID, Reason: model.RelationReasonAdmin, CreatedAt: createdAt},
+					}, int64(1), nil)
+			},
+			expectedResp: &extpb.GetRelationHistoryResponse{
+				Entries: []*extpb.RelationHistoryEntry{{
+					Id:         5,
+					FollowerId: 2,
+					FolloweeId: 1,
+					Action:     extpb.RelationAction_RELATION_ACTION_UNFOLLOW,
+					ActorId:    &adminID,
+					Reason:     extpb.RelationReason_RELATION_REASON_ADMIN,
+				}},
+				Total: 1,
+			},
+		},
+		{
+			name: "history of a user",
+			req:  &extpb.GetRelationHistoryRequest{UserId: 1, Limit: 10, Page: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetRelationHistory", context.Background(), int64(1), (*int64)(nil), int32(10), int32(2)).
+					Return([]model.RelationHistoryEntry{}, int64(3), nil)
+			},
+			expectedResp: &extpb.GetRelationHistoryResponse{Total: 3},
+		},
+		{
+			name:           "validation error - other user ID zero",
+			req:            &extpb.GetRelationHistoryRequest{UserId: 1, OtherUserId: proto.Int64(0), Limit: 10, Page: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - page zero",
+			req:            &extpb.GetRelationHistoryRequest{UserId: 1, Limit: 10},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "forbidden",
+			req:  &extpb.GetRelationHistoryRequest{UserId: 1, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetRelationHistory", mock.Anything, int64(1), mock.Anything, int32(10), int32(1)).
+					Return(nil, int64(0), custom_errors.ErrForbidden)
+			},
+			wantErr:      true,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "generic error",
+			req:  &extpb.GetRelationHistoryRequest{UserId: 1, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetRelationHistory", mock.Anything, int64(1), mock.Anything, int32(10), int32(1)).
+					Return(nil, int64(0), errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewGetRelationHistoryHandler(mockService, validate)
+			resp, err := handler.GetRelationHistory(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResp.GetTotal(), resp.GetTotal())
+			require.Len(t, resp.GetEntries(), len(tt.expectedResp.GetEntries()))
+			for i, expected := range tt.expectedResp.GetEntries() {
+				got := resp.GetEntries()[i]
+				assert.Equal(t, expected.GetId(), got.GetId())
+				assert.Equal(t, expected.GetFollowerId(), got.GetFollowerId())
+				assert.Equal(t, expected.GetFolloweeId(), got.GetFolloweeId())
+				assert.Equal(t, expected.GetAction(), got.GetAction())
+				assert.Equal(t, expected.ActorId, got.ActorId)
+				assert.Equal(t, expected.GetReason(), got.GetReason())
+				assert.True(t, createdAt.Equal(got.GetCreatedAt().AsTime()))
+			}
+		})
+	}
+}
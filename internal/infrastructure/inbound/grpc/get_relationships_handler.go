@@ -0,0 +1,58 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type RelationshipsGetter interface {
+	GetRelationships(ctx context.Context, viewerID int64, targetIDs []int64) ([]model.Relationship, error)
+}
+
+type GetRelationshipsHandler struct {
+	relationService RelationshipsGetter
+	validate        *validator.Validate
+}
+
+func NewGetRelationshipsHandler(relationService RelationshipsGetter, validate *validator.Validate) *GetRelationshipsHandler {
+	return &GetRelationshipsHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type GetRelationshipsRequestInternal struct {
+	ViewerID  int64   `validate:"required,gt=0"`
+	TargetIDs []int64 `validate:"required,min=1,max=1000,dive,gt=0"`
+}
+
+func (h *GetRelationshipsHandler) GetRelationships(ctx context.Context, req *extpb.GetRelationshipsRequest) (*extpb.GetRelationshipsResponse, error) {
+	validationReq := &GetRelationshipsRequestInternal{
+		ViewerID:  req.GetViewerId(),
+		TargetIDs: req.GetTargetIds(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	relationships, err := h.relationService.GetRelationships(ctx, req.GetViewerId(), req.GetTargetIds())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	pbRelationships := make([]*extpb.Relationship, 0, len(relationships))
+	for _, relationship := range relationships {
+		pbRelationships = append(pbRelationships, &extpb.Relationship{
+			TargetId:   relationship.TargetID,
+			Following:  relationship.Following,
+			FollowedBy: relationship.FollowedBy,
+		})
+	}
+
+	return &extpb.GetRelationshipsResponse{Relationships: pbRelationships}, nil
+}
@@ -0,0 +1,169 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestGetRelationshipsHandler_GetRelationships(t *testing.T) {
+	tooManyTargets := make([]int64, model.MaxRelationshipTargets+1)
+	for i := range tooManyTargets {
+		tooManyTargets[i] = int64(i + 1)
+	}
+
+	tests := []struct {
+		name           string
+		req            *extpb.GetRelationshipsRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expected       []*extpb.Relationship
+	}{
+		{
+			name: "successful get relationships",
+			req: &extpb.GetRelationshipsRequest{
+				ViewerId:  1,
+				TargetIds: []int64{2, 3, 4},
+			},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetRelationships", mock.Anything, int64(1), []int64{2, 3, 4}).
+					Return([]model.Relationship{
+						{TargetID: 2, Following: true, FollowedBy: true},
+						{TargetID: 3, Following: true},
+						{TargetID: 4},
+					}, nil)
+			},
+			expected: []*extpb.Relationship{
+				{TargetId: 2, Following: true, FollowedBy: true},
+				{TargetId: 3, Following: true},
+				{TargetId: 4},
+			},
+		},
+		{
+			name: "validation error - viewer ID zero",
+			req: &extpb.GetRelationshipsRequest{
+				ViewerId:  0,
+				TargetIds: []int64{2},
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "validation error - no targets",
+			req: &extpb.GetRelationshipsRequest{
+				ViewerId: 1,
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "validation error - invalid target ID",
+			req: &extpb.GetRelationshipsRequest{
+				ViewerId:  1,
+				TargetIds: []int64{2, -3},
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "max targets accepted",
+			req: &extpb.GetRelationshipsRequest{
+				ViewerId:  1,
+				TargetIds: tooManyTargets[:model.MaxRelationshipTargets],
+			},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetRelationships", mock.Anything, int64(1), tooManyTargets[:model.MaxRelationshipTargets]).
+					Return([]model.Relationship{}, nil)
+			},
+		},
+		{
+			name: "validation error - too many targets",
+			req: &extpb.GetRelationshipsRequest{
+				ViewerId:  1,
+				TargetIds: tooManyTargets,
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "database query error",
+			req: &extpb.GetRelationshipsRequest{
+				ViewerId:  1,
+				TargetIds: []int64{2},
+			},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetRelationships", mock.Anything, int64(1), []int64{2}).
+					Return(nil, custom_errors.ErrDatabaseQuery)
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrDatabaseQuery.Error(),
+		},
+		{
+			name: "generic error",
+			req: &extpb.GetRelationshipsRequest{
+				ViewerId:  1,
+				TargetIds: []int64{2},
+			},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetRelationships", mock.Anything, int64(1), []int64{2}).
+					Return(nil, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewGetRelationshipsHandler(mockService, validate)
+			resp, err := handler.GetRelationships(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetRelationships(), len(tt.expected))
+			for i, expected := range tt.expected {
+				assert.Equal(t, expected.GetTargetId(), resp.GetRelationships()[i].GetTargetId())
+				assert.Equal(t, expected.GetFollowing(), resp.GetRelationships()[i].GetFollowing())
+				assert.Equal(t, expected.GetFollowedBy(), resp.GetRelationships()[i].GetFollowedBy())
+			}
+		})
+	}
+}
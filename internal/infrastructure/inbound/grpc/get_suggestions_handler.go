@@ -0,0 +1,60 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type SuggestionsGetter interface {
+	GetSuggestions(ctx context.Context, userID int64, limit int32) ([]*model.SuggestedUser, error)
+}
+
+type GetSuggestionsHandler struct {
+	relationService SuggestionsGetter
+	validate        *validator.Validate
+}
+
+func NewGetSuggestionsHandler(relationService SuggestionsGetter, validate *validator.Validate) *GetSuggestionsHandler {
+	return &GetSuggestionsHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type GetSuggestionsRequestInternal struct {
+	UserID int64 `validate:"required,gt=0"`
+	Limit  int32 `validate:"required,gt=0,lte=50"`
+}
+
+func (h *GetSuggestionsHandler) GetSuggestions(ctx context.Context, req *extpb.GetSuggestionsRequest) (*extpb.GetSuggestionsResponse, error) {
+	validationReq := &GetSuggestionsRequestInternal{
+		UserID: req.GetUserId(),
+		Limit:  req.GetLimit(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	suggested, err := h.relationService.GetSuggestions(ctx, req.GetUserId(), req.GetLimit())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	suggestions := make([]*extpb.Suggestion, 0, len(suggested))
+	for _, suggestion := range suggested {
+		suggestions = append(suggestions, &extpb.Suggestion{
+			User:        toExtUser(suggestion.User),
+			MutualCount: suggestion.MutualCount,
+			Score:       suggestion.Score,
+		})
+	}
+
+	return &extpb.GetSuggestionsResponse{
+		Suggestions: suggestions,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"pinstack-relation-service/internal/infrastructure/utils"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestGetSuggestionsHandler_GetSuggestions(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.GetSuggestionsRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expected       []*model.SuggestedUser
+	}{
+		{
+			name: "ranked suggestions",
+			req:  &extpb.GetSuggestionsRequest{UserId: 1, Limit: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetSuggestions", mock.Anything, int64(1), int32(2)).
+					Return([]*model.SuggestedUser{
+						{User: &model.User{ID: 3, Username: "alice", AvatarURL: utils.StringPtr("alice.jpg")}, MutualCount: 4, Score: 3.5},
+						{User: &model.User{ID: 4, Username: "bob"}, MutualCount: 1, Score: 0.9},
+					}, nil)
+			},
+			expected: []*model.SuggestedUser{
+				{User: &model.User{ID: 3, Username: "alice", AvatarURL: utils.StringPtr("alice.jpg")}, MutualCount: 4, Score: 3.5},
+				{User: &model.User{ID: 4, Username: "bob"}, MutualCount: 1, Score: 0.9},
+			},
+		},
+		{
+			name: "no suggestions",
+			req:  &extpb.GetSuggestionsRequest{UserId: 1, Limit: 10},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetSuggestions", mock.Anything, int64(1), int32(10)).Return([]*model.SuggestedUser{}, nil)
+			},
+			expected: []*model.SuggestedUser{},
+		},
+		{
+			name:           "validation error - user ID zero",
+			req:            &extpb.GetSuggestionsRequest{Limit: 10},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - limit zero",
+			req:            &extpb.GetSuggestionsRequest{UserId: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - limit too large",
+			req:            &extpb.GetSuggestionsRequest{UserId: 1, Limit: 51},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "user not found",
+			req:  &extpb.GetSuggestionsRequest{UserId: 1, Limit: 10},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetSuggestions", mock.Anything, int64(1), int32(10)).Return(nil, custom_errors.ErrUserNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: custom_errors.ErrUserNotFound.Error(),
+		},
+		{
+			name: "forbidden",
+			req:  &extpb.GetSuggestionsRequest{UserId: 1, Limit: 10},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetSuggestions", mock.Anything, int64(1), int32(10)).Return(nil, custom_errors.ErrForbidden)
+			},
+			wantErr:        true,
+			expectedCode:   codes.PermissionDenied,
+			expectedErrMsg: custom_errors.ErrForbidden.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.GetSuggestionsRequest{UserId: 1, Limit: 10},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("GetSuggestions", mock.Anything, int64(1), int32(10)).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewGetSuggestionsHandler(mockService, validate)
+			resp, err := handler.GetSuggestions(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetSuggestions(), len(tt.expected))
+			for i, expected := range tt.expected {
+				got := resp.GetSuggestions()[i]
+				assert.Equal(t, expected.User.ID, got.GetUser().GetUserId())
+				assert.Equal(t, expected.User.Username, got.GetUser().GetUsername())
+				assert.Equal(t, expected.User.AvatarURL, got.GetUser().AvatarUrl)
+				assert.Equal(t, expected.MutualCount, got.GetMutualCount())
+				assert.Equal(t, expected.Score, got.GetScore())
+			}
+		})
+	}
+}
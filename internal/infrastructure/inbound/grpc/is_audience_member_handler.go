@@ -0,0 +1,50 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type AudienceMembershipChecker interface {
+	IsAudienceMember(ctx context.Context, ownerID, listID, userID int64) (bool, error)
+}
+
+type IsAudienceMemberHandler struct {
+	relationService AudienceMembershipChecker
+	validate        *validator.Validate
+}
+
+func NewIsAudienceMemberHandler(relationService AudienceMembershipChecker, validate *validator.Validate) *IsAudienceMemberHandler {
+	return &IsAudienceMemberHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type IsAudienceMemberRequestInternal struct {
+	OwnerID int64 `validate:"required,gt=0"`
+	ListID  int64 `validate:"required,gt=0"`
+	UserID  int64 `validate:"required,gt=0"`
+}
+
+func (h *IsAudienceMemberHandler) IsAudienceMember(ctx context.Context, req *extpb.IsAudienceMemberRequest) (*extpb.IsAudienceMemberResponse, error) {
+	validationReq := &IsAudienceMemberRequestInternal{
+		OwnerID: req.GetOwnerId(),
+		ListID:  req.GetListId(),
+		UserID:  req.GetUserId(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	isMember, err := h.relationService.IsAudienceMember(ctx, req.GetOwnerId(), req.GetListId(), req.GetUserId())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.IsAudienceMemberResponse{IsMember: isMember}, nil
+}
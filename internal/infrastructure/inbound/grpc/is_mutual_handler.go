@@ -0,0 +1,48 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type MutualChecker interface {
+	IsMutual(ctx context.Context, userID, otherUserID int64) (bool, error)
+}
+
+type IsMutualHandler struct {
+	relationService MutualChecker
+	validate        *validator.Validate
+}
+
+func NewIsMutualHandler(relationService MutualChecker, validate *validator.Validate) *IsMutualHandler {
+	return &IsMutualHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type IsMutualRequestInternal struct {
+	UserID      int64 `validate:"required,gt=0"`
+	OtherUserID int64 `validate:"required,gt=0"`
+}
+
+func (h *IsMutualHandler) IsMutual(ctx context.Context, req *extpb.IsMutualRequest) (*extpb.IsMutualResponse, error) {
+	validationReq := &IsMutualRequestInternal{
+		UserID:      req.GetUserId(),
+		OtherUserID: req.GetOtherUserId(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	mutual, err := h.relationService.IsMutual(ctx, req.GetUserId(), req.GetOtherUserId())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.IsMutualResponse{Mutual: mutual}, nil
+}
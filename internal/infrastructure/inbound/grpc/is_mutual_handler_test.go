@@ -0,0 +1,92 @@
+package follow_grpc_test
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestIsMutualHandler_IsMutual(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.IsMutualRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expected       bool
+	}{
+		{
+			name: "users follow each other",
+			req:  &extpb.IsMutualRequest{UserId: 1, OtherUserId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("IsMutual", mock.Anything, int64(1), int64(2)).Return(true, nil)
+			},
+			expected: true,
+		},
+		{
+			name: "one way follow",
+			req:  &extpb.IsMutualRequest{UserId: 1, OtherUserId: 3},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("IsMutual", mock.Anything, int64(1), int64(3)).Return(false, nil)
+			},
+			expected: false,
+		},
+		{
+			name:           "validation error - other user ID zero",
+			req:            &extpb.IsMutualRequest{UserId: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "database query error",
+			req:  &extpb.IsMutualRequest{UserId: 1, OtherUserId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("IsMutual", mock.Anything, int64(1), int64(2)).Return(false, custom_errors.ErrDatabaseQuery)
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: custom_errors.ErrDatabaseQuery.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewIsMutualHandler(mockService, validate)
+			resp, err := handler.IsMutual(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.GetMutual())
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type AudienceListLister interface {
+	ListAudienceLists(ctx context.Context, ownerID int64) ([]model.AudienceList, error)
+}
+
+type ListAudienceListsHandler struct {
+	relationService AudienceListLister
+	validate        *validator.Validate
+}
+
+func NewListAudienceListsHandler(relationService AudienceListLister, validate *validator.Validate) *ListAudienceListsHandler {
+	return &ListAudienceListsHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type ListAudienceListsRequestInternal struct {
+	OwnerID int64 `validate:"required,gt=0"`
+}
+
+func (h *ListAudienceListsHandler) ListAudienceLists(ctx context.Context, req *extpb.ListAudienceListsRequest) (*extpb.ListAudienceListsResponse, error) {
+	validationReq := &ListAudienceListsRequestInternal{
+		OwnerID: req.GetOwnerId(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	lists, err := h.relationService.ListAudienceLists(ctx, req.GetOwnerId())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	pbLists := make([]*extpb.AudienceList, 0, len(lists))
+	for _, list := range lists {
+		pbLists = append(pbLists, audienceListToProto(list))
+	}
+
+	return &extpb.ListAudienceListsResponse{Lists: pbLists}, nil
+}
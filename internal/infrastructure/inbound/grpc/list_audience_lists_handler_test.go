@@ -0,0 +1,100 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestListAudienceListsHandler_ListAudienceLists(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	list := model.AudienceList{ID: 7, OwnerID: 1, Name: "Close friends", MemberCount: 2, CreatedAt: createdAt, UpdatedAt: createdAt}
+	pbList := &extpb.AudienceList{Id: 7, OwnerId: 1, Name: "Close friends", MemberCount: 2, CreatedAt: timestamppb.New(createdAt), UpdatedAt: timestamppb.New(createdAt)}
+
+	tests := []struct {
+		name           string
+		req            *extpb.ListAudienceListsRequest
+		mockSetup      func(*mocks.FollowService)
+		want           *extpb.ListAudienceListsResponse
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful list",
+			req:  &extpb.ListAudienceListsRequest{OwnerId: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListAudienceLists", context.Background(), int64(1)).Return([]model.AudienceList{list}, nil)
+			},
+			want: &extpb.ListAudienceListsResponse{Lists: []*extpb.AudienceList{pbList}},
+		},
+		{
+			name: "no lists",
+			req:  &extpb.ListAudienceListsRequest{OwnerId: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListAudienceLists", context.Background(), int64(1)).Return([]model.AudienceList{}, nil)
+			},
+			want: &extpb.ListAudienceListsResponse{Lists: []*extpb.AudienceList{}},
+		},
+		{
+			name:           "validation error - owner ID zero",
+			req:            &extpb.ListAudienceListsRequest{},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.ListAudienceListsRequest{OwnerId: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListAudienceLists", mock.Anything, int64(1)).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewListAudienceListsHandler(mockService, validate)
+			resp, err := handler.ListAudienceLists(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type AudienceMemberLister interface {
+	ListAudienceMembers(ctx context.Context, ownerID, listID int64, limit, page int32) ([]int64, int64, error)
+}
+
+type ListAudienceMembersHandler struct {
+	relationService AudienceMemberLister
+	validate        *validator.Validate
+}
+
+func NewListAudienceMembersHandler(relationService AudienceMemberLister, validate *validator.Validate) *ListAudienceMembersHandler {
+	return &ListAudienceMembersHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type ListAudienceMembersRequestInternal struct {
+	OwnerID int64 `validate:"required,gt=0"`
+	ListID  int64 `validate:"required,gt=0"`
+	Limit   int32 `validate:"required,gt=0,lte=100"`
+	Page    int32 `validate:"required,gte=1"`
+}
+
+func (h *ListAudienceMembersHandler) ListAudienceMembers(ctx context.Context, req *extpb.ListAudienceMembersRequest) (*extpb.ListAudienceMembersResponse, error) {
+	validationReq := &ListAudienceMembersRequestInternal{
+		OwnerID: req.GetOwnerId(),
+		ListID:  req.GetListId(),
+		Limit:   req.GetLimit(),
+		Page:    req.GetPage(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	memberIDs, total, err := h.relationService.ListAudienceMembers(ctx, req.GetOwnerId(), req.GetListId(), req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.ListAudienceMembersResponse{
+		MemberIds: memberIDs,
+		Total:     total,
+	}, nil
+}
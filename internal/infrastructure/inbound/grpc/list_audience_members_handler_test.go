@@ -0,0 +1,96 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestListAudienceMembersHandler_ListAudienceMembers(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.ListAudienceMembersRequest
+		mockSetup      func(*mocks.FollowService)
+		want           *extpb.ListAudienceMembersResponse
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful list",
+			req:  &extpb.ListAudienceMembersRequest{OwnerId: 1, ListId: 7, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListAudienceMembers", context.Background(), int64(1), int64(7), int32(10), int32(1)).Return([]int64{3, 2}, int64(2), nil)
+			},
+			want: &extpb.ListAudienceMembersResponse{MemberIds: []int64{3, 2}, Total: 2},
+		},
+		{
+			name:           "validation error - limit too high",
+			req:            &extpb.ListAudienceMembersRequest{OwnerId: 1, ListId: 7, Limit: 101, Page: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "list not found",
+			req:  &extpb.ListAudienceMembersRequest{OwnerId: 1, ListId: 7, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListAudienceMembers", mock.Anything, int64(1), int64(7), int32(10), int32(1)).Return(nil, int64(0), model.ErrAudienceListNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: model.ErrAudienceListNotFound.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.ListAudienceMembersRequest{OwnerId: 1, ListId: 7, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListAudienceMembers", mock.Anything, int64(1), int64(7), int32(10), int32(1)).Return(nil, int64(0), errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewListAudienceMembersHandler(mockService, validate)
+			resp, err := handler.ListAudienceMembers(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
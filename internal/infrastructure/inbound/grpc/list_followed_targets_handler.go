@@ -0,0 +1,56 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type FollowedTargetsLister interface {
+	ListFollowedTargets(ctx context.Context, followerID int64, targetType model.TargetType, limit, page int32) ([]int64, int64, error)
+}
+
+type ListFollowedTargetsHandler struct {
+	relationService FollowedTargetsLister
+	validate        *validator.Validate
+}
+
+func NewListFollowedTargetsHandler(relationService FollowedTargetsLister, validate *validator.Validate) *ListFollowedTargetsHandler {
+	return &ListFollowedTargetsHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type ListFollowedTargetsRequestInternal struct {
+	FollowerID int64            `validate:"required,gt=0"`
+	TargetType model.TargetType `validate:"required,oneof=user board tag"`
+	Limit      int32            `validate:"required,gt=0,lte=100"`
+	Page       int32            `validate:"required,gte=1"`
+}
+
+func (h *ListFollowedTargetsHandler) ListFollowedTargets(ctx context.Context, req *extpb.ListFollowedTargetsRequest) (*extpb.ListFollowedTargetsResponse, error) {
+	validationReq := &ListFollowedTargetsRequestInternal{
+		FollowerID: req.GetFollowerId(),
+		TargetType: targetTypeFromProto(req.GetTargetType()),
+		Limit:      req.GetLimit(),
+		Page:       req.GetPage(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	targetIDs, total, err := h.relationService.ListFollowedTargets(ctx, req.GetFollowerId(), validationReq.TargetType, req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.ListFollowedTargetsResponse{
+		TargetIds: targetIDs,
+		Total:     total,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestListFollowedTargetsHandler_ListFollowedTargets(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.ListFollowedTargetsRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedIDs    []int64
+		expectedTotal  int64
+	}{
+		{
+			name: "successful list followed boards",
+			req:  &extpb.ListFollowedTargetsRequest{FollowerId: 1, TargetType: extpb.TargetType_TARGET_TYPE_BOARD, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListFollowedTargets", mock.Anything, int64(1), model.TargetTypeBoard, int32(10), int32(1)).
+					Return([]int64{9, 7}, int64(2), nil)
+			},
+			expectedIDs:   []int64{9, 7},
+			expectedTotal: 2,
+		},
+		{
+			name:           "validation error - unspecified target type",
+			req:            &extpb.ListFollowedTargetsRequest{FollowerId: 1, Limit: 10, Page: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - limit too large",
+			req:            &extpb.ListFollowedTargetsRequest{FollowerId: 1, TargetType: extpb.TargetType_TARGET_TYPE_TAG, Limit: 101, Page: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - page zero",
+			req:            &extpb.ListFollowedTargetsRequest{FollowerId: 1, TargetType: extpb.TargetType_TARGET_TYPE_TAG, Limit: 10, Page: 0},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.ListFollowedTargetsRequest{FollowerId: 1, TargetType: extpb.TargetType_TARGET_TYPE_TAG, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListFollowedTargets", mock.Anything, int64(1), model.TargetTypeTag, int32(10), int32(1)).
+					Return(nil, int64(0), errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewListFollowedTargetsHandler(mockService, validate)
+			resp, err := handler.ListFollowedTargets(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedIDs, resp.GetTargetIds())
+			assert.Equal(t, tt.expectedTotal, resp.GetTotal())
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type FolloweesLister interface {
+	ListFollowees(ctx context.Context, followerID int64, opts model.FollowListOptions, limit, page int32) (*model.FollowList, error)
+}
+
+type ListFolloweesHandler struct {
+	relationService FolloweesLister
+	validate        *validator.Validate
+}
+
+func NewListFolloweesHandler(relationService FolloweesLister, validate *validator.Validate) *ListFolloweesHandler {
+	return &ListFolloweesHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+func (h *ListFolloweesHandler) ListFollowees(ctx context.Context, req *extpb.ListFollowsRequest) (*extpb.ListFollowsResponse, error) {
+	opts, err := validateListFollowsRequest(h.validate, req)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := h.relationService.ListFollowees(ctx, req.GetUserId(), opts, req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return toListFollowsResponse(list), nil
+}
@@ -0,0 +1,52 @@
+package follow_grpc_test
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestListFolloweesHandler_ListFollowees(t *testing.T) {
+	t.Run("successful ids only list", func(t *testing.T) {
+		mockService := mocks.NewFollowService(t)
+		mockService.On("ListFollowees", context.Background(), int64(1), model.FollowListOptions{Sort: model.FollowListSortNewest, IDsOnly: true}, int32(10), int32(1)).
+			Return(&model.FollowList{UserIDs: []int64{5}, Total: 1}, nil)
+
+		handler := follow_grpc.NewListFolloweesHandler(mockService, validator.New())
+		resp, err := handler.ListFollowees(context.Background(), &extpb.ListFollowsRequest{
+			UserId:  1,
+			Limit:   10,
+			Page:    1,
+			Options: &extpb.FollowListOptions{IdsOnly: true},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{5}, resp.GetUserIds())
+		assert.Empty(t, resp.GetUsers())
+		assert.Equal(t, int64(1), resp.GetTotal())
+	})
+
+	t.Run("validation error - page zero", func(t *testing.T) {
+		handler := follow_grpc.NewListFolloweesHandler(mocks.NewFollowService(t), validator.New())
+		resp, err := handler.ListFollowees(context.Background(), &extpb.ListFollowsRequest{UserId: 1, Limit: 10})
+
+		require.Error(t, err)
+		statusErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+		assert.Contains(t, statusErr.Message(), custom_errors.ErrValidationFailed.Error())
+		assert.Nil(t, resp)
+	})
+}
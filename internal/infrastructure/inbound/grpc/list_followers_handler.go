@@ -0,0 +1,98 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type FollowersLister interface {
+	ListFollowers(ctx context.Context, followeeID int64, opts model.FollowListOptions, limit, page int32) (*model.FollowList, error)
+}
+
+type ListFollowersHandler struct {
+	relationService FollowersLister
+	validate        *validator.Validate
+}
+
+func NewListFollowersHandler(relationService FollowersLister, validate *validator.Validate) *ListFollowersHandler {
+	return &ListFollowersHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type ListFollowsRequestInternal struct {
+	UserID int64                `validate:"required,gt=0"`
+	Limit  int32                `validate:"required,gt=0,lte=100"`
+	Page   int32                `validate:"required,gte=1"`
+	Sort   model.FollowListSort `validate:"required,oneof=newest oldest"`
+}
+
+func (h *ListFollowersHandler) ListFollowers(ctx context.Context, req *extpb.ListFollowsRequest) (*extpb.ListFollowsResponse, error) {
+	opts, err := validateListFollowsRequest(h.validate, req)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := h.relationService.ListFollowers(ctx, req.GetUserId(), opts, req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return toListFollowsResponse(list), nil
+}
+
+// validateListFollowsRequest validates a ListFollowers or ListFollowees request and converts its options
+func validateListFollowsRequest(validate *validator.Validate, req *extpb.ListFollowsRequest) (model.FollowListOptions, error) {
+	options := req.GetOptions()
+	opts := model.FollowListOptions{
+		Sort:       followListSortFromProto(options.GetSort()),
+		MutualOnly: options.GetMutualOnly(),
+		IDsOnly:    options.GetIdsOnly(),
+	}
+	if options.GetSince() != nil {
+		since := options.GetSince().AsTime()
+		opts.Since = &since
+	}
+	if options.GetUntil() != nil {
+		until := options.GetUntil().AsTime()
+		opts.Until = &until
+	}
+
+	validationReq := &ListFollowsRequestInternal{
+		UserID: req.GetUserId(),
+		Limit:  req.GetLimit(),
+		Page:   req.GetPage(),
+		Sort:   opts.Sort,
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		return model.FollowListOptions{}, errmapper.ValidationError(err)
+	}
+
+	return opts, nil
+}
+
+// followListSortFromProto maps the proto enum to the domain sort, unspecified means newest first
+// and unknown values map to "" so validation rejects them
+func followListSortFromProto(sort extpb.FollowListSort) model.FollowListSort {
+	switch sort {
+	case extpb.FollowListSort_FOLLOW_LIST_SORT_UNSPECIFIED, extpb.FollowListSort_FOLLOW_LIST_SORT_NEWEST:
+		return model.FollowListSortNewest
+	case extpb.FollowListSort_FOLLOW_LIST_SORT_OLDEST:
+		return model.FollowListSortOldest
+	default:
+		return ""
+	}
+}
+
+func toListFollowsResponse(list *model.FollowList) *extpb.ListFollowsResponse {
+	if list.Users == nil {
+		return &extpb.ListFollowsResponse{UserIds: list.UserIDs, Total: list.Total}
+	}
+	return &extpb.ListFollowsResponse{Users: toExtUsers(list.Users), Total: list.Total}
+}
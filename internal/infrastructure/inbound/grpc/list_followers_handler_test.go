@@ -0,0 +1,140 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestListFollowersHandler_ListFollowers(t *testing.T) {
+	since := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *extpb.ListFollowsRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedResp   *extpb.ListFollowsResponse
+	}{
+		{
+			name: "default options list users newest first",
+			req:  &extpb.ListFollowsRequest{UserId: 1, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListFollowers", mock.Anything, int64(1), model.FollowListOptions{Sort: model.FollowListSortNewest}, int32(10), int32(1)).
+					Return(&model.FollowList{UserIDs: []int64{2}, Users: []*model.User{{ID: 2, Username: "user2"}}, Total: 1}, nil)
+			},
+			expectedResp: &extpb.ListFollowsResponse{Users: []*extpb.User{{UserId: 2, Username: "user2"}}, Total: 1},
+		},
+		{
+			name: "ids only mutual followers since a time",
+			req: &extpb.ListFollowsRequest{UserId: 1, Limit: 10, Page: 1, Options: &extpb.FollowListOptions{
+				Sort:       extpb.FollowListSort_FOLLOW_LIST_SORT_OLDEST,
+				Since:      timestamppb.New(since),
+				MutualOnly: true,
+				IdsOnly:    true,
+			}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListFollowers", mock.Anything, int64(1), mock.MatchedBy(func(opts model.FollowListOptions) bool {
+					return opts.Sort == model.FollowListSortOldest &&
+						opts.Since != nil && opts.Since.Equal(since) &&
+						opts.Until == nil && opts.MutualOnly && opts.IDsOnly
+				}), int32(10), int32(1)).
+					Return(&model.FollowList{UserIDs: []int64{2, 3}, Total: 2}, nil)
+			},
+			expectedResp: &extpb.ListFollowsResponse{UserIds: []int64{2, 3}, Total: 2},
+		},
+		{
+			name:           "validation error - unknown sort",
+			req:            &extpb.ListFollowsRequest{UserId: 1, Limit: 10, Page: 1, Options: &extpb.FollowListOptions{Sort: extpb.FollowListSort(9)}},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - limit too large",
+			req:            &extpb.ListFollowsRequest{UserId: 1, Limit: 101, Page: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - user ID zero",
+			req:            &extpb.ListFollowsRequest{UserId: 0, Limit: 10, Page: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "invalid time window error",
+			req:  &extpb.ListFollowsRequest{UserId: 1, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListFollowers", mock.Anything, int64(1), mock.Anything, int32(10), int32(1)).
+					Return(nil, custom_errors.ErrInvalidInput)
+			},
+			wantErr:      true,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "generic error",
+			req:  &extpb.ListFollowsRequest{UserId: 1, Limit: 10, Page: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("ListFollowers", mock.Anything, int64(1), mock.Anything, int32(10), int32(1)).
+					Return(nil, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewListFollowersHandler(mockService, validate)
+			resp, err := handler.ListFollowers(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResp.GetTotal(), resp.GetTotal())
+			assert.Equal(t, tt.expectedResp.GetUserIds(), resp.GetUserIds())
+			require.Len(t, resp.GetUsers(), len(tt.expectedResp.GetUsers()))
+			for i, expectedUser := range tt.expectedResp.GetUsers() {
+				assert.Equal(t, expectedUser.GetUserId(), resp.GetUsers()[i].GetUserId())
+				assert.Equal(t, expectedUser.GetUsername(), resp.GetUsers()[i].GetUsername())
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type MutedLister interface {
+	ListMuted(ctx context.Context, followerID int64, limit, page int32) ([]model.Mute, int64, error)
+}
+
+type ListMutedHandler struct {
+	relationService MutedLister
+	validate        *validator.Validate
+}
+
+func NewListMutedHandler(relationService MutedLister, validate *validator.Validate) *ListMutedHandler {
+	return &ListMutedHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type ListMutedRequestInternal struct {
+	UserID int64 `validate:"required,gt=0"`
+	Limit  int32 `validate:"required,gt=0,lte=100"`
+	Page   int32 `validate:"required,gte=1"`
+}
+
+func (h *ListMutedHandler) ListMuted(ctx context.Context, req *extpb.ListMutedRequest) (*extpb.ListMutedResponse, error) {
+	validationReq := &ListMutedRequestInternal{
+		UserID: req.GetUserId(),
+		Limit:  req.GetLimit(),
+		Page:   req.GetPage(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	mutes, total, err := h.relationService.ListMuted(ctx, req.GetUserId(), req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	pbMutes := make([]*extpb.Mute, 0, len(mutes))
+	for _, mute := range mutes {
+		pbMutes = append(pbMutes, muteToProto(mute))
+	}
+
+	return &extpb.ListMutedResponse{
+		Mutes: pbMutes,
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Muter interface {
+	Mute(ctx context.Context, followerID, followeeID int64, expiresAt *time.Time) (model.Mute, error)
+}
+
+type MuteHandler struct {
+	relationService Muter
+	validate        *validator.Validate
+}
+
+func NewMuteHandler(relationService Muter, validate *validator.Validate) *MuteHandler {
+	return &MuteHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type MuteRequestInternal struct {
+	FollowerID int64 `validate:"required,gt=0"`
+	FolloweeID int64 `validate:"required,gt=0"`
+}
+
+func (h *MuteHandler) Mute(ctx context.Context, req *extpb.MuteRequest) (*extpb.MuteResponse, error) {
+	validationReq := &MuteRequestInternal{
+		FollowerID: req.GetFollowerId(),
+		FolloweeID: req.GetFolloweeId(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		if err := req.ExpiresAt.CheckValid(); err != nil {
+			return nil, errmapper.InvalidField("expires_at", err.Error())
+		}
+		t := req.ExpiresAt.AsTime()
+		expiresAt = &t
+	}
+
+	mute, err := h.relationService.Mute(ctx, req.GetFollowerId(), req.GetFolloweeId(), expiresAt)
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.MuteResponse{Mute: muteToProto(mute)}, nil
+}
+
+func muteToProto(mute model.Mute) *extpb.Mute {
+	pbMute := &extpb.Mute{
+		FollowerId: mute.FollowerID,
+		FolloweeId: mute.FolloweeID,
+		CreatedAt:  timestamppb.New(mute.CreatedAt),
+	}
+	if mute.ExpiresAt != nil {
+		pbMute.ExpiresAt = timestamppb.New(*mute.ExpiresAt)
+	}
+	return pbMute
+}
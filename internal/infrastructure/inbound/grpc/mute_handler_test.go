@@ -0,0 +1,119 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestMuteHandler_Mute(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2025, 6, 8, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		req            *extpb.MuteRequest
+		mockSetup      func(*mocks.FollowService)
+		want           *extpb.MuteResponse
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "mute until unmuted",
+			req:  &extpb.MuteRequest{FollowerId: 1, FolloweeId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("Mute", context.Background(), int64(1), int64(2), (*time.Time)(nil)).
+					Return(model.Mute{FollowerID: 1, FolloweeID: 2, CreatedAt: createdAt}, nil)
+			},
+			want: &extpb.MuteResponse{Mute: &extpb.Mute{FollowerId: 1, FolloweeId: 2, CreatedAt: timestamppb.New(createdAt)}},
+		},
+		{
+			name: "mute with expiry",
+			req:  &extpb.MuteRequest{FollowerId: 1, FolloweeId: 2, ExpiresAt: timestamppb.New(expiresAt)},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("Mute", context.Background(), int64(1), int64(2), mock.MatchedBy(func(got *time.Time) bool {
+					return got != nil && got.Equal(expiresAt)
+				})).Return(model.Mute{FollowerID: 1, FolloweeID: 2, CreatedAt: createdAt, ExpiresAt: &expiresAt}, nil)
+			},
+			want: &extpb.MuteResponse{Mute: &extpb.Mute{FollowerId: 1, FolloweeId: 2, CreatedAt: timestamppb.New(createdAt), ExpiresAt: timestamppb.New(expiresAt)}},
+		},
+		{
+			name:           "validation error - followee ID zero",
+			req:            &extpb.MuteRequest{FollowerId: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "malformed expiry",
+			req:            &extpb.MuteRequest{FollowerId: 1, FolloweeId: 2, ExpiresAt: &timestamppb.Timestamp{Nanos: -1}},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "user not found",
+			req:  &extpb.MuteRequest{FollowerId: 1, FolloweeId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("Mute", mock.Anything, int64(1), int64(2), mock.Anything).Return(model.Mute{}, custom_errors.ErrUserNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: custom_errors.ErrUserNotFound.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.MuteRequest{FollowerId: 1, FolloweeId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("Mute", mock.Anything, int64(1), int64(2), mock.Anything).Return(model.Mute{}, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewMuteHandler(mockService, validate)
+			resp, err := handler.Mute(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type AudienceMemberRemover interface {
+	RemoveAudienceMembers(ctx context.Context, ownerID, listID int64, memberIDs []int64) ([]int64, error)
+}
+
+type RemoveAudienceMembersHandler struct {
+	relationService AudienceMemberRemover
+	validate        *validator.Validate
+}
+
+func NewRemoveAudienceMembersHandler(relationService AudienceMemberRemover, validate *validator.Validate) *RemoveAudienceMembersHandler {
+	return &RemoveAudienceMembersHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+func (h *RemoveAudienceMembersHandler) RemoveAudienceMembers(ctx context.Context, req *extpb.AudienceMembersRequest) (*extpb.AudienceMembersResponse, error) {
+	validationReq := &AudienceMembersRequestInternal{
+		OwnerID:   req.GetOwnerId(),
+		ListID:    req.GetListId(),
+		MemberIDs: req.GetMemberIds(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	removed, err := h.relationService.RemoveAudienceMembers(ctx, req.GetOwnerId(), req.GetListId(), req.GetMemberIds())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.AudienceMembersResponse{ChangedMemberIds: removed}, nil
+}
@@ -0,0 +1,96 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestRemoveAudienceMembersHandler_RemoveAudienceMembers(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.AudienceMembersRequest
+		mockSetup      func(*mocks.FollowService)
+		want           *extpb.AudienceMembersResponse
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful remove",
+			req:  &extpb.AudienceMembersRequest{OwnerId: 1, ListId: 7, MemberIds: []int64{2, 3}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("RemoveAudienceMembers", context.Background(), int64(1), int64(7), []int64{2, 3}).Return([]int64{2}, nil)
+			},
+			want: &extpb.AudienceMembersResponse{ChangedMemberIds: []int64{2}},
+		},
+		{
+			name:           "validation error - member ID zero",
+			req:            &extpb.AudienceMembersRequest{OwnerId: 1, ListId: 7, MemberIds: []int64{0}},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "list not found",
+			req:  &extpb.AudienceMembersRequest{OwnerId: 1, ListId: 7, MemberIds: []int64{2, 3}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("RemoveAudienceMembers", mock.Anything, int64(1), int64(7), []int64{2, 3}).Return(nil, model.ErrAudienceListNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: model.ErrAudienceListNotFound.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.AudienceMembersRequest{OwnerId: 1, ListId: 7, MemberIds: []int64{2, 3}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("RemoveAudienceMembers", mock.Anything, int64(1), int64(7), []int64{2, 3}).Return(nil, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewRemoveAudienceMembersHandler(mockService, validate)
+			resp, err := handler.RemoveAudienceMembers(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, resp)
+		})
+	}
+}
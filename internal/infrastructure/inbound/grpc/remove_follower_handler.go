@@ -0,0 +1,47 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type FollowerRemover interface {
+	RemoveFollower(ctx context.Context, ownerID, followerID int64) error
+}
+
+type RemoveFollowerHandler struct {
+	relationService FollowerRemover
+	validate        *validator.Validate
+}
+
+func NewRemoveFollowerHandler(relationService FollowerRemover, validate *validator.Validate) *RemoveFollowerHandler {
+	return &RemoveFollowerHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type RemoveFollowerRequestInternal struct {
+	OwnerID    int64 `validate:"required,gt=0"`
+	FollowerID int64 `validate:"required,gt=0"`
+}
+
+func (h *RemoveFollowerHandler) RemoveFollower(ctx context.Context, req *extpb.RemoveFollowerRequest) (*extpb.RemoveFollowerResponse, error) {
+	validationReq := &RemoveFollowerRequestInternal{
+		OwnerID:    req.GetOwnerId(),
+		FollowerID: req.GetFollowerId(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	if err := h.relationService.RemoveFollower(ctx, req.GetOwnerId(), req.GetFollowerId()); err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.RemoveFollowerResponse{}, nil
+}
@@ -0,0 +1,103 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestRemoveFollowerHandler_RemoveFollower(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.RemoveFollowerRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful removal",
+			req:  &extpb.RemoveFollowerRequest{OwnerId: 1, FollowerId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("RemoveFollower", context.Background(), int64(1), int64(2)).Return(nil)
+			},
+		},
+		{
+			name:           "validation error - follower ID zero",
+			req:            &extpb.RemoveFollowerRequest{OwnerId: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "self removal error",
+			req:  &extpb.RemoveFollowerRequest{OwnerId: 1, FollowerId: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("RemoveFollower", mock.Anything, int64(1), int64(1)).Return(custom_errors.ErrSelfUnfollow)
+			},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrSelfUnfollow.Error(),
+		},
+		{
+			name: "not a follower error",
+			req:  &extpb.RemoveFollowerRequest{OwnerId: 1, FollowerId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("RemoveFollower", mock.Anything, int64(1), int64(2)).Return(custom_errors.ErrFollowRelationNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: custom_errors.ErrFollowRelationNotFound.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.RemoveFollowerRequest{OwnerId: 1, FollowerId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("RemoveFollower", mock.Anything, int64(1), int64(2)).Return(errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewRemoveFollowerHandler(mockService, validate)
+			resp, err := handler.RemoveFollower(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type FollowersRemover interface {
+	RemoveFollowers(ctx context.Context, ownerID int64, followerIDs []int64) ([]int64, error)
+}
+
+type RemoveFollowersHandler struct {
+	relationService FollowersRemover
+	validate        *validator.Validate
+}
+
+func NewRemoveFollowersHandler(relationService FollowersRemover, validate *validator.Validate) *RemoveFollowersHandler {
+	return &RemoveFollowersHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type RemoveFollowersRequestInternal struct {
+	OwnerID     int64   `validate:"required,gt=0"`
+	FollowerIDs []int64 `validate:"required,min=1,max=100,dive,gt=0"`
+}
+
+func (h *RemoveFollowersHandler) RemoveFollowers(ctx context.Context, req *extpb.RemoveFollowersRequest) (*extpb.RemoveFollowersResponse, error) {
+	validationReq := &RemoveFollowersRequestInternal{
+		OwnerID:     req.GetOwnerId(),
+		FollowerIDs: req.GetFollowerIds(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	removed, err := h.relationService.RemoveFollowers(ctx, req.GetOwnerId(), req.GetFollowerIds())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.RemoveFollowersResponse{RemovedFollowerIds: removed}, nil
+}
@@ -0,0 +1,102 @@
+package follow_grpc_test
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestRemoveFollowersHandler_RemoveFollowers(t *testing.T) {
+	tooMany := make([]int64, 101)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 2)
+	}
+
+	tests := []struct {
+		name           string
+		req            *extpb.RemoveFollowersRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedIDs    []int64
+	}{
+		{
+			name: "successful bulk removal",
+			req:  &extpb.RemoveFollowersRequest{OwnerId: 1, FollowerIds: []int64{2, 3, 4}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("RemoveFollowers", context.Background(), int64(1), []int64{2, 3, 4}).Return([]int64{2, 4}, nil)
+			},
+			expectedIDs: []int64{2, 4},
+		},
+		{
+			name:           "validation error - empty follower list",
+			req:            &extpb.RemoveFollowersRequest{OwnerId: 1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - too many followers",
+			req:            &extpb.RemoveFollowersRequest{OwnerId: 1, FollowerIds: tooMany},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - follower ID zero",
+			req:            &extpb.RemoveFollowersRequest{OwnerId: 1, FollowerIds: []int64{2, 0}},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "forbidden",
+			req:  &extpb.RemoveFollowersRequest{OwnerId: 1, FollowerIds: []int64{2}},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("RemoveFollowers", mock.Anything, int64(1), []int64{2}).Return(nil, custom_errors.ErrForbidden)
+			},
+			wantErr:      true,
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewRemoveFollowersHandler(mockService, validate)
+			resp, err := handler.RemoveFollowers(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedIDs, resp.GetRemovedFollowerIds())
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type AudienceListRenamer interface {
+	RenameAudienceList(ctx context.Context, ownerID, listID int64, name string) (model.AudienceList, error)
+}
+
+type RenameAudienceListHandler struct {
+	relationService AudienceListRenamer
+	validate        *validator.Validate
+}
+
+func NewRenameAudienceListHandler(relationService AudienceListRenamer, validate *validator.Validate) *RenameAudienceListHandler {
+	return &RenameAudienceListHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type RenameAudienceListRequestInternal struct {
+	OwnerID int64  `validate:"required,gt=0"`
+	ListID  int64  `validate:"required,gt=0"`
+	Name    string `validate:"required,max=64"`
+}
+
+func (h *RenameAudienceListHandler) RenameAudienceList(ctx context.Context, req *extpb.RenameAudienceListRequest) (*extpb.RenameAudienceListResponse, error) {
+	validationReq := &RenameAudienceListRequestInternal{
+		OwnerID: req.GetOwnerId(),
+		ListID:  req.GetListId(),
+		Name:    req.GetName(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	list, err := h.relationService.RenameAudienceList(ctx, req.GetOwnerId(), req.GetListId(), req.GetName())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.RenameAudienceListResponse{List: audienceListToProto(list)}, nil
+}
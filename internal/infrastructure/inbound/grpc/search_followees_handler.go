@@ -0,0 +1,39 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type FolloweesSearcher interface {
+	SearchFollowees(ctx context.Context, userID int64, query string, limit int32) ([]*model.ProfileMatch, error)
+}
+
+type SearchFolloweesHandler struct {
+	relationService FolloweesSearcher
+	validate        *validator.Validate
+}
+
+func NewSearchFolloweesHandler(relationService FolloweesSearcher, validate *validator.Validate) *SearchFolloweesHandler {
+	return &SearchFolloweesHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+func (h *SearchFolloweesHandler) SearchFollowees(ctx context.Context, req *extpb.SearchFollowsRequest) (*extpb.SearchFollowsResponse, error) {
+	if err := validateSearchFollowsRequest(h.validate, req); err != nil {
+		return nil, err
+	}
+
+	matches, err := h.relationService.SearchFollowees(ctx, req.GetUserId(), req.GetQuery(), req.GetLimit())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return toSearchFollowsResponse(matches), nil
+}
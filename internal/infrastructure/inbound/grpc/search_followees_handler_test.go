@@ -0,0 +1,47 @@
+package follow_grpc_test
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestSearchFolloweesHandler_SearchFollowees(t *testing.T) {
+	t.Run("successful search", func(t *testing.T) {
+		mockService := mocks.NewFollowService(t)
+		mockService.On("SearchFollowees", context.Background(), int64(1), "ivan", int32(5)).
+			Return([]*model.ProfileMatch{{User: &model.User{ID: 4, Username: "ivan_p"}, Score: 2.4}}, nil)
+
+		handler := follow_grpc.NewSearchFolloweesHandler(mockService, validator.New())
+		resp, err := handler.SearchFollowees(context.Background(), &extpb.SearchFollowsRequest{UserId: 1, Query: "ivan", Limit: 5})
+
+		require.NoError(t, err)
+		require.Len(t, resp.GetMatches(), 1)
+		assert.Equal(t, int64(4), resp.GetMatches()[0].GetUser().GetUserId())
+		assert.Nil(t, resp.GetMatches()[0].FullName)
+	})
+
+	t.Run("validation error - user ID zero", func(t *testing.T) {
+		handler := follow_grpc.NewSearchFolloweesHandler(mocks.NewFollowService(t), validator.New())
+		resp, err := handler.SearchFollowees(context.Background(), &extpb.SearchFollowsRequest{Query: "ivan", Limit: 5})
+
+		require.Error(t, err)
+		statusErr, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+		assert.Contains(t, statusErr.Message(), custom_errors.ErrValidationFailed.Error())
+		assert.Nil(t, resp)
+	})
+}
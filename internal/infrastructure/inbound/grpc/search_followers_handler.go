@@ -0,0 +1,70 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type FollowersSearcher interface {
+	SearchFollowers(ctx context.Context, userID int64, query string, limit int32) ([]*model.ProfileMatch, error)
+}
+
+type SearchFollowersHandler struct {
+	relationService FollowersSearcher
+	validate        *validator.Validate
+}
+
+func NewSearchFollowersHandler(relationService FollowersSearcher, validate *validator.Validate) *SearchFollowersHandler {
+	return &SearchFollowersHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type SearchFollowsRequestInternal struct {
+	UserID int64  `validate:"required,gt=0"`
+	Query  string `validate:"required,max=64"`
+	Limit  int32  `validate:"required,gt=0,lte=50"`
+}
+
+func (h *SearchFollowersHandler) SearchFollowers(ctx context.Context, req *extpb.SearchFollowsRequest) (*extpb.SearchFollowsResponse, error) {
+	if err := validateSearchFollowsRequest(h.validate, req); err != nil {
+		return nil, err
+	}
+
+	matches, err := h.relationService.SearchFollowers(ctx, req.GetUserId(), req.GetQuery(), req.GetLimit())
+	if err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return toSearchFollowsResponse(matches), nil
+}
+
+func validateSearchFollowsRequest(validate *validator.Validate, req *extpb.SearchFollowsRequest) error {
+	validationReq := &SearchFollowsRequestInternal{
+		UserID: req.GetUserId(),
+		Query:  req.GetQuery(),
+		Limit:  req.GetLimit(),
+	}
+
+	if err := validate.Struct(validationReq); err != nil {
+		return errmapper.ValidationError(err)
+	}
+	return nil
+}
+
+func toSearchFollowsResponse(matches []*model.ProfileMatch) *extpb.SearchFollowsResponse {
+	pbMatches := make([]*extpb.ProfileMatch, 0, len(matches))
+	for _, match := range matches {
+		pbMatches = append(pbMatches, &extpb.ProfileMatch{
+			User:     toExtUser(match.User),
+			FullName: match.User.FullName,
+			Score:    match.Score,
+		})
+	}
+	return &extpb.SearchFollowsResponse{Matches: pbMatches}
+}
@@ -0,0 +1,128 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestSearchFollowersHandler_SearchFollowers(t *testing.T) {
+	fullName := "Anna Smith"
+
+	tests := []struct {
+		name           string
+		req            *extpb.SearchFollowsRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedResp   *extpb.SearchFollowsResponse
+	}{
+		{
+			name: "successful search",
+			req:  &extpb.SearchFollowsRequest{UserId: 1, Query: "anna", Limit: 10},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("SearchFollowers", context.Background(), int64(1), "anna", int32(10)).
+					Return([]*model.ProfileMatch{
+						{User: &model.User{ID: 3, Username: "anna", FullName: &fullName}, Score: 4},
+						{User: &model.User{ID: 7, Username: "annabel"}, Score: 2.4},
+					}, nil)
+			},
+			expectedResp: &extpb.SearchFollowsResponse{Matches: []*extpb.ProfileMatch{
+				{User: &extpb.User{UserId: 3, Username: "anna"}, FullName: &fullName, Score: 4},
+				{User: &extpb.User{UserId: 7, Username: "annabel"}, Score: 2.4},
+			}},
+		},
+		{
+			name:           "validation error - empty query",
+			req:            &extpb.SearchFollowsRequest{UserId: 1, Limit: 10},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - query too long",
+			req:            &extpb.SearchFollowsRequest{UserId: 1, Query: strings.Repeat("a", 65), Limit: 10},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - limit too large",
+			req:            &extpb.SearchFollowsRequest{UserId: 1, Query: "anna", Limit: 51},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "user not found",
+			req:  &extpb.SearchFollowsRequest{UserId: 1, Query: "anna", Limit: 10},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("SearchFollowers", mock.Anything, int64(1), "anna", int32(10)).
+					Return(nil, custom_errors.ErrUserNotFound)
+			},
+			wantErr:      true,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name: "generic error",
+			req:  &extpb.SearchFollowsRequest{UserId: 1, Query: "anna", Limit: 10},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("SearchFollowers", mock.Anything, int64(1), "anna", int32(10)).
+					Return(nil, errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewSearchFollowersHandler(mockService, validate)
+			resp, err := handler.SearchFollowers(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.GetMatches(), len(tt.expectedResp.GetMatches()))
+			for i, expected := range tt.expectedResp.GetMatches() {
+				got := resp.GetMatches()[i]
+				assert.Equal(t, expected.GetUser().GetUserId(), got.GetUser().GetUserId())
+				assert.Equal(t, expected.GetUser().GetUsername(), got.GetUser().GetUsername())
+				assert.Equal(t, expected.FullName, got.FullName)
+				assert.Equal(t, expected.GetScore(), got.GetScore())
+			}
+		})
+	}
+}
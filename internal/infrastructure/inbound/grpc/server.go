@@ -4,35 +4,49 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
 	ports "pinstack-relation-service/internal/domain/ports/output"
 	"pinstack-relation-service/internal/infrastructure/inbound/middleware"
 	"runtime/debug"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/relation/v1"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
 type Server struct {
-	followGRPCService *FollowGRPCService
-	server            *grpc.Server
-	address           string
-	port              int
-	log               ports.Logger
-	metrics           ports.MetricsProvider
+	followGRPCService  *FollowGRPCService
+	extGRPCService     *RelationExtGRPCService
+	server             *grpc.Server
+	address            string
+	port               int
+	log                ports.Logger
+	metrics            ports.MetricsProvider
+	creds              credentials.TransportCredentials
+	interceptors       []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
 }
 
-func NewServer(grpcServer *FollowGRPCService, address string, port int, log ports.Logger, metrics ports.MetricsProvider) *Server {
+// NewServer creates the gRPC server. Nil creds make the server listen in plaintext.
+// Extra interceptors run after request id, logging, metrics and panic recovery, in the given order,
+// streamInterceptors do the same for streaming RPCs.
+func NewServer(grpcServer *FollowGRPCService, extServer *RelationExtGRPCService, address string, port int, log ports.Logger, metrics ports.MetricsProvider, creds credentials.TransportCredentials, streamInterceptors []grpc.StreamServerInterceptor, interceptors ...grpc.UnaryServerInterceptor) *Server {
 	return &Server{
-		followGRPCService: grpcServer,
-		address:           address,
-		port:              port,
-		log:               log,
-		metrics:           metrics,
+		followGRPCService:  grpcServer,
+		extGRPCService:     extServer,
+		address:            address,
+		port:               port,
+		log:                log,
+		metrics:            metrics,
+		creds:              creds,
+		interceptors:       interceptors,
+		streamInterceptors: streamInterceptors,
 	}
 }
 
@@ -43,25 +57,66 @@ func (s *Server) Run() error {
 		return fmt.Errorf("failed to listen: %v", err)
 	}
 
+	serverOpts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(UnaryInterceptorChain(s.log, s.metrics, s.interceptors...)),
+		grpc.StreamInterceptor(StreamInterceptorChain(s.log, s.metrics, s.streamInterceptors...)),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+	}
+	if s.creds != nil {
+		serverOpts = append(serverOpts, grpc.Creds(s.creds))
+	}
+
+	s.server = grpc.NewServer(serverOpts...)
+
+	pb.RegisterRelationServiceServer(s.server, s.followGRPCService)
+	extpb.RegisterRelationExtServiceServer(s.server, s.extGRPCService)
+
+	s.log.Info("Starting gRPC server", slog.Int("port", s.port), slog.Bool("tls", s.creds != nil))
+	return s.server.Serve(lis)
+}
+
+// UnaryInterceptorChain builds the interceptors every relation API call goes through:
+// request id, logging, metrics and panic recovery followed by the extra ones in the given order.
+// The REST gateway uses the same chain so both transports behave alike.
+func UnaryInterceptorChain(log ports.Logger, metrics ports.MetricsProvider, extra ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
 	opts := []grpc_recovery.Option{
 		grpc_recovery.WithRecoveryHandler(func(p interface{}) (err error) {
-			s.log.Error("panic recovered", slog.Any("panic", p), slog.String("stack", string(debug.Stack())))
+			log.Error("panic recovered", slog.Any("panic", p), slog.String("stack", string(debug.Stack())))
 			return status.Errorf(codes.Internal, "internal server error")
 		}),
 	}
 
-	s.server = grpc.NewServer(
-		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
-			middleware.UnaryLoggerInterceptor(s.log),
-			middleware.UnaryMetricsInterceptor(s.metrics),
-			grpc_recovery.UnaryServerInterceptor(opts...),
-		)),
-	)
+	interceptors := []grpc.UnaryServerInterceptor{
+		middleware.UnaryRequestIDInterceptor(log),
+		middleware.UnaryLoggerInterceptor(log),
+		middleware.UnaryMetricsInterceptor(metrics),
+		grpc_recovery.UnaryServerInterceptor(opts...),
+		middleware.UnaryIdempotencyKeyInterceptor(),
+		middleware.UnaryFollowAttributionInterceptor(),
+	}
+	interceptors = append(interceptors, extra...)
 
-	pb.RegisterRelationServiceServer(s.server, s.followGRPCService)
+	return grpc_middleware.ChainUnaryServer(interceptors...)
+}
 
-	s.log.Info("Starting gRPC server", slog.Int("port", s.port))
-	return s.server.Serve(lis)
+// StreamInterceptorChain is UnaryInterceptorChain for streaming RPCs
+func StreamInterceptorChain(log ports.Logger, metrics ports.MetricsProvider, extra ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	opts := []grpc_recovery.Option{
+		grpc_recovery.WithRecoveryHandler(func(p interface{}) (err error) {
+			log.Error("panic recovered", slog.Any("panic", p), slog.String("stack", string(debug.Stack())))
+			return status.Errorf(codes.Internal, "internal server error")
+		}),
+	}
+
+	interceptors := []grpc.StreamServerInterceptor{
+		middleware.StreamRequestIDInterceptor(log),
+		middleware.StreamLoggerInterceptor(log),
+		middleware.StreamMetricsInterceptor(metrics),
+		grpc_recovery.StreamServerInterceptor(opts...),
+	}
+	interceptors = append(interceptors, extra...)
+
+	return grpc_middleware.ChainStreamServer(interceptors...)
 }
 
 func (s *Server) Shutdown() error {
@@ -0,0 +1,57 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
+)
+
+type FollowerIDsStreamer interface {
+	StreamFollowerIDs(ctx context.Context, userID, cursor int64, chunkSize int32, send func(followerIDs []int64, nextCursor int64) error) error
+}
+
+type StreamFollowerIDsHandler struct {
+	relationService FollowerIDsStreamer
+	validate        *validator.Validate
+}
+
+func NewStreamFollowerIDsHandler(relationService FollowerIDsStreamer, validate *validator.Validate) *StreamFollowerIDsHandler {
+	return &StreamFollowerIDsHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type StreamFollowerIDsRequestInternal struct {
+	UserID    int64 `validate:"required,gt=0"`
+	Cursor    int64 `validate:"gte=0"`
+	ChunkSize int32 `validate:"gte=0,lte=10000"`
+}
+
+func (h *StreamFollowerIDsHandler) StreamFollowerIDs(req *extpb.StreamFollowerIDsRequest, stream grpc.ServerStreamingServer[extpb.StreamFollowerIDsResponse]) error {
+	validationReq := &StreamFollowerIDsRequestInternal{
+		UserID:    req.GetUserId(),
+		Cursor:    req.GetCursor(),
+		ChunkSize: req.GetChunkSize(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return errmapper.ValidationError(err)
+	}
+
+	send := func(followerIDs []int64, nextCursor int64) error {
+		return stream.Send(&extpb.StreamFollowerIDsResponse{
+			FollowerIds: followerIDs,
+			NextCursor:  nextCursor,
+		})
+	}
+
+	if err := h.relationService.StreamFollowerIDs(stream.Context(), req.GetUserId(), req.GetCursor(), req.GetChunkSize(), send); err != nil {
+		return errmapper.Error(err)
+	}
+
+	return nil
+}
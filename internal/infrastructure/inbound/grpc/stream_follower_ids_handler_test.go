@@ -0,0 +1,151 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+type followerIDsStream struct {
+	grpc.ServerStreamingServer[extpb.StreamFollowerIDsResponse]
+	ctx  context.Context
+	sent []*extpb.StreamFollowerIDsResponse
+}
+
+func (s *followerIDsStream) Context() context.Context { return s.ctx }
+
+func (s *followerIDsStream) Send(resp *extpb.StreamFollowerIDsResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func TestStreamFollowerIDsHandler_StreamFollowerIDs(t *testing.T) {
+	sendChunks := func(chunks ...[]int64) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			send := args.Get(4).(func([]int64, int64) error)
+			for _, chunk := range chunks {
+				_ = send(chunk, chunk[len(chunk)-1])
+			}
+		}
+	}
+
+	tests := []struct {
+		name           string
+		req            *extpb.StreamFollowerIDsRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+		expectedSent   []*extpb.StreamFollowerIDsResponse
+	}{
+		{
+			name: "successful stream",
+			req:  &extpb.StreamFollowerIDsRequest{UserId: 1, ChunkSize: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("StreamFollowerIDs", mock.Anything, int64(1), int64(0), int32(2), mock.Anything).
+					Run(sendChunks([]int64{3, 5}, []int64{8})).
+					Return(nil)
+			},
+			expectedSent: []*extpb.StreamFollowerIDsResponse{
+				{FollowerIds: []int64{3, 5}, NextCursor: 5},
+				{FollowerIds: []int64{8}, NextCursor: 8},
+			},
+		},
+		{
+			name: "resume from cursor",
+			req:  &extpb.StreamFollowerIDsRequest{UserId: 1, Cursor: 5},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("StreamFollowerIDs", mock.Anything, int64(1), int64(5), int32(0), mock.Anything).
+					Run(sendChunks([]int64{8})).
+					Return(nil)
+			},
+			expectedSent: []*extpb.StreamFollowerIDsResponse{
+				{FollowerIds: []int64{8}, NextCursor: 8},
+			},
+		},
+		{
+			name:           "validation error - user ID zero",
+			req:            &extpb.StreamFollowerIDsRequest{UserId: 0},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - negative cursor",
+			req:            &extpb.StreamFollowerIDsRequest{UserId: 1, Cursor: -1},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - chunk size too large",
+			req:            &extpb.StreamFollowerIDsRequest{UserId: 1, ChunkSize: 10001},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "forbidden error",
+			req:  &extpb.StreamFollowerIDsRequest{UserId: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("StreamFollowerIDs", mock.Anything, int64(1), int64(0), int32(0), mock.Anything).
+					Return(custom_errors.ErrForbidden)
+			},
+			wantErr:      true,
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "generic error",
+			req:  &extpb.StreamFollowerIDsRequest{UserId: 1},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("StreamFollowerIDs", mock.Anything, int64(1), int64(0), int32(0), mock.Anything).
+					Return(errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			stream := &followerIDsStream{ctx: context.Background()}
+			handler := follow_grpc.NewStreamFollowerIDsHandler(mockService, validate)
+			err := handler.StreamFollowerIDs(tt.req, stream)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Empty(t, stream.sent)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSent, stream.sent)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type TargetUnfollower interface {
+	UnfollowTarget(ctx context.Context, followerID int64, target model.FollowTarget) error
+}
+
+type UnfollowTargetHandler struct {
+	relationService TargetUnfollower
+	validate        *validator.Validate
+}
+
+func NewUnfollowTargetHandler(relationService TargetUnfollower, validate *validator.Validate) *UnfollowTargetHandler {
+	return &UnfollowTargetHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type UnfollowTargetRequestInternal struct {
+	FollowerID int64            `validate:"required,gt=0"`
+	TargetType model.TargetType `validate:"required,oneof=user board tag"`
+	TargetID   int64            `validate:"required,gt=0"`
+}
+
+func (h *UnfollowTargetHandler) UnfollowTarget(ctx context.Context, req *extpb.UnfollowTargetRequest) (*extpb.UnfollowTargetResponse, error) {
+	validationReq := &UnfollowTargetRequestInternal{
+		FollowerID: req.GetFollowerId(),
+		TargetType: targetTypeFromProto(req.GetTargetType()),
+		TargetID:   req.GetTargetId(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	target := model.FollowTarget{Type: validationReq.TargetType, ID: req.GetTargetId()}
+	if err := h.relationService.UnfollowTarget(ctx, req.GetFollowerId(), target); err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.UnfollowTargetResponse{}, nil
+}
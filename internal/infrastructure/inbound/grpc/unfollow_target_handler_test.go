@@ -0,0 +1,101 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestUnfollowTargetHandler_UnfollowTarget(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.UnfollowTargetRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful tag unfollow",
+			req:  &extpb.UnfollowTargetRequest{FollowerId: 1, TargetType: extpb.TargetType_TARGET_TYPE_TAG, TargetId: 3},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("UnfollowTarget", mock.Anything, int64(1), model.FollowTarget{Type: model.TargetTypeTag, ID: 3}).Return(nil)
+			},
+		},
+		{
+			name:           "validation error - unspecified target type",
+			req:            &extpb.UnfollowTargetRequest{FollowerId: 1, TargetType: extpb.TargetType_TARGET_TYPE_UNSPECIFIED, TargetId: 3},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name:           "validation error - target ID zero",
+			req:            &extpb.UnfollowTargetRequest{FollowerId: 1, TargetType: extpb.TargetType_TARGET_TYPE_TAG, TargetId: 0},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "follow relation not found error",
+			req:  &extpb.UnfollowTargetRequest{FollowerId: 1, TargetType: extpb.TargetType_TARGET_TYPE_BOARD, TargetId: 7},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("UnfollowTarget", mock.Anything, int64(1), mock.Anything).Return(custom_errors.ErrFollowRelationNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: custom_errors.ErrFollowRelationNotFound.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.UnfollowTargetRequest{FollowerId: 1, TargetType: extpb.TargetType_TARGET_TYPE_BOARD, TargetId: 7},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("UnfollowTarget", mock.Anything, int64(1), mock.Anything).Return(errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewUnfollowTargetHandler(mockService, validate)
+			resp, err := handler.UnfollowTarget(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	}
+}
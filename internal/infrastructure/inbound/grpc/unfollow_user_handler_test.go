@@ -123,7 +123,7 @@ func TestUnfollowHandler_Unfollow(t *testing.T) {
 			},
 			wantErr:        true,
 			expectedCode:   codes.Internal,
-			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+			expectedErrMsg: "internal server error",
 		},
 		{
 			name: "follow relation delete fail",
@@ -136,7 +136,20 @@ func TestUnfollowHandler_Unfollow(t *testing.T) {
 			},
 			wantErr:        true,
 			expectedCode:   codes.Internal,
-			expectedErrMsg: custom_errors.ErrExternalServiceError.Error(),
+			expectedErrMsg: custom_errors.ErrFollowRelationDeleteFail.Error(),
+		},
+		{
+			name: "caller not allowed to act for follower",
+			req: &pb.UnfollowRequest{
+				FollowerId: 1,
+				FolloweeId: 2,
+			},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("Unfollow", mock.Anything, int64(1), int64(2)).Return(custom_errors.ErrForbidden)
+			},
+			wantErr:        true,
+			expectedCode:   codes.PermissionDenied,
+			expectedErrMsg: custom_errors.ErrForbidden.Error(),
 		},
 	}
 
@@ -0,0 +1,47 @@
+package follow_grpc
+
+import (
+	"context"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Unmuter interface {
+	Unmute(ctx context.Context, followerID, followeeID int64) error
+}
+
+type UnmuteHandler struct {
+	relationService Unmuter
+	validate        *validator.Validate
+}
+
+func NewUnmuteHandler(relationService Unmuter, validate *validator.Validate) *UnmuteHandler {
+	return &UnmuteHandler{
+		relationService: relationService,
+		validate:        validate,
+	}
+}
+
+type UnmuteRequestInternal struct {
+	FollowerID int64 `validate:"required,gt=0"`
+	FolloweeID int64 `validate:"required,gt=0"`
+}
+
+func (h *UnmuteHandler) Unmute(ctx context.Context, req *extpb.UnmuteRequest) (*extpb.UnmuteResponse, error) {
+	validationReq := &UnmuteRequestInternal{
+		FollowerID: req.GetFollowerId(),
+		FolloweeID: req.GetFolloweeId(),
+	}
+
+	if err := h.validate.Struct(validationReq); err != nil {
+		return nil, errmapper.ValidationError(err)
+	}
+
+	if err := h.relationService.Unmute(ctx, req.GetFollowerId(), req.GetFolloweeId()); err != nil {
+		return nil, errmapper.Error(err)
+	}
+
+	return &extpb.UnmuteResponse{}, nil
+}
@@ -0,0 +1,94 @@
+package follow_grpc_test
+
+import (
+	"context"
+	"errors"
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestUnmuteHandler_Unmute(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            *extpb.UnmuteRequest
+		mockSetup      func(*mocks.FollowService)
+		wantErr        bool
+		expectedCode   codes.Code
+		expectedErrMsg string
+	}{
+		{
+			name: "successful unmute",
+			req:  &extpb.UnmuteRequest{FollowerId: 1, FolloweeId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("Unmute", context.Background(), int64(1), int64(2)).Return(nil)
+			},
+		},
+		{
+			name:           "validation error - follower ID zero",
+			req:            &extpb.UnmuteRequest{FolloweeId: 2},
+			wantErr:        true,
+			expectedCode:   codes.InvalidArgument,
+			expectedErrMsg: custom_errors.ErrValidationFailed.Error(),
+		},
+		{
+			name: "not muted error",
+			req:  &extpb.UnmuteRequest{FollowerId: 1, FolloweeId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("Unmute", mock.Anything, int64(1), int64(2)).Return(model.ErrMuteNotFound)
+			},
+			wantErr:        true,
+			expectedCode:   codes.NotFound,
+			expectedErrMsg: model.ErrMuteNotFound.Error(),
+		},
+		{
+			name: "generic error",
+			req:  &extpb.UnmuteRequest{FollowerId: 1, FolloweeId: 2},
+			mockSetup: func(mockService *mocks.FollowService) {
+				mockService.On("Unmute", mock.Anything, int64(1), int64(2)).Return(errors.New("unexpected error"))
+			},
+			wantErr:        true,
+			expectedCode:   codes.Internal,
+			expectedErrMsg: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validate := validator.New()
+			mockService := mocks.NewFollowService(t)
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockService)
+			}
+
+			handler := follow_grpc.NewUnmuteHandler(mockService, validate)
+			resp, err := handler.Unmute(context.Background(), tt.req)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				statusErr, ok := status.FromError(err)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, statusErr.Code())
+				assert.Contains(t, statusErr.Message(), tt.expectedErrMsg)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"google.golang.org/grpc"
+)
+
+type Authenticator interface {
+	Authenticate(ctx context.Context) (model.Caller, error)
+}
+
+func UnaryAuthInterceptor(authenticator Authenticator, log ports.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		caller, err := authenticator.Authenticate(ctx)
+		if err != nil {
+			ports.LoggerFromContext(ctx, log).Warn("Request authentication failed",
+				slog.String("method", info.FullMethod),
+				slog.String("error", err.Error()))
+			return nil, errmapper.Error(err)
+		}
+
+		return handler(model.ContextWithCaller(ctx, caller), req)
+	}
+}
+
+func StreamAuthInterceptor(authenticator Authenticator, log ports.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx := stream.Context()
+		caller, err := authenticator.Authenticate(ctx)
+		if err != nil {
+			ports.LoggerFromContext(ctx, log).Warn("Stream authentication failed",
+				slog.String("method", info.FullMethod),
+				slog.String("error", err.Error()))
+			return errmapper.Error(err)
+		}
+
+		return handler(srv, withStreamContext(stream, model.ContextWithCaller(ctx, caller)))
+	}
+}
@@ -0,0 +1,60 @@
+package middleware_test
+
+import (
+	"context"
+	"testing"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/middleware"
+	"pinstack-relation-service/internal/infrastructure/logger"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type staticAuthenticator struct {
+	caller model.Caller
+	err    error
+}
+
+func (a staticAuthenticator) Authenticate(context.Context) (model.Caller, error) {
+	return a.caller, a.err
+}
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/relation_ext.v1.RelationExtService/StreamFollowerIDs", IsServerStream: true}
+
+	t.Run("caller is passed to the handler", func(t *testing.T) {
+		interceptor := middleware.StreamAuthInterceptor(staticAuthenticator{caller: model.Caller{UserID: 7, ServiceAccount: true}}, logger.New("test"))
+
+		var caller model.Caller
+		var ok bool
+		err := interceptor(nil, &serverStream{ctx: context.Background()}, info, func(srv interface{}, stream grpc.ServerStream) error {
+			caller, ok = model.CallerFromContext(stream.Context())
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, int64(7), caller.UserID)
+		assert.True(t, caller.ServiceAccount)
+	})
+
+	t.Run("unauthenticated stream is rejected", func(t *testing.T) {
+		interceptor := middleware.StreamAuthInterceptor(staticAuthenticator{err: custom_errors.ErrUnauthenticated}, logger.New("test"))
+
+		called := false
+		err := interceptor(nil, &serverStream{ctx: context.Background()}, info, func(srv interface{}, stream grpc.ServerStream) error {
+			called = true
+			return nil
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.False(t, called)
+	})
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/relation/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	FollowSourceHeader    = "follow-source"
+	FollowContextIDHeader = "follow-context-id"
+)
+
+// attributedMethods are the RPCs that create follows and so take an attribution
+var attributedMethods = map[string]struct{}{
+	pb.RelationService_Follow_FullMethodName:           {},
+	extpb.RelationExtService_BulkFollow_FullMethodName: {},
+}
+
+// UnaryFollowAttributionInterceptor puts the optional follow-source and follow-context-id from
+// incoming metadata into the context, for follows to record which surface they came from.
+// The follow RPCs are defined in the shared proto, so the attribution travels as metadata.
+// Other RPCs pass through with the headers ignored.
+func UnaryFollowAttributionInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		if _, ok := attributedMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		var attribution model.FollowAttribution
+		if values := md.Get(FollowSourceHeader); len(values) > 0 {
+			attribution.Source = model.FollowSource(values[0])
+			if !attribution.Source.Valid() {
+				return nil, errmapper.InvalidField(FollowSourceHeader, "must be one of suggestion, search, profile, board, import")
+			}
+		}
+		if values := md.Get(FollowContextIDHeader); len(values) > 0 {
+			attribution.ContextID = values[0]
+			if len(attribution.ContextID) > model.MaxFollowContextIDLength {
+				return nil, errmapper.InvalidField(FollowContextIDHeader, "must be at most 128 characters")
+			}
+		}
+
+		if attribution != (model.FollowAttribution{}) {
+			ctx = model.ContextWithFollowAttribution(ctx, attribution)
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,97 @@
+package middleware_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/middleware"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryFollowAttributionInterceptor(t *testing.T) {
+	interceptor := middleware.UnaryFollowAttributionInterceptor()
+
+	tests := []struct {
+		name     string
+		method   string
+		incoming metadata.MD
+		expected model.FollowAttribution
+		wantErr  bool
+	}{
+		{
+			name:     "source and context id are passed on",
+			method:   followMethod,
+			incoming: metadata.Pairs("follow-source", "board", "follow-context-id", "board-42"),
+			expected: model.FollowAttribution{Source: model.FollowSourceBoard, ContextID: "board-42"},
+		},
+		{
+			name:     "request without attribution",
+			method:   followMethod,
+			incoming: metadata.Pairs("idempotency-key", "key-1"),
+		},
+		{
+			name:   "request without metadata",
+			method: followMethod,
+		},
+		{
+			name:     "bulk follow takes an attribution",
+			method:   "/relation_ext.v1.RelationExtService/BulkFollow",
+			incoming: metadata.Pairs("follow-source", "import"),
+			expected: model.FollowAttribution{Source: model.FollowSourceImport},
+		},
+		{
+			name:     "other methods ignore a malformed source",
+			method:   "/relation.v1.RelationService/Unfollow",
+			incoming: metadata.Pairs("follow-source", "unknown"),
+		},
+		{
+			name:     "unknown source is rejected",
+			method:   followMethod,
+			incoming: metadata.Pairs("follow-source", "unknown"),
+			wantErr:  true,
+		},
+		{
+			name:     "too long context id is rejected",
+			method:   followMethod,
+			incoming: metadata.Pairs("follow-source", "search", "follow-context-id", strings.Repeat("c", 129)),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.incoming != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.incoming)
+			}
+
+			called := false
+			var attribution model.FollowAttribution
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+			_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				attribution = model.FollowAttributionFromContext(ctx)
+				return nil, nil
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.False(t, called)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, called)
+			assert.Equal(t, tt.expected, attribution)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"context"
+
+	extpb "pinstack-relation-service/gen/go/relation_ext/v1"
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/relation/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	IdempotencyKeyHeader = "idempotency-key"
+
+	maxIdempotencyKeyLength = 128
+)
+
+// idempotentMethods are the RPCs that honour an idempotency key
+var idempotentMethods = map[string]struct{}{
+	pb.RelationService_Follow_FullMethodName:             {},
+	pb.RelationService_Unfollow_FullMethodName:           {},
+	extpb.RelationExtService_BulkFollow_FullMethodName:   {},
+	extpb.RelationExtService_BulkUnfollow_FullMethodName: {},
+}
+
+// UnaryIdempotencyKeyInterceptor puts the optional idempotency-key from incoming metadata into
+// the context. Retrying a request with the same key returns the original result instead of
+// running it twice. A malformed key is rejected rather than ignored, a client sending one
+// expects the request to be safe to retry. Other RPCs pass through with the header ignored.
+func UnaryIdempotencyKeyInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		if _, ok := idempotentMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		key, ok := incomingIdempotencyKey(ctx)
+		if !ok {
+			return nil, errmapper.InvalidField(IdempotencyKeyHeader, "must be 1 to 128 printable ASCII characters")
+		}
+		if key != "" {
+			ctx = model.ContextWithIdempotencyKey(ctx, key)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// incomingIdempotencyKey returns the idempotency key of the request, ok is false when it is malformed
+func incomingIdempotencyKey(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", true
+	}
+	values := md.Get(IdempotencyKeyHeader)
+	if len(values) == 0 {
+		return "", true
+	}
+	key := values[0]
+	if len(key) == 0 || len(key) > maxIdempotencyKeyLength {
+		return "", false
+	}
+	for _, r := range key {
+		if r < 0x21 || r > 0x7e {
+			return "", false
+		}
+	}
+	return key, true
+}
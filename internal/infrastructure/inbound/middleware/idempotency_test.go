@@ -0,0 +1,92 @@
+package middleware_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/middleware"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryIdempotencyKeyInterceptor(t *testing.T) {
+	interceptor := middleware.UnaryIdempotencyKeyInterceptor()
+
+	tests := []struct {
+		name        string
+		method      string
+		incoming    metadata.MD
+		expectedKey string
+		wantErr     bool
+	}{
+		{
+			name:        "idempotency key from metadata is passed on",
+			method:      followMethod,
+			incoming:    metadata.Pairs("idempotency-key", "follow-1-2-attempt"),
+			expectedKey: "follow-1-2-attempt",
+		},
+		{
+			name:        "bulk unfollow takes an idempotency key",
+			method:      "/relation_ext.v1.RelationExtService/BulkUnfollow",
+			incoming:    metadata.Pairs("idempotency-key", "bulk-1"),
+			expectedKey: "bulk-1",
+		},
+		{
+			name:   "request without a key",
+			method: followMethod,
+		},
+		{
+			name:     "key with control characters is rejected",
+			method:   followMethod,
+			incoming: metadata.Pairs("idempotency-key", "key\n1"),
+			wantErr:  true,
+		},
+		{
+			name:     "too long key is rejected",
+			method:   followMethod,
+			incoming: metadata.Pairs("idempotency-key", strings.Repeat("k", 129)),
+			wantErr:  true,
+		},
+		{
+			name:     "other methods ignore a malformed key",
+			method:   "/relation.v1.RelationService/GetFollowers",
+			incoming: metadata.Pairs("idempotency-key", "key\n1"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.incoming != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.incoming)
+			}
+
+			called := false
+			var key string
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+			_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				key = model.IdempotencyKeyFromContext(ctx)
+				return nil, nil
+			})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.False(t, called)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, called)
+			assert.Equal(t, tt.expectedKey, key)
+		})
+	}
+}
@@ -31,7 +31,7 @@ func UnaryLoggerInterceptor(log ports.Logger) grpc.UnaryServerInterceptor {
 		latency := time.Since(start)
 		st, _ := status.FromError(err)
 
-		log.With(
+		ports.LoggerFromContext(ctx, log).With(
 			slog.String("method", info.FullMethod),
 			slog.String("remote_address", remoteAddr),
 			slog.String("latency", latency.String()),
@@ -41,3 +41,34 @@ func UnaryLoggerInterceptor(log ports.Logger) grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+func StreamLoggerInterceptor(log ports.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		ctx := stream.Context()
+
+		var remoteAddr string
+		if p, ok := peer.FromContext(ctx); ok {
+			remoteAddr = p.Addr.String()
+		}
+
+		err := handler(srv, stream)
+
+		latency := time.Since(start)
+		st, _ := status.FromError(err)
+
+		ports.LoggerFromContext(ctx, log).With(
+			slog.String("method", info.FullMethod),
+			slog.String("remote_address", remoteAddr),
+			slog.String("latency", latency.String()),
+			slog.String("grpc_code", st.Code().String()),
+		).Info("gRPC stream completed")
+
+		return err
+	}
+}
@@ -32,3 +32,25 @@ func UnaryMetricsInterceptor(metrics output.MetricsProvider) grpc.UnaryServerInt
 		return resp, err
 	}
 }
+
+func StreamMetricsInterceptor(metrics output.MetricsProvider) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+
+		err := handler(srv, stream)
+
+		duration := time.Since(start)
+
+		statusStr := status.Code(err).String()
+
+		metrics.IncrementGRPCRequests(info.FullMethod, statusStr)
+		metrics.RecordGRPCRequestDuration(info.FullMethod, statusStr, duration)
+
+		return err
+	}
+}
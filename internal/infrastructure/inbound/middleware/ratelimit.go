@@ -0,0 +1,124 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net"
+	"strconv"
+
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/domain/ports/output/ratelimit"
+	"pinstack-relation-service/internal/infrastructure/config"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	retryAfterHeader         = "retry-after"
+	rateLimitLimitHeader     = "x-ratelimit-limit"
+	rateLimitRemainingHeader = "x-ratelimit-remaining"
+)
+
+// UnaryRateLimitInterceptor throttles requests with a token bucket per method and key.
+// Limiter failures are logged and the request is let through.
+func UnaryRateLimitInterceptor(limiter ratelimit.Limiter, cfg config.RateLimit, log ports.Logger, metrics ports.MetricsProvider) grpc.UnaryServerInterceptor {
+	policies := make(map[string]config.MethodRateLimit, len(cfg.Methods))
+	for _, policy := range cfg.Methods {
+		policies[policy.Method] = policy
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		policy, ok := policies[info.FullMethod]
+		if !ok {
+			policy = cfg.Default
+		}
+		if policy.Rate <= 0 || policy.Burst <= 0 {
+			return handler(ctx, req)
+		}
+
+		keyType, key := rateLimitKey(ctx, req, policy)
+		decision, err := limiter.Allow(ctx, info.FullMethod+"|"+key, model.RateLimit{Rate: policy.Rate, Burst: policy.Burst})
+		if err != nil {
+			ports.LoggerFromContext(ctx, log).Error("Rate limiter failed, allowing request",
+				slog.String("method", info.FullMethod),
+				slog.String("error", err.Error()))
+			return handler(ctx, req)
+		}
+
+		header := metadata.Pairs(
+			rateLimitLimitHeader, strconv.Itoa(policy.Burst),
+			rateLimitRemainingHeader, strconv.Itoa(decision.Remaining),
+		)
+
+		if !decision.Allowed {
+			retryAfter := int(math.Ceil(decision.RetryAfter.Seconds()))
+			header.Set(retryAfterHeader, strconv.Itoa(retryAfter))
+			_ = grpc.SetHeader(ctx, header)
+
+			metrics.IncrementRateLimitedRequests(info.FullMethod, keyType)
+			ports.LoggerFromContext(ctx, log).Warn("Request rate limited",
+				slog.String("method", info.FullMethod),
+				slog.String("key", key),
+				slog.Int("retry_after_seconds", retryAfter))
+			return nil, errmapper.Error(errmapper.WithRetryAfter(custom_errors.ErrRateLimitExceeded, decision.RetryAfter))
+		}
+
+		_ = grpc.SetHeader(ctx, header)
+		return handler(ctx, req)
+	}
+}
+
+// rateLimitKey builds the bucket key for the policy. Caller and target keys fall
+// back to the peer address when the identity or the field is not available.
+func rateLimitKey(ctx context.Context, req interface{}, policy config.MethodRateLimit) (string, string) {
+	switch policy.Key {
+	case config.RateLimitKeyCaller:
+		if caller, ok := model.CallerFromContext(ctx); ok {
+			return config.RateLimitKeyCaller, fmt.Sprintf("caller:%d", caller.UserID)
+		}
+	case config.RateLimitKeyTarget:
+		if target, ok := targetField(req, policy.TargetField); ok {
+			return config.RateLimitKeyTarget, "target:" + target
+		}
+	}
+	return config.RateLimitKeyPeer, "peer:" + peerHost(ctx)
+}
+
+func targetField(req interface{}, field string) (string, bool) {
+	msg, ok := req.(proto.Message)
+	if !ok || field == "" {
+		return "", false
+	}
+	reflected := msg.ProtoReflect()
+	fd := reflected.Descriptor().Fields().ByName(protoreflect.Name(field))
+	if fd == nil || fd.IsList() || fd.IsMap() {
+		return "", false
+	}
+	return reflected.Get(fd).String(), true
+}
+
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
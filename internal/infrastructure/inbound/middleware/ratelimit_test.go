@@ -0,0 +1,135 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/config"
+	"pinstack-relation-service/internal/infrastructure/inbound/middleware"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-relation-service/mocks"
+
+	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/relation/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const followMethod = "/relation.v1.RelationService/Follow"
+
+func TestUnaryRateLimitInterceptor(t *testing.T) {
+	cfg := config.RateLimit{
+		Methods: []config.MethodRateLimit{
+			{Method: followMethod, Key: config.RateLimitKeyCaller, Rate: 1, Burst: 5},
+			{Method: "/relation.v1.RelationService/GetFollowers", Key: config.RateLimitKeyTarget, TargetField: "followee_id", Rate: 10, Burst: 20},
+		},
+	}
+	followCtx := model.ContextWithCaller(context.Background(), model.Caller{UserID: 7})
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		method        string
+		req           interface{}
+		mockSetup     func(*mocks.Limiter)
+		expectHandler bool
+		expectedCode  codes.Code
+	}{
+		{
+			name:   "allowed request keyed by caller",
+			ctx:    followCtx,
+			method: followMethod,
+			req:    &pb.FollowRequest{FollowerId: 7, FolloweeId: 8},
+			mockSetup: func(l *mocks.Limiter) {
+				l.EXPECT().Allow(mock.Anything, followMethod+"|caller:7", model.RateLimit{Rate: 1, Burst: 5}).
+					Return(model.RateLimitDecision{Allowed: true, Remaining: 4}, nil)
+			},
+			expectHandler: true,
+		},
+		{
+			name:   "throttled request",
+			ctx:    followCtx,
+			method: followMethod,
+			req:    &pb.FollowRequest{FollowerId: 7, FolloweeId: 8},
+			mockSetup: func(l *mocks.Limiter) {
+				l.EXPECT().Allow(mock.Anything, followMethod+"|caller:7", mock.Anything).
+					Return(model.RateLimitDecision{Allowed: false, RetryAfter: 1500 * time.Millisecond}, nil)
+			},
+			expectedCode: codes.ResourceExhausted,
+		},
+		{
+			name:   "keyed by target user",
+			ctx:    context.Background(),
+			method: "/relation.v1.RelationService/GetFollowers",
+			req:    &pb.GetFollowersRequest{FolloweeId: 42, Limit: 10, Page: 1},
+			mockSetup: func(l *mocks.Limiter) {
+				l.EXPECT().Allow(mock.Anything, "/relation.v1.RelationService/GetFollowers|target:42", mock.Anything).
+					Return(model.RateLimitDecision{Allowed: true}, nil)
+			},
+			expectHandler: true,
+		},
+		{
+			name:   "caller key falls back to peer without identity",
+			ctx:    context.Background(),
+			method: followMethod,
+			req:    &pb.FollowRequest{FollowerId: 7, FolloweeId: 8},
+			mockSetup: func(l *mocks.Limiter) {
+				l.EXPECT().Allow(mock.Anything, followMethod+"|peer:unknown", mock.Anything).
+					Return(model.RateLimitDecision{Allowed: true}, nil)
+			},
+			expectHandler: true,
+		},
+		{
+			name:   "limiter failure lets request through",
+			ctx:    followCtx,
+			method: followMethod,
+			req:    &pb.FollowRequest{FollowerId: 7, FolloweeId: 8},
+			mockSetup: func(l *mocks.Limiter) {
+				l.EXPECT().Allow(mock.Anything, mock.Anything, mock.Anything).
+					Return(model.RateLimitDecision{}, errors.New("redis down"))
+			},
+			expectHandler: true,
+		},
+		{
+			name:          "method without policy and disabled default",
+			ctx:           context.Background(),
+			method:        "/relation.v1.RelationService/Unfollow",
+			req:           &pb.UnfollowRequest{FollowerId: 7, FolloweeId: 8},
+			mockSetup:     func(l *mocks.Limiter) {},
+			expectHandler: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := mocks.NewLimiter(t)
+			tt.mockSetup(limiter)
+
+			interceptor := middleware.UnaryRateLimitInterceptor(limiter, cfg, logger.New("test"), prometheus.NewPrometheusMetricsProvider())
+
+			handlerCalled := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCalled = true
+				return "ok", nil
+			}
+
+			resp, err := interceptor(tt.ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			assert.Equal(t, tt.expectHandler, handlerCalled)
+			if tt.expectHandler {
+				require.NoError(t, err)
+				assert.Equal(t, "ok", resp)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
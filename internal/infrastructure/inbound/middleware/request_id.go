@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	RequestIDHeader = "x-request-id"
+
+	maxRequestIDLength = 128
+)
+
+// UnaryRequestIDInterceptor takes the x-request-id from incoming metadata, or generates one,
+// echoes it in the response header and puts a logger carrying it into the context.
+func UnaryRequestIDInterceptor(log ports.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		return handler(requestIDContext(ctx, log, grpc.SetHeader), req)
+	}
+}
+
+// StreamRequestIDInterceptor is UnaryRequestIDInterceptor for server streams
+func StreamRequestIDInterceptor(log ports.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		setHeader := func(_ context.Context, md metadata.MD) error {
+			return stream.SetHeader(md)
+		}
+		return handler(srv, withStreamContext(stream, requestIDContext(stream.Context(), log, setHeader)))
+	}
+}
+
+func requestIDContext(ctx context.Context, log ports.Logger, setHeader func(context.Context, metadata.MD) error) context.Context {
+	requestID := incomingRequestID(ctx)
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+
+	_ = setHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+	attrs := []any{slog.String("request_id", requestID)}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		attrs = append(attrs, slog.String("trace_id", spanContext.TraceID().String()))
+	}
+
+	ctx = model.ContextWithRequestID(ctx, requestID)
+	return ports.ContextWithLogger(ctx, log.With(attrs...))
+}
+
+// UnaryClientRequestIDInterceptor forwards the request id to downstream services
+func UnaryClientRequestIDInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if requestID := model.RequestIDFromContext(ctx); requestID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, RequestIDHeader, requestID)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// incomingRequestID returns the caller supplied id, ignoring values that are too long
+// or contain non printable characters so they can't pollute logs and headers.
+func incomingRequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(RequestIDHeader)
+	if len(values) == 0 {
+		return ""
+	}
+	requestID := values[0]
+	if len(requestID) == 0 || len(requestID) > maxRequestIDLength {
+		return ""
+	}
+	for _, r := range requestID {
+		if r < 0x21 || r > 0x7e {
+			return ""
+		}
+	}
+	return requestID
+}
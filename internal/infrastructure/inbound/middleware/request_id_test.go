@@ -0,0 +1,136 @@
+package middleware_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/infrastructure/inbound/middleware"
+	"pinstack-relation-service/internal/infrastructure/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type headerStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return followMethod }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestUnaryRequestIDInterceptor(t *testing.T) {
+	base := logger.New("test")
+	interceptor := middleware.UnaryRequestIDInterceptor(base)
+	info := &grpc.UnaryServerInfo{FullMethod: followMethod}
+
+	tests := []struct {
+		name     string
+		incoming metadata.MD
+		keepsID  bool
+	}{
+		{
+			name:     "request id from metadata is kept",
+			incoming: metadata.Pairs("x-request-id", "req-123"),
+			keepsID:  true,
+		},
+		{
+			name: "request id is generated when missing",
+		},
+		{
+			name:     "request id with control characters is replaced",
+			incoming: metadata.Pairs("x-request-id", "req\n123"),
+		},
+		{
+			name:     "too long request id is replaced",
+			incoming: metadata.Pairs("x-request-id", strings.Repeat("a", 129)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &headerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			if tt.incoming != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.incoming)
+			}
+
+			var requestID string
+			var log ports.Logger
+			_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				requestID = model.RequestIDFromContext(ctx)
+				log = ports.LoggerFromContext(ctx, nil)
+				return nil, nil
+			})
+			require.NoError(t, err)
+
+			if tt.keepsID {
+				assert.Equal(t, "req-123", requestID)
+			} else {
+				_, parseErr := uuid.Parse(requestID)
+				assert.NoError(t, parseErr)
+			}
+			assert.NotNil(t, log)
+			assert.NotSame(t, base, log)
+			assert.Equal(t, []string{requestID}, stream.header.Get("x-request-id"))
+		})
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestStreamRequestIDInterceptor(t *testing.T) {
+	interceptor := middleware.StreamRequestIDInterceptor(logger.New("test"))
+	info := &grpc.StreamServerInfo{FullMethod: "/relation_ext.v1.RelationExtService/StreamFollowerIDs", IsServerStream: true}
+
+	stream := &serverStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-request-id", "req-123"))}
+
+	var requestID string
+	err := interceptor(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+		requestID = model.RequestIDFromContext(stream.Context())
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "req-123", requestID)
+	assert.Equal(t, []string{"req-123"}, stream.header.Get("x-request-id"))
+}
+
+func TestUnaryClientRequestIDInterceptor(t *testing.T) {
+	interceptor := middleware.UnaryClientRequestIDInterceptor()
+
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	ctx := model.ContextWithRequestID(context.Background(), "req-123")
+	require.NoError(t, interceptor(ctx, "/user.v1.UserService/GetUser", nil, nil, nil, invoker))
+	assert.Equal(t, []string{"req-123"}, outgoing.Get("x-request-id"))
+
+	outgoing = nil
+	require.NoError(t, interceptor(context.Background(), "/user.v1.UserService/GetUser", nil, nil, nil, invoker))
+	assert.Empty(t, outgoing.Get("x-request-id"))
+}
@@ -0,0 +1,78 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/relation/v1"
+)
+
+func (s *Server) follow(w http.ResponseWriter, r *http.Request) {
+	req := &pb.FollowRequest{}
+	if !pathInt64(w, r, "follower_id", &req.FollowerId) || !pathInt64(w, r, "followee_id", &req.FolloweeId) {
+		return
+	}
+	s.invoke(w, r, pb.RelationService_Follow_FullMethodName, req, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.api.Follow(ctx, req.(*pb.FollowRequest))
+	})
+}
+
+func (s *Server) unfollow(w http.ResponseWriter, r *http.Request) {
+	req := &pb.UnfollowRequest{}
+	if !pathInt64(w, r, "follower_id", &req.FollowerId) || !pathInt64(w, r, "followee_id", &req.FolloweeId) {
+		return
+	}
+	s.invoke(w, r, pb.RelationService_Unfollow_FullMethodName, req, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.api.Unfollow(ctx, req.(*pb.UnfollowRequest))
+	})
+}
+
+func (s *Server) getFollowers(w http.ResponseWriter, r *http.Request) {
+	req := &pb.GetFollowersRequest{}
+	if !pathInt64(w, r, "followee_id", &req.FolloweeId) || !queryInt32(w, r, "limit", &req.Limit) || !queryInt32(w, r, "page", &req.Page) {
+		return
+	}
+	s.invoke(w, r, pb.RelationService_GetFollowers_FullMethodName, req, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.api.GetFollowers(ctx, req.(*pb.GetFollowersRequest))
+	})
+}
+
+func (s *Server) getFollowees(w http.ResponseWriter, r *http.Request) {
+	req := &pb.GetFolloweesRequest{}
+	if !pathInt64(w, r, "follower_id", &req.FollowerId) || !queryInt32(w, r, "limit", &req.Limit) || !queryInt32(w, r, "page", &req.Page) {
+		return
+	}
+	s.invoke(w, r, pb.RelationService_GetFollowees_FullMethodName, req, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.api.GetFollowees(ctx, req.(*pb.GetFolloweesRequest))
+	})
+}
+
+// pathInt64 parses a path parameter and writes a field violation when it is not an integer.
+// Range checks are left to the gRPC handler validation.
+func pathInt64(w http.ResponseWriter, r *http.Request, name string, dst *int64) bool {
+	value, err := strconv.ParseInt(r.PathValue(name), 10, 64)
+	if err != nil {
+		writeError(w, errmapper.InvalidField(name, "must be a 64-bit integer"))
+		return false
+	}
+	*dst = value
+	return true
+}
+
+// queryInt32 parses an optional query parameter, a missing one is left as zero
+func queryInt32(w http.ResponseWriter, r *http.Request, name string, dst *int32) bool {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return true
+	}
+	value, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil {
+		writeError(w, errmapper.InvalidField(name, "must be a 32-bit integer"))
+		return false
+	}
+	*dst = int32(value)
+	return true
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	_ "embed"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	ports "pinstack-relation-service/internal/domain/ports/output"
+
+	pb "github.com/soloda1/pinstack-proto-definitions/gen/go/pinstack-proto-definitions/relation/v1"
+	"google.golang.org/grpc"
+)
+
+//go:embed openapi.json
+var openAPIDocument []byte
+
+// Server exposes the relation API as HTTP/JSON. Every call is passed through the
+// given interceptor chain to the gRPC implementation, so authentication, rate limits,
+// validation and error mapping are shared with the gRPC transport.
+type Server struct {
+	api         pb.RelationServiceServer
+	interceptor grpc.UnaryServerInterceptor
+	handler     http.Handler
+	server      *http.Server
+	tlsConfig   *tls.Config
+	address     string
+	port        int
+	log         ports.Logger
+}
+
+// NewServer creates the REST gateway. A nil interceptor calls the API directly and a nil
+// tlsConfig serves plaintext HTTP.
+func NewServer(api pb.RelationServiceServer, interceptor grpc.UnaryServerInterceptor, tlsConfig *tls.Config, address string, port int, log ports.Logger) *Server {
+	s := &Server{
+		api:         api,
+		interceptor: interceptor,
+		tlsConfig:   tlsConfig,
+		address:     address,
+		port:        port,
+		log:         log,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/users/{follower_id}/followees/{followee_id}", s.follow)
+	mux.HandleFunc("DELETE /v1/users/{follower_id}/followees/{followee_id}", s.unfollow)
+	mux.HandleFunc("GET /v1/users/{followee_id}/followers", s.getFollowers)
+	mux.HandleFunc("GET /v1/users/{follower_id}/followees", s.getFollowees)
+	mux.HandleFunc("GET /openapi.json", s.openAPI)
+	s.handler = mux
+
+	return s
+}
+
+func (s *Server) Handler() http.Handler {
+	return s.handler
+}
+
+func (s *Server) Run() error {
+	addr := fmt.Sprintf("%s:%d", s.address, s.port)
+
+	s.server = &http.Server{
+		Addr:              addr,
+		Handler:           s.handler,
+		TLSConfig:         s.tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	s.log.Info("Starting REST gateway", slog.String("address", addr), slog.Bool("tls", s.tlsConfig != nil))
+
+	var err error
+	if s.tlsConfig != nil {
+		// The certificates come from TLSConfig, so no files are passed
+		err = s.server.ListenAndServeTLS("", "")
+	} else {
+		err = s.server.ListenAndServe()
+	}
+	if err != nil && err != http.ErrServerClosed {
+		return fmt.Errorf("rest gateway error: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	if s.server == nil {
+		return nil
+	}
+
+	s.log.Info("Shutting down REST gateway")
+	return s.server.Shutdown(ctx)
+}
+
+func (s *Server) openAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(openAPIDocument)
+}
@@ -0,0 +1,282 @@
+package rest_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+	follow_grpc "pinstack-relation-service/internal/infrastructure/inbound/grpc"
+	"pinstack-relation-service/internal/infrastructure/inbound/middleware"
+	"pinstack-relation-service/internal/infrastructure/inbound/rest"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/utils"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+type errorBody struct {
+	Code    int32            `json:"code"`
+	Message string           `json:"message"`
+	Details []map[string]any `json:"details"`
+}
+
+func (b errorBody) detail(typeName string) map[string]any {
+	for _, d := range b.Details {
+		if d["@type"] == "type.googleapis.com/"+typeName {
+			return d
+		}
+	}
+	return nil
+}
+
+func newTestServer(t *testing.T, interceptor grpc.UnaryServerInterceptor) (*mocks.FollowService, http.Handler) {
+	t.Helper()
+	log := logger.New("test")
+	service := mocks.NewFollowService(t)
+	api := follow_grpc.NewFollowGRPCService(service, log)
+	return service, rest.NewServer(api, interceptor, nil, "127.0.0.1", 0, log).Handler()
+}
+
+func TestServer_Routes(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		mockSetup    func(*mocks.FollowService)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:   "follow",
+			method: http.MethodPost,
+			target: "/v1/users/1/followees/2",
+			mockSetup: func(s *mocks.FollowService) {
+				s.On("Follow", mock.Anything, int64(1), int64(2)).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{}`,
+		},
+		{
+			name:   "unfollow",
+			method: http.MethodDelete,
+			target: "/v1/users/1/followees/2",
+			mockSetup: func(s *mocks.FollowService) {
+				s.On("Unfollow", mock.Anything, int64(1), int64(2)).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{}`,
+		},
+		{
+			name:   "get followers",
+			method: http.MethodGet,
+			target: "/v1/users/1/followers?limit=10&page=1",
+			mockSetup: func(s *mocks.FollowService) {
+				s.On("GetFollowers", mock.Anything, int64(1), int32(10), int32(1)).Return([]*model.User{
+					{ID: 2, Username: "user2", AvatarURL: utils.StringPtr("avatar2.jpg")},
+				}, int64(1), nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"followers":[{"follower_id":"2","username":"user2","avatar_url":"avatar2.jpg"}],"total":"1"}`,
+		},
+		{
+			name:   "get followees",
+			method: http.MethodGet,
+			target: "/v1/users/2/followees?limit=5&page=2",
+			mockSetup: func(s *mocks.FollowService) {
+				s.On("GetFollowees", mock.Anything, int64(2), int32(5), int32(2)).Return([]*model.User{}, int64(0), nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `{"followees":[],"total":"0"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, handler := newTestServer(t, middleware.UnaryRequestIDInterceptor(logger.New("test")))
+			tt.mockSetup(service)
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			assert.NotEmpty(t, rec.Header().Get(middleware.RequestIDHeader))
+			assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+		})
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		mockSetup      func(*mocks.FollowService)
+		expectedCode   int
+		expectedGRPC   codes.Code
+		expectedReason string
+		expectedField  string
+	}{
+		{
+			name:           "malformed path parameter",
+			method:         http.MethodPost,
+			target:         "/v1/users/abc/followees/2",
+			expectedCode:   http.StatusBadRequest,
+			expectedGRPC:   codes.InvalidArgument,
+			expectedReason: "VALIDATION_FAILED",
+			expectedField:  "follower_id",
+		},
+		{
+			name:           "malformed query parameter",
+			method:         http.MethodGet,
+			target:         "/v1/users/1/followers?limit=ten&page=1",
+			expectedCode:   http.StatusBadRequest,
+			expectedGRPC:   codes.InvalidArgument,
+			expectedReason: "VALIDATION_FAILED",
+			expectedField:  "limit",
+		},
+		{
+			name:           "validation uses the grpc handler rules",
+			method:         http.MethodGet,
+			target:         "/v1/users/1/followees?limit=500&page=1",
+			expectedCode:   http.StatusBadRequest,
+			expectedGRPC:   codes.InvalidArgument,
+			expectedReason: "VALIDATION_FAILED",
+			expectedField:  "limit",
+		},
+		{
+			name:   "self follow",
+			method: http.MethodPost,
+			target: "/v1/users/1/followees/1",
+			mockSetup: func(s *mocks.FollowService) {
+				s.On("Follow", mock.Anything, int64(1), int64(1)).Return(custom_errors.ErrSelfFollow)
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedGRPC:   codes.InvalidArgument,
+			expectedReason: "SELF_FOLLOW",
+		},
+		{
+			name:   "already following",
+			method: http.MethodPost,
+			target: "/v1/users/1/followees/2",
+			mockSetup: func(s *mocks.FollowService) {
+				s.On("Follow", mock.Anything, int64(1), int64(2)).Return(custom_errors.ErrAlreadyFollowing)
+			},
+			expectedCode:   http.StatusConflict,
+			expectedGRPC:   codes.AlreadyExists,
+			expectedReason: "ALREADY_FOLLOWING",
+		},
+		{
+			name:   "relation not found",
+			method: http.MethodDelete,
+			target: "/v1/users/1/followees/2",
+			mockSetup: func(s *mocks.FollowService) {
+				s.On("Unfollow", mock.Anything, int64(1), int64(2)).Return(custom_errors.ErrFollowRelationNotFound)
+			},
+			expectedCode:   http.StatusNotFound,
+			expectedGRPC:   codes.NotFound,
+			expectedReason: "FOLLOW_RELATION_NOT_FOUND",
+		},
+		{
+			name:   "forbidden",
+			method: http.MethodDelete,
+			target: "/v1/users/1/followees/2",
+			mockSetup: func(s *mocks.FollowService) {
+				s.On("Unfollow", mock.Anything, int64(1), int64(2)).Return(custom_errors.ErrForbidden)
+			},
+			expectedCode:   http.StatusForbidden,
+			expectedGRPC:   codes.PermissionDenied,
+			expectedReason: "FORBIDDEN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, handler := newTestServer(t, nil)
+			if tt.mockSetup != nil {
+				tt.mockSetup(service)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+
+			var body errorBody
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, int32(tt.expectedGRPC), body.Code)
+
+			info := body.detail("google.rpc.ErrorInfo")
+			require.NotNil(t, info)
+			assert.Equal(t, tt.expectedReason, info["reason"])
+			assert.Equal(t, errmapper.Domain, info["domain"])
+
+			if tt.expectedField != "" {
+				badRequest := body.detail("google.rpc.BadRequest")
+				require.NotNil(t, badRequest)
+				violations := badRequest["field_violations"].([]any)
+				require.Len(t, violations, 1)
+				assert.Equal(t, tt.expectedField, violations[0].(map[string]any)["field"])
+			}
+		})
+	}
+}
+
+func TestServer_InterceptorHeadersAndMetadata(t *testing.T) {
+	var gotAuthorization []string
+	rateLimited := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		gotAuthorization = md.Get("authorization")
+		assert.Equal(t, "/relation.v1.RelationService/Follow", info.FullMethod)
+
+		_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", "3"))
+		return nil, errmapper.Error(errmapper.WithRetryAfter(custom_errors.ErrRateLimitExceeded, 3*time.Second))
+	}
+	_, handler := newTestServer(t, rateLimited)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/users/1/followees/2", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, []string{"Bearer token"}, gotAuthorization)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "3", rec.Header().Get("Retry-After"))
+
+	var body errorBody
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	retry := body.detail("google.rpc.RetryInfo")
+	require.NotNil(t, retry)
+	assert.Equal(t, "3s", retry["retry_delay"])
+}
+
+func TestServer_OpenAPI(t *testing.T) {
+	_, handler := newTestServer(t, nil)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var document struct {
+		OpenAPI string                    `json:"openapi"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &document))
+	assert.Equal(t, "3.0.3", document.OpenAPI)
+	assert.Contains(t, document.Paths["/v1/users/{follower_id}/followees/{followee_id}"], "post")
+	assert.Contains(t, document.Paths["/v1/users/{follower_id}/followees/{followee_id}"], "delete")
+	assert.Contains(t, document.Paths["/v1/users/{followee_id}/followers"], "get")
+	assert.Contains(t, document.Paths["/v1/users/{follower_id}/followees"], "get")
+}
@@ -0,0 +1,142 @@
+package rest
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"pinstack-relation-service/internal/infrastructure/inbound/errmapper"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var marshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// invoke runs the call through the interceptor chain as if it arrived over gRPC.
+// Request headers become incoming metadata and headers set by interceptors
+// (request id, rate limit) are copied to the HTTP response.
+func (s *Server) invoke(w http.ResponseWriter, r *http.Request, method string, req proto.Message, handler grpc.UnaryHandler) {
+	stream := &headerStream{method: method, header: metadata.MD{}}
+	ctx := grpc.NewContextWithServerTransportStream(r.Context(), stream)
+	ctx = metadata.NewIncomingContext(ctx, incomingMetadata(r))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: remoteAddr(r.RemoteAddr)})
+
+	var resp interface{}
+	var err error
+	if s.interceptor != nil {
+		resp, err = s.interceptor(ctx, req, &grpc.UnaryServerInfo{Server: s.api, FullMethod: method}, handler)
+	} else {
+		resp, err = handler(ctx, req)
+	}
+
+	for key, values := range stream.headers() {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeMessage(w, http.StatusOK, resp.(proto.Message))
+}
+
+func writeMessage(w http.ResponseWriter, code int, msg proto.Message) {
+	body, err := marshaler.Marshal(msg)
+	if err != nil {
+		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(body)
+}
+
+// writeError renders the error as a google.rpc.Status, the same body grpc-gateway produces
+func writeError(w http.ResponseWriter, err error) {
+	st := errmapper.Status(err)
+	writeMessage(w, httpStatusFromCode(st.Code()), st.Proto())
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func incomingMetadata(r *http.Request) metadata.MD {
+	md := make(metadata.MD, len(r.Header))
+	for key, values := range r.Header {
+		md[strings.ToLower(key)] = values
+	}
+	return md
+}
+
+// headerStream collects the headers interceptors set with grpc.SetHeader
+type headerStream struct {
+	method string
+	mu     sync.Mutex
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string {
+	return s.method
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *headerStream) SetTrailer(metadata.MD) error {
+	return nil
+}
+
+func (s *headerStream) headers() metadata.MD {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.header.Copy()
+}
+
+// remoteAddr lets the peer based rate limit key work for HTTP clients
+type remoteAddr string
+
+func (a remoteAddr) Network() string { return "tcp" }
+func (a remoteAddr) String() string  { return string(a) }
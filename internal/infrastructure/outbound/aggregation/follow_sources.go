@@ -0,0 +1,74 @@
+package aggregation
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/domain/ports/output/repository"
+)
+
+// FollowSourcesWorker periodically recounts the daily follows per source. Every run
+// recounts the current UTC day and the one before it, so yesterday gets its final count
+// during the first runs of today.
+type FollowSourcesWorker struct {
+	repo     repository.FollowStatsRepository
+	interval time.Duration
+	log      ports.Logger
+	wg       *sync.WaitGroup
+	stopChan chan struct{}
+}
+
+func NewFollowSourcesWorker(
+	repo repository.FollowStatsRepository,
+	interval time.Duration,
+	log ports.Logger,
+) *FollowSourcesWorker {
+	return &FollowSourcesWorker{
+		repo:     repo,
+		interval: interval,
+		log:      log,
+		wg:       &sync.WaitGroup{},
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (w *FollowSourcesWorker) Start(ctx context.Context) {
+	w.log.Info("Starting follow sources aggregation worker", slog.Duration("interval", w.interval))
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.aggregate(ctx, time.Now())
+			case <-w.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (w *FollowSourcesWorker) Stop() {
+	close(w.stopChan)
+	w.wg.Wait()
+	w.log.Info("Follow sources aggregation worker stopped")
+}
+
+func (w *FollowSourcesWorker) aggregate(ctx context.Context, now time.Time) {
+	for _, day := range []time.Time{now.AddDate(0, 0, -1), now} {
+		if err := w.repo.AggregateDay(ctx, day); err != nil {
+			w.log.Error("Failed to aggregate follow sources",
+				slog.String("day", day.UTC().Format(time.DateOnly)),
+				slog.String("error", err.Error()))
+			return
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package aggregation
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/domain/ports/output/repository"
+)
+
+// FollowerGrowthWorker periodically recounts the daily follower growth of every user from
+// the relation history. Like FollowSourcesWorker it recounts the current UTC day and the one
+// before it, so growth lags the history by at most one interval.
+type FollowerGrowthWorker struct {
+	repo     repository.FollowerGrowthRepository
+	interval time.Duration
+	log      ports.Logger
+	wg       *sync.WaitGroup
+	stopChan chan struct{}
+}
+
+func NewFollowerGrowthWorker(
+	repo repository.FollowerGrowthRepository,
+	interval time.Duration,
+	log ports.Logger,
+) *FollowerGrowthWorker {
+	return &FollowerGrowthWorker{
+		repo:     repo,
+		interval: interval,
+		log:      log,
+		wg:       &sync.WaitGroup{},
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (w *FollowerGrowthWorker) Start(ctx context.Context) {
+	w.log.Info("Starting follower growth aggregation worker", slog.Duration("interval", w.interval))
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.aggregate(ctx, time.Now())
+			case <-w.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (w *FollowerGrowthWorker) Stop() {
+	close(w.stopChan)
+	w.wg.Wait()
+	w.log.Info("Follower growth aggregation worker stopped")
+}
+
+func (w *FollowerGrowthWorker) aggregate(ctx context.Context, now time.Time) {
+	for _, day := range []time.Time{now.AddDate(0, 0, -1), now} {
+		if err := w.repo.AggregateDay(ctx, day); err != nil {
+			w.log.Error("Failed to aggregate follower growth",
+				slog.String("day", day.UTC().Format(time.DateOnly)),
+				slog.String("error", err.Error()))
+			return
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+)
+
+type entry struct {
+	suggestions []model.Suggestion
+	expiresAt   time.Time
+}
+
+// SuggestionCache keeps ranked suggestions per user for a fixed TTL. Entries are
+// process-local, so replicas may serve different rankings until they expire.
+type SuggestionCache struct {
+	mu      sync.Mutex
+	entries map[int64]entry
+	ttl     time.Duration
+	now     func() time.Time
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewSuggestionCache creates the cache and starts a janitor that drops expired
+// entries every cleanupInterval.
+func NewSuggestionCache(ttl, cleanupInterval time.Duration) *SuggestionCache {
+	c := &SuggestionCache{
+		entries: make(map[int64]entry),
+		ttl:     ttl,
+		now:     time.Now,
+		stop:    make(chan struct{}),
+	}
+
+	c.wg.Add(1)
+	go c.cleanup(cleanupInterval)
+
+	return c
+}
+
+func (c *SuggestionCache) Get(_ context.Context, userID int64) ([]model.Suggestion, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[userID]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.expiresAt) {
+		delete(c.entries, userID)
+		return nil, false
+	}
+	return e.suggestions, true
+}
+
+func (c *SuggestionCache) Set(_ context.Context, userID int64, suggestions []model.Suggestion) {
+	stored := make([]model.Suggestion, len(suggestions))
+	copy(stored, suggestions)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[userID] = entry{suggestions: stored, expiresAt: c.now().Add(c.ttl)}
+}
+
+func (c *SuggestionCache) Invalidate(_ context.Context, userID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, userID)
+}
+
+func (c *SuggestionCache) Close() {
+	close(c.stop)
+	c.wg.Wait()
+}
+
+func (c *SuggestionCache) cleanup(interval time.Duration) {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			now := c.now()
+			c.mu.Lock()
+			for userID, e := range c.entries {
+				if !now.Before(e.expiresAt) {
+					delete(c.entries, userID)
+				}
+			}
+			c.mu.Unlock()
+		}
+	}
+}
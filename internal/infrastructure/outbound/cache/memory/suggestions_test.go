@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestionCache_GetSet(t *testing.T) {
+	cache := NewSuggestionCache(time.Minute, time.Hour)
+	t.Cleanup(cache.Close)
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	suggestions := []model.Suggestion{{UserID: 3, MutualCount: 2, Score: 1.5}}
+
+	_, ok := cache.Get(ctx, 1)
+	assert.False(t, ok)
+
+	cache.Set(ctx, 1, suggestions)
+	suggestions[0].UserID = 42
+
+	got, ok := cache.Get(ctx, 1)
+	require.True(t, ok)
+	assert.Equal(t, []model.Suggestion{{UserID: 3, MutualCount: 2, Score: 1.5}}, got, "the cache keeps its own copy")
+
+	_, ok = cache.Get(ctx, 2)
+	assert.False(t, ok, "entries are per user")
+
+	now = now.Add(time.Minute)
+	_, ok = cache.Get(ctx, 1)
+	assert.False(t, ok, "entries expire after the TTL")
+}
+
+func TestSuggestionCache_Invalidate(t *testing.T) {
+	cache := NewSuggestionCache(time.Minute, time.Hour)
+	t.Cleanup(cache.Close)
+
+	ctx := context.Background()
+	cache.Set(ctx, 1, []model.Suggestion{{UserID: 3}})
+	cache.Invalidate(ctx, 1)
+
+	_, ok := cache.Get(ctx, 1)
+	assert.False(t, ok)
+}
+
+func TestSuggestionCache_CleanupRemovesExpiredEntries(t *testing.T) {
+	cache := NewSuggestionCache(time.Millisecond, 10*time.Millisecond)
+	t.Cleanup(cache.Close)
+
+	cache.Set(context.Background(), 1, []model.Suggestion{{UserID: 3}})
+
+	assert.Eventually(t, func() bool {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+		return len(cache.entries) == 0
+	}, time.Second, 10*time.Millisecond)
+}
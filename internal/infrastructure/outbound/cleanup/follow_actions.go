@@ -0,0 +1,78 @@
+package cleanup
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/domain/ports/output/repository"
+)
+
+// FollowActionsWorker periodically removes follow actions that are older than
+// any anti-spam rule looks back, keeping the follow_actions table small.
+type FollowActionsWorker struct {
+	repo      repository.FollowActionRepository
+	retention time.Duration
+	interval  time.Duration
+	log       ports.Logger
+	wg        *sync.WaitGroup
+	stopChan  chan struct{}
+}
+
+func NewFollowActionsWorker(
+	repo repository.FollowActionRepository,
+	retention time.Duration,
+	interval time.Duration,
+	log ports.Logger,
+) *FollowActionsWorker {
+	return &FollowActionsWorker{
+		repo:      repo,
+		retention: retention,
+		interval:  interval,
+		log:       log,
+		wg:        &sync.WaitGroup{},
+		stopChan:  make(chan struct{}),
+	}
+}
+
+func (w *FollowActionsWorker) Start(ctx context.Context) {
+	w.log.Info("Starting follow actions cleanup worker",
+		slog.Duration("retention", w.retention),
+		slog.Duration("interval", w.interval))
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.cleanup(ctx)
+			case <-w.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (w *FollowActionsWorker) Stop() {
+	close(w.stopChan)
+	w.wg.Wait()
+	w.log.Info("Follow actions cleanup worker stopped")
+}
+
+func (w *FollowActionsWorker) cleanup(ctx context.Context) {
+	deleted, err := w.repo.DeleteBefore(ctx, time.Now().Add(-w.retention))
+	if err != nil {
+		w.log.Error("Failed to clean up follow actions", slog.String("error", err.Error()))
+		return
+	}
+	if deleted > 0 {
+		w.log.Info("Old follow actions removed", slog.Int64("deleted", deleted))
+	}
+}
@@ -0,0 +1,73 @@
+package cleanup
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/domain/ports/output/repository"
+)
+
+// IdempotencyKeysWorker periodically removes expired idempotency keys. Expired keys are
+// already ignored on lookup, removing them only keeps the table small.
+type IdempotencyKeysWorker struct {
+	repo     repository.IdempotencyRepository
+	interval time.Duration
+	log      ports.Logger
+	wg       *sync.WaitGroup
+	stopChan chan struct{}
+}
+
+func NewIdempotencyKeysWorker(
+	repo repository.IdempotencyRepository,
+	interval time.Duration,
+	log ports.Logger,
+) *IdempotencyKeysWorker {
+	return &IdempotencyKeysWorker{
+		repo:     repo,
+		interval: interval,
+		log:      log,
+		wg:       &sync.WaitGroup{},
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (w *IdempotencyKeysWorker) Start(ctx context.Context) {
+	w.log.Info("Starting idempotency keys cleanup worker", slog.Duration("interval", w.interval))
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.cleanup(ctx)
+			case <-w.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (w *IdempotencyKeysWorker) Stop() {
+	close(w.stopChan)
+	w.wg.Wait()
+	w.log.Info("Idempotency keys cleanup worker stopped")
+}
+
+func (w *IdempotencyKeysWorker) cleanup(ctx context.Context) {
+	deleted, err := w.repo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		w.log.Error("Failed to clean up idempotency keys", slog.String("error", err.Error()))
+		return
+	}
+	if deleted > 0 {
+		w.log.Info("Expired idempotency keys removed", slog.Int64("deleted", deleted))
+	}
+}
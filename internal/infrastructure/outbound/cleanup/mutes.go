@@ -0,0 +1,73 @@
+package cleanup
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/domain/ports/output/repository"
+)
+
+// MutesWorker periodically removes expired mutes. Expired mutes are already ignored
+// on every read, removing them only keeps the table small.
+type MutesWorker struct {
+	repo     repository.MuteRepository
+	interval time.Duration
+	log      ports.Logger
+	wg       *sync.WaitGroup
+	stopChan chan struct{}
+}
+
+func NewMutesWorker(
+	repo repository.MuteRepository,
+	interval time.Duration,
+	log ports.Logger,
+) *MutesWorker {
+	return &MutesWorker{
+		repo:     repo,
+		interval: interval,
+		log:      log,
+		wg:       &sync.WaitGroup{},
+		stopChan: make(chan struct{}),
+	}
+}
+
+func (w *MutesWorker) Start(ctx context.Context) {
+	w.log.Info("Starting mutes cleanup worker", slog.Duration("interval", w.interval))
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				w.cleanup(ctx)
+			case <-w.stopChan:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (w *MutesWorker) Stop() {
+	close(w.stopChan)
+	w.wg.Wait()
+	w.log.Info("Mutes cleanup worker stopped")
+}
+
+func (w *MutesWorker) cleanup(ctx context.Context) {
+	deleted, err := w.repo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		w.log.Error("Failed to clean up expired mutes", slog.String("error", err.Error()))
+		return
+	}
+	if deleted > 0 {
+		w.log.Info("Expired mutes removed", slog.Int64("deleted", deleted))
+	}
+}
@@ -0,0 +1,65 @@
+package post_client
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	ports "pinstack-relation-service/internal/domain/ports/output"
+)
+
+// FakeClient stands in for the post service until it exposes boards and tags over gRPC.
+// When acceptUnknown is set every positive id exists, which is what local development
+// needs; otherwise only the ids registered with AddBoard and AddTag do.
+type FakeClient struct {
+	mu            sync.RWMutex
+	boards        map[int64]struct{}
+	tags          map[int64]struct{}
+	acceptUnknown bool
+	log           ports.Logger
+}
+
+func NewFakeClient(log ports.Logger, acceptUnknown bool) *FakeClient {
+	return &FakeClient{
+		boards:        make(map[int64]struct{}),
+		tags:          make(map[int64]struct{}),
+		acceptUnknown: acceptUnknown,
+		log:           log,
+	}
+}
+
+func (c *FakeClient) AddBoard(boardID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.boards[boardID] = struct{}{}
+}
+
+func (c *FakeClient) AddTag(tagID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tags[tagID] = struct{}{}
+}
+
+func (c *FakeClient) BoardExists(_ context.Context, boardID int64) (bool, error) {
+	exists := c.exists(c.boards, boardID)
+	c.log.Debug("Fake board lookup", slog.Int64("board_id", boardID), slog.Bool("exists", exists))
+	return exists, nil
+}
+
+func (c *FakeClient) TagExists(_ context.Context, tagID int64) (bool, error) {
+	exists := c.exists(c.tags, tagID)
+	c.log.Debug("Fake tag lookup", slog.Int64("tag_id", tagID), slog.Bool("exists", exists))
+	return exists, nil
+}
+
+func (c *FakeClient) exists(known map[int64]struct{}, id int64) bool {
+	if id <= 0 {
+		return false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if _, ok := known[id]; ok {
+		return true
+	}
+	return c.acceptUnknown
+}
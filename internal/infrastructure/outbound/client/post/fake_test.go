@@ -0,0 +1,45 @@
+package post_client
+
+import (
+	"context"
+	"testing"
+
+	"pinstack-relation-service/internal/infrastructure/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeClient(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("strict mode only knows registered ids", func(t *testing.T) {
+		client := NewFakeClient(logger.New("test"), false)
+		client.AddBoard(7)
+		client.AddTag(9)
+
+		exists, err := client.BoardExists(ctx, 7)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = client.BoardExists(ctx, 9)
+		require.NoError(t, err)
+		assert.False(t, exists, "boards and tags are separate namespaces")
+
+		exists, err = client.TagExists(ctx, 9)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("permissive mode accepts any positive id", func(t *testing.T) {
+		client := NewFakeClient(logger.New("test"), true)
+
+		exists, err := client.BoardExists(ctx, 42)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = client.TagExists(ctx, 0)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
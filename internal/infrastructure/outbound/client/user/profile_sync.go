@@ -0,0 +1,145 @@
+package user_client
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"pinstack-relation-service/internal/domain/ports/output/repository"
+	user_port "pinstack-relation-service/internal/domain/ports/output/user_client"
+)
+
+const profileUpsertTimeout = 5 * time.Second
+
+// ProfileSyncingClient wraps a user service client and stores every profile it fetches
+// in the local profile copy used by follower search. Profiles are queued and written in
+// batches off the request path; when the queue is full a profile is dropped, the next
+// fetch or the backfill batch picks it up again.
+type ProfileSyncingClient struct {
+	user_port.Client
+	profiles      repository.ProfileRepository
+	log           ports.Logger
+	batchSize     int
+	flushInterval time.Duration
+	pending       chan *model.User
+	stop          chan struct{}
+	wg            sync.WaitGroup
+}
+
+func NewProfileSyncingClient(client user_port.Client, profiles repository.ProfileRepository, log ports.Logger, batchSize int, flushInterval time.Duration) *ProfileSyncingClient {
+	c := &ProfileSyncingClient{
+		Client:        client,
+		profiles:      profiles,
+		log:           log,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		pending:       make(chan *model.User, batchSize*4),
+		stop:          make(chan struct{}),
+	}
+	c.wg.Add(1)
+	go c.run()
+	return c
+}
+
+func (c *ProfileSyncingClient) GetUser(ctx context.Context, id int64) (*model.User, error) {
+	user, err := c.Client.GetUser(ctx, id)
+	if err == nil {
+		c.record(user)
+	}
+	return user, err
+}
+
+func (c *ProfileSyncingClient) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
+	user, err := c.Client.GetUserByUsername(ctx, username)
+	if err == nil {
+		c.record(user)
+	}
+	return user, err
+}
+
+func (c *ProfileSyncingClient) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	user, err := c.Client.GetUserByEmail(ctx, email)
+	if err == nil {
+		c.record(user)
+	}
+	return user, err
+}
+
+func (c *ProfileSyncingClient) GetUsers(ctx context.Context, ids []int64) (map[int64]*model.User, error) {
+	users, err := c.Client.GetUsers(ctx, ids)
+	if err == nil {
+		for _, user := range users {
+			c.record(user)
+		}
+	}
+	return users, err
+}
+
+// Close writes the queued profiles and stops the background writer
+func (c *ProfileSyncingClient) Close() {
+	close(c.stop)
+	c.wg.Wait()
+}
+
+func (c *ProfileSyncingClient) record(user *model.User) {
+	if user == nil {
+		return
+	}
+	select {
+	case c.pending <- user:
+	default:
+		c.log.Debug("Profile sync queue is full, dropping profile", slog.Int64("user_id", user.ID))
+	}
+}
+
+func (c *ProfileSyncingClient) run() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(c.flushInterval)
+	defer ticker.Stop()
+
+	batch := make(map[int64]*model.User, c.batchSize)
+	for {
+		select {
+		case user := <-c.pending:
+			batch[user.ID] = user
+			if len(batch) >= c.batchSize {
+				c.flush(batch)
+			}
+		case <-ticker.C:
+			c.flush(batch)
+		case <-c.stop:
+			for {
+				select {
+				case user := <-c.pending:
+					batch[user.ID] = user
+				default:
+					c.flush(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+// flush writes and empties the batch, keeping only the latest copy of each profile
+func (c *ProfileSyncingClient) flush(batch map[int64]*model.User) {
+	if len(batch) == 0 {
+		return
+	}
+
+	users := make([]*model.User, 0, len(batch))
+	for _, user := range batch {
+		users = append(users, user)
+	}
+	clear(batch)
+
+	ctx, cancel := context.WithTimeout(context.Background(), profileUpsertTimeout)
+	defer cancel()
+	if err := c.profiles.Upsert(ctx, users); err != nil {
+		c.log.Warn("Failed to sync user profiles", slog.Int("count", len(users)), slog.String("error", err.Error()))
+	}
+}
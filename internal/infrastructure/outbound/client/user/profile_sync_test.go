@@ -0,0 +1,81 @@
+package user_client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+	infra_logger "pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileSyncingClient(t *testing.T) {
+	t.Run("fetched profiles are written on close", func(t *testing.T) {
+		mockClient := mocks.NewClient(t)
+		mockProfiles := mocks.NewProfileRepository(t)
+		ctx := context.Background()
+		user := &model.User{ID: 1, Username: "anna"}
+
+		mockClient.On("GetUser", ctx, int64(1)).Return(user, nil).Twice()
+		mockClient.On("GetUser", ctx, int64(2)).Return(nil, errors.New("not found")).Once()
+		mockProfiles.On("Upsert", mock.Anything, []*model.User{user}).Return(nil).Once()
+
+		client := NewProfileSyncingClient(mockClient, mockProfiles, infra_logger.New("test"), 10, time.Hour)
+		got, err := client.GetUser(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, user, got)
+		_, err = client.GetUser(ctx, 1)
+		require.NoError(t, err)
+		_, err = client.GetUser(ctx, 2)
+		require.Error(t, err)
+
+		client.Close()
+	})
+
+	t.Run("full batch is written without waiting for the interval", func(t *testing.T) {
+		mockClient := mocks.NewClient(t)
+		mockProfiles := mocks.NewProfileRepository(t)
+		ctx := context.Background()
+		written := make(chan struct{})
+
+		mockClient.On("GetUserByUsername", ctx, "anna").Return(&model.User{ID: 1, Username: "anna"}, nil)
+		mockClient.On("GetUserByEmail", ctx, "bob@example.com").Return(&model.User{ID: 2, Username: "bob"}, nil)
+		mockProfiles.On("Upsert", mock.Anything, mock.MatchedBy(func(users []*model.User) bool { return len(users) == 2 })).
+			Run(func(mock.Arguments) { close(written) }).
+			Return(nil).
+			Once()
+
+		client := NewProfileSyncingClient(mockClient, mockProfiles, infra_logger.New("test"), 2, time.Hour)
+		defer client.Close()
+		_, _ = client.GetUserByUsername(ctx, "anna")
+		_, _ = client.GetUserByEmail(ctx, "bob@example.com")
+
+		select {
+		case <-written:
+		case <-time.After(time.Second):
+			t.Fatal("batch was not written")
+		}
+	})
+	t.Run("every user of a batch lookup is recorded", func(t *testing.T) {
+		mockClient := mocks.NewClient(t)
+		mockProfiles := mocks.NewProfileRepository(t)
+		ctx := context.Background()
+		users := map[int64]*model.User{1: {ID: 1, Username: "anna"}, 3: {ID: 3, Username: "carl"}}
+
+		mockClient.On("GetUsers", ctx, []int64{1, 2, 3}).Return(users, nil).Once()
+		mockProfiles.On("Upsert", mock.Anything, mock.MatchedBy(func(written []*model.User) bool { return len(written) == 2 })).Return(nil).Once()
+
+		client := NewProfileSyncingClient(mockClient, mockProfiles, infra_logger.New("test"), 10, time.Hour)
+		got, err := client.GetUsers(ctx, []int64{1, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, users, got)
+
+		client.Close()
+	})
+}
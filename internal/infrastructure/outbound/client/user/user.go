@@ -2,7 +2,9 @@ package user_client
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"sync"
 
 	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
 
@@ -15,6 +17,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// getUsersConcurrency bounds the GetUser calls GetUsers runs in parallel
+const getUsersConcurrency = 8
+
 type UserClient struct {
 	client pb.UserServiceClient
 	log    ports.Logger
@@ -74,3 +79,47 @@ func (u *UserClient) GetUserByEmail(ctx context.Context, email string) (*model.U
 	u.log.Info("Successfully got user by email", slog.String("email", email))
 	return model.UserFromProto(resp), nil
 }
+
+// GetUsers looks up many users at once. The user service has no batch endpoint, so the
+// lookups run in parallel; the first failure other than a missing user cancels the rest.
+func (u *UserClient) GetUsers(ctx context.Context, ids []int64) (map[int64]*model.User, error) {
+	u.log.Info("Getting users by IDs", slog.Int("count", len(ids)))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		users    = make(map[int64]*model.User, len(ids))
+		sem      = make(chan struct{}, getUsersConcurrency)
+	)
+	for _, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id int64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			user, err := u.GetUser(ctx, id)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				users[id] = user
+			case errors.Is(err, custom_errors.ErrUserNotFound):
+			case firstErr == nil:
+				firstErr = err
+				cancel()
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return users, nil
+}
@@ -0,0 +1,21 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderCarrier(t *testing.T) {
+	headers := headerCarrier{{Key: "event_id", Value: []byte("1")}}
+
+	headers.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	headers.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203332-01")
+
+	assert.Equal(t, "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203332-01", headers.Get("traceparent"))
+	assert.Equal(t, "1", headers.Get("event_id"))
+	assert.Empty(t, headers.Get("missing"))
+	assert.Equal(t, []string{"event_id", "traceparent"}, headers.Keys())
+	assert.Len(t, []kafka.Header(headers), 2)
+}
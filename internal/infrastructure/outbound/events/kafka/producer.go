@@ -9,9 +9,15 @@ import (
 	ports "pinstack-relation-service/internal/domain/ports/output"
 	kafka_port "pinstack-relation-service/internal/domain/ports/output/kafka"
 	"pinstack-relation-service/internal/infrastructure/config"
+	"pinstack-relation-service/internal/infrastructure/tracing"
 
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Producer struct {
@@ -19,6 +25,7 @@ type Producer struct {
 	topic    string
 	logger   ports.Logger
 	metrics  ports.MetricsProvider
+	tracer   trace.Tracer
 }
 
 func NewProducer(kafkaConfig config.Kafka, logger ports.Logger, metrics ports.MetricsProvider) (*Producer, error) {
@@ -49,23 +56,45 @@ func NewProducer(kafkaConfig config.Kafka, logger ports.Logger, metrics ports.Me
 		topic:    kafkaConfig.Topic,
 		logger:   logger,
 		metrics:  metrics,
+		tracer:   otel.Tracer("pinstack-relation-service/kafka"),
 	}, nil
 }
 
 func (p *Producer) SendMessage(ctx context.Context, event model.OutboxEvent) <-chan kafka_port.SendResult {
 	resultChan := make(chan kafka_port.SendResult)
 
+	log := p.logger
+	if event.RequestID != "" {
+		log = log.With(slog.String("request_id", event.RequestID))
+	}
+
 	go func() {
 		defer close(resultChan)
 
+		// The span continues the trace of the request that wrote the outbox row
+		spanCtx, span := p.tracer.Start(tracing.ExtractContext(ctx, event.TraceContext), p.topic+" publish",
+			trace.WithSpanKind(trace.SpanKindProducer),
+			trace.WithAttributes(
+				semconv.MessagingSystemKafka,
+				semconv.MessagingOperationTypeSend,
+				semconv.MessagingDestinationName(p.topic),
+				semconv.MessagingMessageID(fmt.Sprintf("%d", event.ID)),
+				attribute.String("messaging.event_type", string(event.EventType)),
+			))
+
 		var err error
 		defer func() {
 			p.metrics.IncrementKafkaMessages(p.topic, "send", err == nil)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
 		}()
 
 		payload, err := json.Marshal(event.Payload)
 		if err != nil {
-			p.logger.Error("Failed to marshal event payload", slog.String("error", err.Error()), slog.Int64("event_id", event.ID))
+			log.Error("Failed to marshal event payload", slog.String("error", err.Error()), slog.Int64("event_id", event.ID))
 			resultChan <- kafka_port.SendResult{EventID: event.ID, Error: err}
 			return
 		}
@@ -96,9 +125,17 @@ func (p *Producer) SendMessage(ctx context.Context, event model.OutboxEvent) <-c
 			},
 		}
 
+		if event.RequestID != "" {
+			message.Headers = append(message.Headers, kafka.Header{Key: "request_id", Value: []byte(event.RequestID)})
+		}
+
+		headers := headerCarrier(message.Headers)
+		otel.GetTextMapPropagator().Inject(spanCtx, &headers)
+		message.Headers = headers
+
 		err = p.producer.Produce(message, deliveryChan)
 		if err != nil {
-			p.logger.Error("Failed to produce message", slog.String("error", err.Error()), slog.Int64("event_id", event.ID))
+			log.Error("Failed to produce message", slog.String("error", err.Error()), slog.Int64("event_id", event.ID))
 			resultChan <- kafka_port.SendResult{EventID: event.ID, Error: err}
 			return
 		}
@@ -110,7 +147,7 @@ func (p *Producer) SendMessage(ctx context.Context, event model.OutboxEvent) <-c
 		case e := <-deliveryChan:
 			m, ok := e.(*kafka.Message)
 			if !ok {
-				p.logger.Error("Unexpected event type received on delivery channel",
+				log.Error("Unexpected event type received on delivery channel",
 					slog.String("event_type", fmt.Sprintf("%T", e)),
 					slog.Int64("event_id", event.ID))
 				err = custom_errors.ErrUnexpectedEventType
@@ -118,13 +155,13 @@ func (p *Producer) SendMessage(ctx context.Context, event model.OutboxEvent) <-c
 				return
 			}
 			if m.TopicPartition.Error != nil {
-				p.logger.Error("Message delivery failed",
+				log.Error("Message delivery failed",
 					slog.String("error", m.TopicPartition.Error.Error()),
 					slog.Int64("event_id", event.ID))
 				err = m.TopicPartition.Error
 				resultChan <- kafka_port.SendResult{EventID: event.ID, Error: err}
 			} else {
-				p.logger.Info("Message delivered successfully",
+				log.Info("Message delivered successfully",
 					slog.Int64("event_id", event.ID),
 					slog.String("topic", *m.TopicPartition.Topic),
 					slog.Int("partition", int(m.TopicPartition.Partition)),
@@ -78,6 +78,15 @@ var (
 		[]string{"operation", "status"},
 	)
 
+	// Rate limiting metrics
+	rateLimitedRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "relation_service_rate_limited_requests_total",
+			Help: "Total number of requests rejected by the rate limiter",
+		},
+		[]string{"method", "key_type"},
+	)
+
 	// System metrics
 	activeConnections = promauto.NewGauge(
 		prometheus.GaugeOpts{
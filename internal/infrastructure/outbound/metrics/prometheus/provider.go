@@ -60,6 +60,10 @@ func (p *PrometheusMetricsProvider) IncrementOutboxOperations(operation string,
 	outboxOperationsTotal.WithLabelValues(operation, status).Inc()
 }
 
+func (p *PrometheusMetricsProvider) IncrementRateLimitedRequests(method, keyType string) {
+	rateLimitedRequestsTotal.WithLabelValues(method, keyType).Inc()
+}
+
 func (p *PrometheusMetricsProvider) SetActiveConnections(count int) {
 	activeConnections.Set(float64(count))
 }
@@ -6,6 +6,7 @@ import (
 	model "pinstack-relation-service/internal/domain/models"
 	ports "pinstack-relation-service/internal/domain/ports/output"
 	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/internal/infrastructure/tracing"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -21,6 +22,10 @@ func NewOutboxRepository(db repository_postgres.PgDB, log ports.Logger, metrics
 	return &Repository{db: db, log: log, metrics: metrics}
 }
 
+func (r *Repository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
 func (r *Repository) AddEvent(ctx context.Context, outbox model.OutboxEvent) (err error) {
 	start := time.Now()
 	defer func() {
@@ -29,21 +34,36 @@ func (r *Repository) AddEvent(ctx context.Context, outbox model.OutboxEvent) (er
 		r.metrics.RecordDatabaseQueryDuration("outbox_add_event", time.Since(start))
 	}()
 
+	traceContext := outbox.TraceContext
+	if traceContext == nil {
+		traceContext = tracing.InjectContext(ctx)
+	}
+
+	requestID := outbox.RequestID
+	if requestID == "" {
+		requestID = model.RequestIDFromContext(ctx)
+	}
+
 	args := pgx.NamedArgs{
-		"aggregate_id": outbox.AggregateID,
-		"event_type":   outbox.EventType,
-		"payload":      outbox.Payload,
+		"request_id":    requestID,
+		"aggregate_id":  outbox.AggregateID,
+		"event_type":    outbox.EventType,
+		"payload":       outbox.Payload,
+		"trace_context": traceContext,
 	}
 
-	query := `INSERT INTO outbox (aggregate_id, event_type, payload) VALUES (@aggregate_id, @event_type, @payload)`
+	query := `
+		INSERT INTO outbox (aggregate_id, event_type, payload, trace_context, request_id)
+		VALUES (@aggregate_id, @event_type, @payload, @trace_context, @request_id)
+	`
 
 	_, err = r.db.Exec(ctx, query, args)
 	if err != nil {
-		r.log.Error("Failed to add event to outbox", slog.String("error", err.Error()), slog.Int64("aggregate_id", outbox.AggregateID), slog.String("event_type", string(outbox.EventType)))
+		r.logger(ctx).Error("Failed to add event to outbox", slog.String("error", err.Error()), slog.Int64("aggregate_id", outbox.AggregateID), slog.String("event_type", string(outbox.EventType)))
 		return err
 	}
 
-	r.log.Info("Event added to outbox successfully", slog.Int64("aggregate_id", outbox.AggregateID), slog.String("event_type", string(outbox.EventType)))
+	r.logger(ctx).Info("Event added to outbox successfully", slog.Int64("aggregate_id", outbox.AggregateID), slog.String("event_type", string(outbox.EventType)))
 	return nil
 }
 
@@ -56,7 +76,7 @@ func (r *Repository) GetEventsForProcessing(ctx context.Context, limit int) (eve
 	}()
 
 	query := `
-		SELECT id, aggregate_id, event_type, payload, status, created_at, sent_at
+		SELECT id, aggregate_id, event_type, payload, status, created_at, sent_at, trace_context, request_id
 		FROM outbox
 		WHERE status = 'new'
 		ORDER BY created_at
@@ -68,7 +88,7 @@ func (r *Repository) GetEventsForProcessing(ctx context.Context, limit int) (eve
 
 	rows, err := r.db.Query(ctx, query, args)
 	if err != nil {
-		r.log.Error("Failed to get events for processing", slog.String("error", err.Error()))
+		r.logger(ctx).Error("Failed to get events for processing", slog.String("error", err.Error()))
 		return nil, err
 	}
 	defer rows.Close()
@@ -84,15 +104,17 @@ func (r *Repository) GetEventsForProcessing(ctx context.Context, limit int) (eve
 			&event.Status,
 			&event.CreatedAt,
 			&event.SentAt,
+			&event.TraceContext,
+			&event.RequestID,
 		); err != nil {
-			r.log.Error("Failed to scan event row", slog.String("error", err.Error()))
+			r.logger(ctx).Error("Failed to scan event row", slog.String("error", err.Error()))
 			return nil, err
 		}
 		eventsList = append(eventsList, event)
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Error("Error iterating over event rows", slog.String("error", err.Error()))
+		r.logger(ctx).Error("Error iterating over event rows", slog.String("error", err.Error()))
 		return nil, err
 	}
 
@@ -135,14 +157,14 @@ func (r *Repository) UpdateEventStatus(ctx context.Context, eventID int64, statu
 
 	_, err = r.db.Exec(ctx, query, args)
 	if err != nil {
-		r.log.Error("Failed to update event status",
+		r.logger(ctx).Error("Failed to update event status",
 			slog.String("error", err.Error()),
 			slog.Int64("event_id", eventID),
 			slog.String("status", string(status)))
 		return err
 	}
 
-	r.log.Info("Event status updated",
+	r.logger(ctx).Info("Event status updated",
 		slog.Int64("event_id", eventID),
 		slog.String("status", string(status)))
 	return nil
@@ -169,12 +191,12 @@ func (r *Repository) MarkEventAsPending(ctx context.Context, eventID int64) (err
 
 	_, err = r.db.Exec(ctx, query, args)
 	if err != nil {
-		r.log.Error("Failed to mark event as pending",
+		r.logger(ctx).Error("Failed to mark event as pending",
 			slog.String("error", err.Error()),
 			slog.Int64("event_id", eventID))
 		return err
 	}
 
-	r.log.Debug("Event marked as pending", slog.Int64("event_id", eventID))
+	r.logger(ctx).Debug("Event marked as pending", slog.Int64("event_id", eventID))
 	return nil
 }
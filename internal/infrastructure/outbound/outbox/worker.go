@@ -118,6 +118,10 @@ func (wp *OutboxWorker) worker(ctx context.Context, event model.OutboxEvent) {
 
 func (wp *OutboxWorker) processEvent(ctx context.Context, event model.OutboxEvent) {
 	start := time.Now()
+	log := wp.log
+	if event.RequestID != "" {
+		log = log.With(slog.String("request_id", event.RequestID))
+	}
 	var success bool
 	defer func() {
 		wp.metrics.IncrementOutboxOperations("process_event", success)
@@ -130,7 +134,7 @@ func (wp *OutboxWorker) processEvent(ctx context.Context, event model.OutboxEven
 	}()
 
 	if err := wp.repo.MarkEventAsPending(ctx, event.ID); err != nil {
-		wp.log.Error("Failed to mark event as pending",
+		log.Error("Failed to mark event as pending",
 			slog.Int64("event_id", event.ID),
 			slog.String("error", err.Error()))
 		return
@@ -140,12 +144,12 @@ func (wp *OutboxWorker) processEvent(ctx context.Context, event model.OutboxEven
 	result := <-resultChan
 
 	if result.Error != nil {
-		wp.log.Error("Failed to send event to Kafka",
+		log.Error("Failed to send event to Kafka",
 			slog.Int64("event_id", event.ID),
 			slog.String("error", result.Error.Error()))
 
 		if err := wp.repo.UpdateEventStatus(ctx, event.ID, model.OutboxStatusError, nil); err != nil {
-			wp.log.Error("Failed to update event status to error",
+			log.Error("Failed to update event status to error",
 				slog.Int64("event_id", event.ID),
 				slog.String("error", err.Error()))
 		}
@@ -154,12 +158,12 @@ func (wp *OutboxWorker) processEvent(ctx context.Context, event model.OutboxEven
 
 	now := time.Now()
 	if err := wp.repo.UpdateEventStatus(ctx, event.ID, model.OutboxStatusSent, &now); err != nil {
-		wp.log.Error("Failed to update event status to sent",
+		log.Error("Failed to update event status to sent",
 			slog.Int64("event_id", event.ID),
 			slog.String("error", err.Error()))
 		return
 	}
 
 	success = true
-	wp.log.Info("Event successfully processed and sent", slog.Int64("event_id", event.ID))
+	log.Info("Event successfully processed and sent", slog.Int64("event_id", event.ID))
 }
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+)
+
+type bucket struct {
+	tokens   float64
+	last     time.Time
+	idleTime time.Duration
+}
+
+// Limiter is a process-local token bucket limiter. Limits are not shared
+// between replicas, use the Redis backend for that.
+type Limiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewLimiter creates the limiter and starts a janitor that drops buckets
+// which have been refilled completely for longer than cleanupInterval.
+func NewLimiter(cleanupInterval time.Duration) *Limiter {
+	l := &Limiter{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+		stop:    make(chan struct{}),
+	}
+
+	l.wg.Add(1)
+	go l.cleanup(cleanupInterval)
+
+	return l
+}
+
+func (l *Limiter) Allow(_ context.Context, key string, limit model.RateLimit) (model.RateLimitDecision, error) {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+	b.idleTime = time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second))
+
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+		b.last = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return model.RateLimitDecision{Allowed: true, Remaining: int(b.tokens)}, nil
+	}
+
+	retryAfter := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	return model.RateLimitDecision{Allowed: false, RetryAfter: retryAfter}, nil
+}
+
+func (l *Limiter) Close() {
+	close(l.stop)
+	l.wg.Wait()
+}
+
+func (l *Limiter) cleanup(interval time.Duration) {
+	defer l.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			now := l.now()
+			l.mu.Lock()
+			for key, b := range l.buckets {
+				if now.Sub(b.last) > b.idleTime+interval {
+					delete(l.buckets, key)
+				}
+			}
+			l.mu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	limiter := NewLimiter(time.Minute)
+	t.Cleanup(limiter.Close)
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	limit := model.RateLimit{Rate: 2, Burst: 3}
+
+	for i := 2; i >= 0; i-- {
+		decision, err := limiter.Allow(ctx, "caller:1", limit)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+		assert.Equal(t, i, decision.Remaining)
+	}
+
+	decision, err := limiter.Allow(ctx, "caller:1", limit)
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, 500*time.Millisecond, decision.RetryAfter)
+
+	decision, err = limiter.Allow(ctx, "caller:2", limit)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed, "buckets are independent per key")
+
+	now = now.Add(500 * time.Millisecond)
+	decision, err = limiter.Allow(ctx, "caller:1", limit)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed, "one token is refilled after 1/rate seconds")
+
+	now = now.Add(time.Hour)
+	decision, err = limiter.Allow(ctx, "caller:1", limit)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+	assert.Equal(t, 2, decision.Remaining, "refill is capped at burst")
+}
+
+func TestLimiter_CleanupRemovesIdleBuckets(t *testing.T) {
+	limiter := NewLimiter(10 * time.Millisecond)
+	t.Cleanup(limiter.Close)
+
+	_, err := limiter.Allow(context.Background(), "peer:127.0.0.1", model.RateLimit{Rate: 1000, Burst: 1})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		return len(limiter.buckets) == 0
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,86 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript refills and takes a token atomically. The Redis server
+// clock is used so that all replicas agree on the time.
+//
+// KEYS[1] - bucket key
+// ARGV[1] - rate (tokens per second)
+// ARGV[2] - burst
+//
+// Returns {allowed, remaining, retry_after_ms}
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+
+local elapsed = math.max(0, now - ts) / 1000
+tokens = math.min(burst, tokens + elapsed * rate)
+
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry_after = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+
+return {allowed, math.floor(tokens), retry_after}
+`)
+
+// Limiter is a token bucket limiter backed by Redis, shared by all replicas
+type Limiter struct {
+	client    redis.Scripter
+	keyPrefix string
+	log       ports.Logger
+}
+
+func NewLimiter(client redis.Scripter, keyPrefix string, log ports.Logger) *Limiter {
+	return &Limiter{
+		client:    client,
+		keyPrefix: keyPrefix,
+		log:       log,
+	}
+}
+
+func (l *Limiter) Allow(ctx context.Context, key string, limit model.RateLimit) (model.RateLimitDecision, error) {
+	result, err := tokenBucketScript.Run(ctx, l.client, []string{l.keyPrefix + key}, limit.Rate, limit.Burst).Int64Slice()
+	if err != nil {
+		l.log.Error("Failed to evaluate rate limit script", slog.String("key", key), slog.String("error", err.Error()))
+		return model.RateLimitDecision{}, fmt.Errorf("rate limit script: %w", err)
+	}
+	if len(result) != 3 {
+		return model.RateLimitDecision{}, fmt.Errorf("rate limit script returned %d values", len(result))
+	}
+
+	return model.RateLimitDecision{
+		Allowed:    result[0] == 1,
+		Remaining:  int(result[1]),
+		RetryAfter: time.Duration(result[2]) * time.Millisecond,
+	}, nil
+}
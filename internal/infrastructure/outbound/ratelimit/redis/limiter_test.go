@@ -0,0 +1,61 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	redis_ratelimit "pinstack-relation-service/internal/infrastructure/outbound/ratelimit/redis"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupLimiter(t *testing.T) (*redis_ratelimit.Limiter, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	return redis_ratelimit.NewLimiter(client, "test:", logger.New("test")), server
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	limiter, server := setupLimiter(t)
+	ctx := context.Background()
+	limit := model.RateLimit{Rate: 1, Burst: 2}
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	server.SetTime(now)
+
+	for i := 1; i >= 0; i-- {
+		decision, err := limiter.Allow(ctx, "caller:1", limit)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+		assert.Equal(t, i, decision.Remaining)
+	}
+
+	decision, err := limiter.Allow(ctx, "caller:1", limit)
+	require.NoError(t, err)
+	assert.False(t, decision.Allowed)
+	assert.Equal(t, time.Second, decision.RetryAfter)
+
+	assert.True(t, server.Exists("test:caller:1"))
+	assert.Positive(t, server.TTL("test:caller:1"))
+
+	server.SetTime(now.Add(time.Second))
+	decision, err = limiter.Allow(ctx, "caller:1", limit)
+	require.NoError(t, err)
+	assert.True(t, decision.Allowed)
+}
+
+func TestLimiter_RedisUnavailable(t *testing.T) {
+	limiter, server := setupLimiter(t)
+	server.Close()
+
+	_, err := limiter.Allow(context.Background(), "caller:1", model.RateLimit{Rate: 1, Burst: 1})
+	assert.Error(t, err)
+}
@@ -0,0 +1,465 @@
+package repository_postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolationCode is the SQLSTATE of a unique constraint violation
+const uniqueViolationCode = "23505"
+
+// audienceListColumns selects a list of the audience_lists alias l together with its member count
+const audienceListColumns = `
+	l.id, l.owner_id, l.name, l.created_at, l.updated_at,
+	(SELECT COUNT(*) FROM audience_list_members m WHERE m.list_id = l.id) AS member_count
+`
+
+type AudienceListRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewAudienceListRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *AudienceListRepository {
+	return &AudienceListRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *AudienceListRepository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
+func (r *AudienceListRepository) Create(ctx context.Context, ownerID int64, name string) (list model.AudienceList, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("create_audience_list", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("create_audience_list", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"owner_id": ownerID,
+		"name":     name,
+	}
+
+	query := `
+		INSERT INTO audience_lists (owner_id, name, created_at, updated_at)
+		VALUES (@owner_id, @name, NOW(), NOW())
+		ON CONFLICT (owner_id, name) DO NOTHING
+		RETURNING id, owner_id, name, created_at, updated_at
+	`
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&list.ID, &list.OwnerID, &list.Name, &list.CreatedAt, &list.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.AudienceList{}, model.ErrAudienceListExists
+		}
+		r.logger(ctx).Error("Failed to create audience list",
+			slog.Int64("owner_id", ownerID),
+			slog.String("error", err.Error()))
+		return model.AudienceList{}, custom_errors.ErrDatabaseQuery
+	}
+
+	return list, nil
+}
+
+func (r *AudienceListRepository) Rename(ctx context.Context, ownerID, listID int64, name string) (list model.AudienceList, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("rename_audience_list", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("rename_audience_list", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"owner_id": ownerID,
+		"list_id":  listID,
+		"name":     name,
+	}
+
+	query := `
+		UPDATE audience_lists l
+		SET name = @name, updated_at = NOW()
+		WHERE l.id = @list_id AND l.owner_id = @owner_id
+		RETURNING ` + audienceListColumns
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&list.ID, &list.OwnerID, &list.Name, &list.CreatedAt, &list.UpdatedAt, &list.MemberCount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.AudienceList{}, model.ErrAudienceListNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return model.AudienceList{}, model.ErrAudienceListExists
+		}
+		r.logger(ctx).Error("Failed to rename audience list",
+			slog.Int64("owner_id", ownerID),
+			slog.Int64("list_id", listID),
+			slog.String("error", err.Error()))
+		return model.AudienceList{}, custom_errors.ErrDatabaseQuery
+	}
+
+	return list, nil
+}
+
+func (r *AudienceListRepository) Delete(ctx context.Context, ownerID, listID int64) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_audience_list", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_audience_list", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"owner_id": ownerID,
+		"list_id":  listID,
+	}
+
+	query := `DELETE FROM audience_lists WHERE id = @list_id AND owner_id = @owner_id`
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to delete audience list",
+			slog.Int64("owner_id", ownerID),
+			slog.Int64("list_id", listID),
+			slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrAudienceListNotFound
+	}
+
+	return nil
+}
+
+func (r *AudienceListRepository) Get(ctx context.Context, ownerID, listID int64) (model.AudienceList, error) {
+	return r.get(ctx, "get_audience_list", ownerID, listID, "")
+}
+
+func (r *AudienceListRepository) GetForUpdate(ctx context.Context, ownerID, listID int64) (model.AudienceList, error) {
+	return r.get(ctx, "get_audience_list_for_update", ownerID, listID, "FOR UPDATE OF l")
+}
+
+func (r *AudienceListRepository) get(ctx context.Context, metric string, ownerID, listID int64, lock string) (list model.AudienceList, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries(metric, err == nil)
+		r.metrics.RecordDatabaseQueryDuration(metric, time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"owner_id": ownerID,
+		"list_id":  listID,
+	}
+
+	query := `
+		SELECT ` + audienceListColumns + `
+		FROM audience_lists l
+		WHERE l.id = @list_id AND l.owner_id = @owner_id
+		` + lock
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&list.ID, &list.OwnerID, &list.Name, &list.CreatedAt, &list.UpdatedAt, &list.MemberCount)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.AudienceList{}, model.ErrAudienceListNotFound
+		}
+		r.logger(ctx).Error("Failed to get audience list",
+			slog.Int64("owner_id", ownerID),
+			slog.Int64("list_id", listID),
+			slog.String("error", err.Error()))
+		return model.AudienceList{}, custom_errors.ErrDatabaseQuery
+	}
+
+	return list, nil
+}
+
+func (r *AudienceListRepository) ListByOwner(ctx context.Context, ownerID int64) (lists []model.AudienceList, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_audience_lists", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_audience_lists", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"owner_id": ownerID,
+	}
+
+	query := `
+		SELECT ` + audienceListColumns + `
+		FROM audience_lists l
+		WHERE l.owner_id = @owner_id
+		ORDER BY l.name
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query audience lists",
+			slog.Int64("owner_id", ownerID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	lists = make([]model.AudienceList, 0)
+	for rows.Next() {
+		var list model.AudienceList
+		if err := rows.Scan(&list.ID, &list.OwnerID, &list.Name, &list.CreatedAt, &list.UpdatedAt, &list.MemberCount); err != nil {
+			r.logger(ctx).Error("Failed to scan audience list row",
+				slog.Int64("owner_id", ownerID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		lists = append(lists, list)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during audience lists iteration",
+			slog.Int64("owner_id", ownerID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return lists, nil
+}
+
+func (r *AudienceListRepository) CountByOwner(ctx context.Context, ownerID int64) (count int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("count_audience_lists", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("count_audience_lists", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"owner_id": ownerID,
+	}
+
+	query := `SELECT COUNT(*) FROM audience_lists WHERE owner_id = @owner_id`
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&count)
+	if err != nil {
+		r.logger(ctx).Error("Failed to count audience lists",
+			slog.Int64("owner_id", ownerID),
+			slog.String("error", err.Error()))
+		return 0, custom_errors.ErrDatabaseQuery
+	}
+
+	return count, nil
+}
+
+func (r *AudienceListRepository) AddMembers(ctx context.Context, listID int64, memberIDs []int64) (added []int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("add_audience_members", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("add_audience_members", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"list_id":    listID,
+		"member_ids": memberIDs,
+	}
+
+	query := `
+		INSERT INTO audience_list_members (list_id, member_id, added_at)
+		SELECT @list_id, member_id, NOW()
+		FROM unnest(@member_ids::bigint[]) AS member_id
+		ON CONFLICT (list_id, member_id) DO NOTHING
+		RETURNING member_id
+	`
+
+	return r.queryMemberIDs(ctx, "add audience members", listID, query, args)
+}
+
+func (r *AudienceListRepository) RemoveMembers(ctx context.Context, listID int64, memberIDs []int64) (removed []int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("remove_audience_members", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("remove_audience_members", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"list_id":    listID,
+		"member_ids": memberIDs,
+	}
+
+	query := `
+		DELETE FROM audience_list_members
+		WHERE list_id = @list_id AND member_id = ANY(@member_ids)
+		RETURNING member_id
+	`
+
+	return r.queryMemberIDs(ctx, "remove audience members", listID, query, args)
+}
+
+func (r *AudienceListRepository) RemoveMemberFromOwnerLists(ctx context.Context, ownerID, memberID int64) (listIDs []int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("remove_audience_member_from_owner_lists", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("remove_audience_member_from_owner_lists", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"owner_id":  ownerID,
+		"member_id": memberID,
+	}
+
+	query := `
+		DELETE FROM audience_list_members m
+		USING audience_lists l
+		WHERE m.list_id = l.id AND l.owner_id = @owner_id AND m.member_id = @member_id
+		RETURNING m.list_id
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to remove audience member from owner lists",
+			slog.Int64("owner_id", ownerID),
+			slog.Int64("member_id", memberID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	listIDs = make([]int64, 0)
+	for rows.Next() {
+		var listID int64
+		if err := rows.Scan(&listID); err != nil {
+			r.logger(ctx).Error("Failed to scan audience list id", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		listIDs = append(listIDs, listID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during audience list ids iteration", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return listIDs, nil
+}
+
+// queryMemberIDs runs a membership change that returns the member ids it touched
+func (r *AudienceListRepository) queryMemberIDs(ctx context.Context, operation string, listID int64, query string, args pgx.NamedArgs) ([]int64, error) {
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to "+operation,
+			slog.Int64("list_id", listID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	memberIDs := make([]int64, 0)
+	for rows.Next() {
+		var memberID int64
+		if err := rows.Scan(&memberID); err != nil {
+			r.logger(ctx).Error("Failed to scan audience member",
+				slog.Int64("list_id", listID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during audience members iteration",
+			slog.Int64("list_id", listID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return memberIDs, nil
+}
+
+func (r *AudienceListRepository) ListMembers(ctx context.Context, listID int64, limit, offset int32) (memberIDs []int64, total int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_audience_members", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_audience_members", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"list_id": listID,
+		"limit":   limit,
+		"offset":  offset,
+	}
+
+	query := `
+		SELECT member_id, COUNT(*) OVER() as total_count
+		FROM audience_list_members
+		WHERE list_id = @list_id
+		ORDER BY added_at DESC, member_id DESC
+		LIMIT @limit OFFSET @offset
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query audience members",
+			slog.Int64("list_id", listID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	memberIDs = make([]int64, 0)
+	for rows.Next() {
+		var memberID int64
+		if err := rows.Scan(&memberID, &total); err != nil {
+			r.logger(ctx).Error("Failed to scan audience member row",
+				slog.Int64("list_id", listID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during audience members iteration",
+			slog.Int64("list_id", listID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+
+	if len(memberIDs) == 0 && offset > 0 {
+		countQuery := `SELECT COUNT(*) FROM audience_list_members WHERE list_id = @list_id`
+		err := r.db.QueryRow(ctx, countQuery, args).Scan(&total)
+		if err != nil {
+			r.logger(ctx).Error("Failed to count audience members for empty result",
+				slog.Int64("list_id", listID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+	}
+
+	return memberIDs, total, nil
+}
+
+func (r *AudienceListRepository) IsMember(ctx context.Context, listID, userID int64) (isMember bool, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("is_audience_member", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("is_audience_member", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"list_id": listID,
+		"user_id": userID,
+	}
+
+	query := `SELECT EXISTS(SELECT 1 FROM audience_list_members WHERE list_id = @list_id AND member_id = @user_id)`
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&isMember)
+	if err != nil {
+		r.logger(ctx).Error("Failed to check audience membership",
+			slog.Int64("list_id", listID),
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return false, custom_errors.ErrDatabaseQuery
+	}
+
+	return isMember, nil
+}
@@ -0,0 +1,266 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func newTestAudienceListRepository(db *mocks.PgDB) *repository_postgres.AudienceListRepository {
+	return repository_postgres.NewAudienceListRepository(db, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+}
+
+// setupAudienceListRow makes a row that scans into list together with its member count
+func setupAudienceListRow(t *testing.T, list model.AudienceList) *mocks.Row {
+	mockRow := mocks.NewRow(t)
+	mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(0).(*int64) = list.ID
+		*args.Get(1).(*int64) = list.OwnerID
+		*args.Get(2).(*string) = list.Name
+		*args.Get(3).(*time.Time) = list.CreatedAt
+		*args.Get(4).(*time.Time) = list.UpdatedAt
+		*args.Get(5).(*int64) = list.MemberCount
+	}).Return(nil)
+	return mockRow
+}
+
+func TestAudienceListRepository_Create(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("list created", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockRow := mocks.NewRow(t)
+		mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*int64) = 7
+			*args.Get(1).(*int64) = 1
+			*args.Get(2).(*string) = "Close friends"
+			*args.Get(3).(*time.Time) = createdAt
+			*args.Get(4).(*time.Time) = createdAt
+		}).Return(nil)
+		mockDB.On("QueryRow",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "ON CONFLICT (owner_id, name) DO NOTHING")
+			}),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				return args["owner_id"] == int64(1) && args["name"] == "Close friends"
+			}),
+		).Return(mockRow)
+
+		list, err := newTestAudienceListRepository(mockDB).Create(context.Background(), 1, "Close friends")
+
+		require.NoError(t, err)
+		assert.Equal(t, model.AudienceList{ID: 7, OwnerID: 1, Name: "Close friends", CreatedAt: createdAt, UpdatedAt: createdAt}, list)
+	})
+
+	t.Run("name already taken", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockRow := mocks.NewRow(t)
+		mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+		mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+
+		_, err := newTestAudienceListRepository(mockDB).Create(context.Background(), 1, "Close friends")
+
+		assert.ErrorIs(t, err, model.ErrAudienceListExists)
+	})
+}
+
+func TestAudienceListRepository_Rename(t *testing.T) {
+	tests := []struct {
+		name        string
+		scanErr     error
+		expectedErr error
+	}{
+		{name: "list not found", scanErr: pgx.ErrNoRows, expectedErr: model.ErrAudienceListNotFound},
+		{name: "name already taken", scanErr: &pgconn.PgError{Code: "23505"}, expectedErr: model.ErrAudienceListExists},
+		{name: "database error", scanErr: errors.New("db error"), expectedErr: custom_errors.ErrDatabaseQuery},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			mockRow := mocks.NewRow(t)
+			mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.scanErr)
+			mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+
+			_, err := newTestAudienceListRepository(mockDB).Rename(context.Background(), 1, 7, "Family")
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestAudienceListRepository_Delete(t *testing.T) {
+	t.Run("list deleted", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool { return strings.Contains(query, "owner_id = @owner_id") }),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool { return args["owner_id"] == int64(1) && args["list_id"] == int64(7) }),
+		).Return(pgconn.NewCommandTag("DELETE 1"), nil)
+
+		err := newTestAudienceListRepository(mockDB).Delete(context.Background(), 1, 7)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("list of another owner", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(pgconn.NewCommandTag("DELETE 0"), nil)
+
+		err := newTestAudienceListRepository(mockDB).Delete(context.Background(), 2, 7)
+
+		assert.ErrorIs(t, err, model.ErrAudienceListNotFound)
+	})
+}
+
+func TestAudienceListRepository_GetForUpdate(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	list := model.AudienceList{ID: 7, OwnerID: 1, Name: "Close friends", MemberCount: 3, CreatedAt: createdAt, UpdatedAt: createdAt}
+
+	t.Run("list locked", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("QueryRow",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool { return strings.Contains(query, "FOR UPDATE OF l") }),
+			mock.Anything,
+		).Return(setupAudienceListRow(t, list))
+
+		got, err := newTestAudienceListRepository(mockDB).GetForUpdate(context.Background(), 1, 7)
+
+		require.NoError(t, err)
+		assert.Equal(t, list, got)
+	})
+
+	t.Run("list not found", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockRow := mocks.NewRow(t)
+		mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+		mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+
+		_, err := newTestAudienceListRepository(mockDB).GetForUpdate(context.Background(), 1, 7)
+
+		assert.ErrorIs(t, err, model.ErrAudienceListNotFound)
+	})
+}
+
+func TestAudienceListRepository_AddMembers(t *testing.T) {
+	t.Run("new members returned", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Query",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "unnest(@member_ids::bigint[])") && strings.Contains(query, "DO NOTHING")
+			}),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				memberIDs, ok := args["member_ids"].([]int64)
+				return args["list_id"] == int64(7) && ok && len(memberIDs) == 2
+			}),
+		).Return(setupMockIDRows(t, []int64{3}), nil)
+
+		added, err := newTestAudienceListRepository(mockDB).AddMembers(context.Background(), 7, []int64{2, 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, added)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil, errors.New("db error"))
+
+		_, err := newTestAudienceListRepository(mockDB).AddMembers(context.Background(), 7, []int64{2})
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestAudienceListRepository_RemoveMembers(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockDB.On("Query",
+		mock.Anything,
+		mock.MatchedBy(func(query string) bool { return strings.Contains(query, "member_id = ANY(@member_ids)") }),
+		mock.Anything,
+	).Return(setupMockIDRows(t, []int64{2}), nil)
+
+	removed, err := newTestAudienceListRepository(mockDB).RemoveMembers(context.Background(), 7, []int64{2, 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, removed)
+}
+
+func TestAudienceListRepository_RemoveMemberFromOwnerLists(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockDB.On("Query",
+		mock.Anything,
+		mock.MatchedBy(func(query string) bool {
+			return strings.Contains(query, "l.owner_id = @owner_id") && strings.Contains(query, "RETURNING m.list_id")
+		}),
+		mock.MatchedBy(func(args pgx.NamedArgs) bool { return args["owner_id"] == int64(1) && args["member_id"] == int64(2) }),
+	).Return(setupMockIDRows(t, []int64{7, 8}), nil)
+
+	listIDs, err := newTestAudienceListRepository(mockDB).RemoveMemberFromOwnerLists(context.Background(), 1, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7, 8}, listIDs)
+}
+
+func TestAudienceListRepository_ListMembers(t *testing.T) {
+	t.Run("empty page past the end counts separately", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockRows := mocks.NewRows(t)
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
+		mockDB.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRows, nil)
+
+		mockRow := mocks.NewRow(t)
+		mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*int64) = 5
+		}).Return(nil)
+		mockDB.On("QueryRow",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool { return strings.Contains(query, "SELECT COUNT(*) FROM audience_list_members") }),
+			mock.Anything,
+		).Return(mockRow)
+
+		memberIDs, total, err := newTestAudienceListRepository(mockDB).ListMembers(context.Background(), 7, 10, 10)
+
+		require.NoError(t, err)
+		assert.Empty(t, memberIDs)
+		assert.Equal(t, int64(5), total)
+	})
+}
+
+func TestAudienceListRepository_IsMember(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockRow := mocks.NewRow(t)
+	mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(0).(*bool) = true
+	}).Return(nil)
+	mockDB.On("QueryRow",
+		mock.Anything,
+		mock.AnythingOfType("string"),
+		mock.MatchedBy(func(args pgx.NamedArgs) bool { return args["list_id"] == int64(7) && args["user_id"] == int64(2) }),
+	).Return(mockRow)
+
+	isMember, err := newTestAudienceListRepository(mockDB).IsMember(context.Background(), 7, 2)
+
+	require.NoError(t, err)
+	assert.True(t, isMember)
+}
@@ -0,0 +1,116 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) CreateMany(ctx context.Context, followerID int64, followeeIDs []int64, attribution model.FollowAttribution) (created []model.Follower, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("create_follow_relations", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("create_follow_relations", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id":  followerID,
+		"followee_ids": followeeIDs,
+		"source":       string(attribution.Source),
+		"context_id":   attribution.ContextID,
+	}
+
+	query := `
+		INSERT INTO followers (follower_id, followee_id, target_type, source, context_id, created_at)
+		SELECT @follower_id, followee_id, 'user', NULLIF(@source, ''), NULLIF(@context_id, ''), NOW()
+		FROM unnest(@followee_ids::bigint[]) AS followee_id
+		ON CONFLICT (follower_id, target_type, followee_id) DO NOTHING
+		RETURNING id, follower_id, followee_id, created_at
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to create follow relations",
+			slog.Int64("follower_id", followerID),
+			slog.Int("count", len(followeeIDs)),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrFollowRelationCreateFail
+	}
+	defer rows.Close()
+
+	created = make([]model.Follower, 0, len(followeeIDs))
+	for rows.Next() {
+		follower := model.Follower{TargetType: model.TargetTypeUser, Source: attribution.Source, ContextID: attribution.ContextID}
+		if err := rows.Scan(&follower.ID, &follower.FollowerID, &follower.FolloweeID, &follower.CreatedAt); err != nil {
+			r.logger(ctx).Error("Failed to scan created follow row",
+				slog.Int64("follower_id", followerID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrFollowRelationCreateFail
+		}
+		created = append(created, follower)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during created follows iteration",
+			slog.Int64("follower_id", followerID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrFollowRelationCreateFail
+	}
+
+	return created, nil
+}
+
+func (r *Repository) DeleteMany(ctx context.Context, followerID int64, followeeIDs []int64) (removed []model.Follower, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_follow_relations", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_follow_relations", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id":  followerID,
+		"followee_ids": followeeIDs,
+	}
+
+	query := `
+		DELETE FROM followers
+		WHERE follower_id = @follower_id AND followee_id = ANY(@followee_ids) AND target_type = 'user'
+		RETURNING id, follower_id, followee_id, created_at
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to delete follow relations",
+			slog.Int64("follower_id", followerID),
+			slog.Int("count", len(followeeIDs)),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrFollowRelationDeleteFail
+	}
+	defer rows.Close()
+
+	removed = make([]model.Follower, 0, len(followeeIDs))
+	for rows.Next() {
+		follower := model.Follower{TargetType: model.TargetTypeUser}
+		if err := rows.Scan(&follower.ID, &follower.FollowerID, &follower.FolloweeID, &follower.CreatedAt); err != nil {
+			r.logger(ctx).Error("Failed to scan deleted follow row",
+				slog.Int64("follower_id", followerID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrFollowRelationDeleteFail
+		}
+		removed = append(removed, follower)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during deleted follows iteration",
+			slog.Int64("follower_id", followerID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrFollowRelationDeleteFail
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,156 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func setupMockFollowerRows(t *testing.T, followers []model.Follower) *mocks.Rows {
+	mockRows := mocks.NewRows(t)
+	for _, follower := range followers {
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Scan",
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("*time.Time")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*int64) = follower.ID
+				*args.Get(1).(*int64) = follower.FollowerID
+				*args.Get(2).(*int64) = follower.FolloweeID
+				*args.Get(3).(*time.Time) = follower.CreatedAt
+			}).
+			Return(nil).
+			Once()
+	}
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil).Maybe()
+	mockRows.On("Close").Return()
+	return mockRows
+}
+
+func TestRepository_CreateMany(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		want        []model.Follower
+		expectedErr error
+	}{
+		{
+			name: "returns only the created follows",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "INSERT INTO followers") &&
+							strings.Contains(query, "unnest(@followee_ids::bigint[])") &&
+							strings.Contains(query, "ON CONFLICT (follower_id, target_type, followee_id) DO NOTHING")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["follower_id"] == int64(1) &&
+							assert.ObjectsAreEqual([]int64{2, 3}, args["followee_ids"])
+					})).Return(setupMockFollowerRows(t, []model.Follower{{ID: 10, FollowerID: 1, FolloweeID: 3, CreatedAt: now}}), nil)
+			},
+			want: []model.Follower{{ID: 10, FollowerID: 1, FolloweeID: 3, TargetType: model.TargetTypeUser, CreatedAt: now}},
+		},
+		{
+			name: "query error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrFollowRelationCreateFail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, err := repo.CreateMany(context.Background(), 1, []int64{2, 3}, model.FollowAttribution{})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRepository_DeleteMany(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		want        []model.Follower
+		expectedErr error
+	}{
+		{
+			name: "returns the removed follows",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "DELETE FROM followers") &&
+							strings.Contains(query, "followee_id = ANY(@followee_ids)") &&
+							strings.Contains(query, "RETURNING id, follower_id, followee_id, created_at")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["follower_id"] == int64(1) &&
+							assert.ObjectsAreEqual([]int64{2, 3}, args["followee_ids"])
+					})).Return(setupMockFollowerRows(t, []model.Follower{{ID: 10, FollowerID: 1, FolloweeID: 2, CreatedAt: now}}), nil)
+			},
+			want: []model.Follower{{ID: 10, FollowerID: 1, FolloweeID: 2, TargetType: model.TargetTypeUser, CreatedAt: now}},
+		},
+		{
+			name: "query error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrFollowRelationDeleteFail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, err := repo.DeleteMany(context.Background(), 1, []int64{2, 3})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type FollowActionRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewFollowActionRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *FollowActionRepository {
+	return &FollowActionRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *FollowActionRepository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
+func (r *FollowActionRepository) Record(ctx context.Context, followerID, followeeID int64, action model.FollowActionType) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("record_follow_action", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("record_follow_action", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id": followerID,
+		"followee_id": followeeID,
+		"action":      string(action),
+	}
+
+	query := `
+		INSERT INTO follow_actions (follower_id, followee_id, action, created_at)
+		VALUES (@follower_id, @followee_id, @action, NOW())
+	`
+
+	_, err = r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to record follow action",
+			slog.Int64("follower_id", followerID),
+			slog.Int64("followee_id", followeeID),
+			slog.String("action", string(action)),
+			slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+
+	return nil
+}
+
+func (r *FollowActionRepository) GetVelocity(ctx context.Context, followerID, followeeID int64, now time.Time) (velocity model.FollowVelocity, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_follow_velocity", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_follow_velocity", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id": followerID,
+		"followee_id": followeeID,
+		"hour_since":  now.Add(-time.Hour),
+		"day_since":   now.Add(-24 * time.Hour),
+	}
+
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM follow_actions
+				WHERE follower_id = @follower_id AND action = 'follow' AND created_at >= @hour_since),
+			(SELECT COUNT(*) FROM follow_actions
+				WHERE follower_id = @follower_id AND action = 'follow' AND created_at >= @day_since),
+			(SELECT COUNT(*) FROM followers WHERE follower_id = @follower_id AND target_type = 'user'),
+			(SELECT MAX(created_at) FROM follow_actions
+				WHERE follower_id = @follower_id AND followee_id = @followee_id AND action = 'unfollow')
+	`
+
+	err = r.db.QueryRow(ctx, query, args).Scan(
+		&velocity.FollowsLastHour,
+		&velocity.FollowsLastDay,
+		&velocity.Followees,
+		&velocity.LastUnfollowAt,
+	)
+	if err != nil {
+		r.logger(ctx).Error("Failed to get follow velocity",
+			slog.Int64("follower_id", followerID),
+			slog.Int64("followee_id", followeeID),
+			slog.String("error", err.Error()))
+		return model.FollowVelocity{}, custom_errors.ErrDatabaseQuery
+	}
+
+	return velocity, nil
+}
+
+func (r *FollowActionRepository) DeleteBefore(ctx context.Context, before time.Time) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_old_follow_actions", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_old_follow_actions", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"before": before,
+	}
+
+	query := `DELETE FROM follow_actions WHERE created_at < @before`
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to delete old follow actions",
+			slog.Time("before", before),
+			slog.String("error", err.Error()))
+		return 0, custom_errors.ErrDatabaseQuery
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,124 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestFollowActionRepository_Record(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "successful record",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(createSuccessCommandTag(), nil)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowActionRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			err := repo.Record(context.Background(), 1, 2, model.FollowActionFollow)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFollowActionRepository_GetVelocity(t *testing.T) {
+	unfollowedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		want        model.FollowVelocity
+		wantErr     bool
+		expectedErr error
+	}{
+		{
+			name: "velocity returned",
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(0).(*int64) = 3
+					*args.Get(1).(*int64) = 7
+					*args.Get(2).(*int64) = 42
+					*args.Get(3).(**time.Time) = &unfollowedAt
+				}).Return(nil)
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+			},
+			want: model.FollowVelocity{FollowsLastHour: 3, FollowsLastDay: 7, Followees: 42, LastUnfollowAt: &unfollowedAt},
+		},
+		{
+			name: "database error",
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error"))
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+			},
+			wantErr:     true,
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowActionRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, err := repo.GetVelocity(context.Background(), 1, 2, time.Now())
+			if tt.wantErr {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFollowActionRepository_DeleteBefore(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockDB.On("Exec", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(pgconn.NewCommandTag("DELETE 5"), nil)
+
+	repo := repository_postgres.NewFollowActionRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+	deleted, err := repo.DeleteBefore(context.Background(), time.Now().Add(-24*time.Hour))
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), deleted)
+}
@@ -0,0 +1,196 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	"pinstack-relation-service/internal/infrastructure/outbound/migrator"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	uow_adapter "pinstack-relation-service/internal/infrastructure/outbound/uow"
+)
+
+// testPostgresDSNEnv names the database the concurrency tests run against. They need real
+// row locks and are skipped when it is not set.
+const testPostgresDSNEnv = "RELATION_TEST_POSTGRES_DSN"
+
+const concurrentRequests = 32
+
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	dsn := os.Getenv(testPostgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testPostgresDSNEnv)
+	}
+
+	m, err := migrator.NewMigrator("../../../../../migrations", dsn, logger.New("test"))
+	require.NoError(t, err)
+	require.NoError(t, m.Up())
+	require.NoError(t, m.Close())
+
+	pool, err := pgxpool.New(context.Background(), dsn)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// uniqueUserID keeps concurrent test runs against one database apart
+func uniqueUserID() int64 {
+	return time.Now().UnixNano()
+}
+
+// runConcurrently starts every call at the same time and waits for all of them
+func runConcurrently(n int, call func()) {
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			call()
+		}()
+	}
+	close(start)
+	wg.Wait()
+}
+
+func TestRepository_CreateConcurrent(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	followerID, followeeID := uniqueUserID(), uniqueUserID()
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, "DELETE FROM followers WHERE follower_id = $1", followerID)
+	})
+
+	unitOfWork := uow_adapter.NewPostgresUOW(pool, logger.New("test"), prometheus.NewPrometheusMetricsProvider())
+	var created, existed, failed atomic.Int64
+	runConcurrently(concurrentRequests, func() {
+		tx, err := unitOfWork.Begin(ctx)
+		if err != nil {
+			failed.Add(1)
+			return
+		}
+		_, ok, err := tx.FollowRepository().Create(ctx, followerID, followeeID, model.FollowAttribution{})
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			failed.Add(1)
+			return
+		}
+		if err := tx.Commit(ctx); err != nil {
+			failed.Add(1)
+			return
+		}
+		if ok {
+			created.Add(1)
+		} else {
+			existed.Add(1)
+		}
+	})
+
+	assert.Zero(t, failed.Load())
+	assert.Equal(t, int64(1), created.Load())
+	assert.Equal(t, int64(concurrentRequests-1), existed.Load())
+
+	repo := repository_postgres.NewFollowRepository(pool, logger.New("test"), prometheus.NewPrometheusMetricsProvider())
+	exists, err := repo.Exists(ctx, followerID, followeeID)
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestRepository_DeleteConcurrent(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	followerID, followeeID := uniqueUserID(), uniqueUserID()
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, "DELETE FROM followers WHERE follower_id = $1", followerID)
+	})
+
+	repo := repository_postgres.NewFollowRepository(pool, logger.New("test"), prometheus.NewPrometheusMetricsProvider())
+	_, _, err := repo.Create(ctx, followerID, followeeID, model.FollowAttribution{})
+	require.NoError(t, err)
+
+	unitOfWork := uow_adapter.NewPostgresUOW(pool, logger.New("test"), prometheus.NewPrometheusMetricsProvider())
+	var deleted, notFound, failed atomic.Int64
+	runConcurrently(concurrentRequests, func() {
+		tx, err := unitOfWork.Begin(ctx)
+		if err != nil {
+			failed.Add(1)
+			return
+		}
+		_, err = tx.FollowRepository().Delete(ctx, followerID, followeeID)
+		if errors.Is(err, custom_errors.ErrFollowRelationNotFound) {
+			_ = tx.Rollback(ctx)
+			notFound.Add(1)
+			return
+		}
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			failed.Add(1)
+			return
+		}
+		if err := tx.Commit(ctx); err != nil {
+			failed.Add(1)
+			return
+		}
+		deleted.Add(1)
+	})
+
+	assert.Zero(t, failed.Load())
+	assert.Equal(t, int64(1), deleted.Load())
+	assert.Equal(t, int64(concurrentRequests-1), notFound.Load())
+}
+
+func TestIdempotencyRepository_ClaimConcurrent(t *testing.T) {
+	pool := newTestPool(t)
+	ctx := context.Background()
+	userID := uniqueUserID()
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, "DELETE FROM idempotency_keys WHERE user_id = $1", userID)
+	})
+
+	unitOfWork := uow_adapter.NewPostgresUOW(pool, logger.New("test"), prometheus.NewPrometheusMetricsProvider())
+	record := model.IdempotencyRecord{
+		UserID:    userID,
+		Key:       "concurrent-key",
+		Operation: model.IdempotentOperationFollow,
+		TargetID:  1,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	var claimed, failed atomic.Int64
+	runConcurrently(concurrentRequests, func() {
+		tx, err := unitOfWork.Begin(ctx)
+		if err != nil {
+			failed.Add(1)
+			return
+		}
+		ok, err := tx.IdempotencyRepository().Claim(ctx, record)
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			failed.Add(1)
+			return
+		}
+		if err := tx.Commit(ctx); err != nil {
+			failed.Add(1)
+			return
+		}
+		if ok {
+			claimed.Add(1)
+		}
+	})
+
+	assert.Zero(t, failed.Load())
+	assert.Equal(t, int64(1), claimed.Load())
+}
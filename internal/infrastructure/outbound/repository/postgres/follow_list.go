@@ -0,0 +1,75 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func (r *Repository) ListFollowers(ctx context.Context, followeeID int64, opts model.FollowListOptions, limit, offset int32) (followers []int64, total int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_followers", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_followers", time.Since(start))
+	}()
+
+	return r.listFollows(ctx, followersSide, followeeID, opts, limit, offset)
+}
+
+func (r *Repository) ListFollowees(ctx context.Context, followerID int64, opts model.FollowListOptions, limit, offset int32) (followees []int64, total int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_followees", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_followees", time.Since(start))
+	}()
+
+	return r.listFollows(ctx, followeesSide, followerID, opts, limit, offset)
+}
+
+func (r *Repository) listFollows(ctx context.Context, side followListSide, userID int64, opts model.FollowListOptions, limit, offset int32) ([]int64, int64, error) {
+	query, countQuery, args := buildFollowListQuery(side, userID, opts, limit, offset)
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query follow list",
+			slog.String("listed", side.listed),
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0, limit)
+	var total int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id, &total); err != nil {
+			r.logger(ctx).Error("Failed to scan follow list row",
+				slog.Int64("user_id", userID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during follow list iteration",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+
+	if len(ids) == 0 && offset > 0 {
+		if err := r.db.QueryRow(ctx, countQuery, args).Scan(&total); err != nil {
+			r.logger(ctx).Error("Failed to count follow list for empty result",
+				slog.Int64("user_id", userID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+	}
+
+	return ids, total, nil
+}
@@ -0,0 +1,60 @@
+package repository_postgres
+
+import (
+	model "pinstack-relation-service/internal/domain/models"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// followListSide names the columns of a listing: owner is the user whose list it is,
+// listed is the column returned for every row
+type followListSide struct {
+	owner  string
+	listed string
+}
+
+var (
+	followersSide = followListSide{owner: "followee_id", listed: "follower_id"}
+	followeesSide = followListSide{owner: "follower_id", listed: "followee_id"}
+)
+
+// buildFollowListQuery builds the page query of a filtered listing and the count query
+// used when the page is empty. The listed id breaks created_at ties so pages are stable.
+func buildFollowListQuery(side followListSide, userID int64, opts model.FollowListOptions, limit, offset int32) (query, countQuery string, args pgx.NamedArgs) {
+	args = pgx.NamedArgs{"user_id": userID}
+	conditions := []string{
+		"f." + side.owner + " = @user_id",
+		"f.target_type = 'user'",
+	}
+
+	if opts.Since != nil {
+		conditions = append(conditions, "f.created_at >= @since")
+		args["since"] = *opts.Since
+	}
+	if opts.Until != nil {
+		conditions = append(conditions, "f.created_at < @until")
+		args["until"] = *opts.Until
+	}
+	if opts.MutualOnly {
+		// The reverse edge of a follow swaps the two columns whichever side is listed
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM followers back WHERE back.follower_id = f.followee_id AND back.followee_id = f.follower_id AND back.target_type = 'user')")
+	}
+
+	where := " FROM followers f WHERE " + strings.Join(conditions, " AND ")
+
+	direction := "DESC"
+	if opts.Sort == model.FollowListSortOldest {
+		direction = "ASC"
+	}
+
+	countQuery = "SELECT COUNT(*)" + where
+	query = "SELECT f." + side.listed + ", COUNT(*) OVER() AS total_count" + where +
+		" ORDER BY f.created_at " + direction + ", f." + side.listed + " " + direction +
+		" LIMIT @limit OFFSET @offset"
+
+	args["limit"] = limit
+	args["offset"] = offset
+
+	return query, countQuery, args
+}
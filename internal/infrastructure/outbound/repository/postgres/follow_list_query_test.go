@@ -0,0 +1,118 @@
+package repository_postgres
+
+import (
+	"fmt"
+	model "pinstack-relation-service/internal/domain/models"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const mutualCondition = "EXISTS (SELECT 1 FROM followers back WHERE back.follower_id = f.followee_id AND back.followee_id = f.follower_id AND back.target_type = 'user')"
+
+func TestBuildFollowListQuery_Golden(t *testing.T) {
+	since := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		side          followListSide
+		opts          model.FollowListOptions
+		expectedQuery string
+		expectedCount string
+	}{
+		{
+			name:          "followers without options",
+			side:          followersSide,
+			expectedQuery: "SELECT f.follower_id, COUNT(*) OVER() AS total_count FROM followers f WHERE f.followee_id = @user_id AND f.target_type = 'user' ORDER BY f.created_at DESC, f.follower_id DESC LIMIT @limit OFFSET @offset",
+			expectedCount: "SELECT COUNT(*) FROM followers f WHERE f.followee_id = @user_id AND f.target_type = 'user'",
+		},
+		{
+			name: "followees oldest first in a window, mutual only",
+			side: followeesSide,
+			opts: model.FollowListOptions{
+				Sort:       model.FollowListSortOldest,
+				Since:      &since,
+				Until:      &until,
+				MutualOnly: true,
+			},
+			expectedQuery: "SELECT f.followee_id, COUNT(*) OVER() AS total_count FROM followers f WHERE f.follower_id = @user_id AND f.target_type = 'user' AND f.created_at >= @since AND f.created_at < @until AND " + mutualCondition + " ORDER BY f.created_at ASC, f.followee_id ASC LIMIT @limit OFFSET @offset",
+			expectedCount: "SELECT COUNT(*) FROM followers f WHERE f.follower_id = @user_id AND f.target_type = 'user' AND f.created_at >= @since AND f.created_at < @until AND " + mutualCondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, countQuery, _ := buildFollowListQuery(tt.side, 1, tt.opts, 10, 0)
+			assert.Equal(t, tt.expectedQuery, query)
+			assert.Equal(t, tt.expectedCount, countQuery)
+		})
+	}
+}
+
+func TestBuildFollowListQuery_AllCombinations(t *testing.T) {
+	since := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC)
+
+	sides := map[string]followListSide{"followers": followersSide, "followees": followeesSide}
+	sorts := []model.FollowListSort{"", model.FollowListSortNewest, model.FollowListSortOldest}
+
+	for sideName, side := range sides {
+		for _, sort := range sorts {
+			for _, withSince := range []bool{false, true} {
+				for _, withUntil := range []bool{false, true} {
+					for _, mutualOnly := range []bool{false, true} {
+						opts := model.FollowListOptions{Sort: sort, MutualOnly: mutualOnly}
+						if withSince {
+							opts.Since = &since
+						}
+						if withUntil {
+							opts.Until = &until
+						}
+
+						name := fmt.Sprintf("%s sort=%q since=%t until=%t mutual=%t", sideName, sort, withSince, withUntil, mutualOnly)
+						t.Run(name, func(t *testing.T) {
+							query, countQuery, args := buildFollowListQuery(side, 7, opts, 20, 40)
+
+							owner := "f." + side.owner + " = @user_id AND f.target_type = 'user'"
+							assert.True(t, strings.HasPrefix(query, "SELECT f."+side.listed+", COUNT(*) OVER() AS total_count FROM followers f WHERE "+owner))
+							assert.True(t, strings.HasPrefix(countQuery, "SELECT COUNT(*) FROM followers f WHERE "+owner))
+							assert.True(t, strings.HasSuffix(query, " LIMIT @limit OFFSET @offset"))
+							assert.NotContains(t, countQuery, "ORDER BY")
+							assert.NotContains(t, countQuery, "LIMIT")
+
+							direction := "DESC"
+							if sort == model.FollowListSortOldest {
+								direction = "ASC"
+							}
+							assert.Contains(t, query, " ORDER BY f.created_at "+direction+", f."+side.listed+" "+direction+" ")
+
+							assert.Equal(t, withSince, strings.Contains(query, "f.created_at >= @since"))
+							assert.Equal(t, withSince, strings.Contains(countQuery, "f.created_at >= @since"))
+							assert.Equal(t, withUntil, strings.Contains(query, "f.created_at < @until"))
+							assert.Equal(t, withUntil, strings.Contains(countQuery, "f.created_at < @until"))
+							assert.Equal(t, mutualOnly, strings.Contains(query, mutualCondition))
+							assert.Equal(t, mutualOnly, strings.Contains(countQuery, mutualCondition))
+
+							assert.Equal(t, int64(7), args["user_id"])
+							assert.Equal(t, int32(20), args["limit"])
+							assert.Equal(t, int32(40), args["offset"])
+							_, hasSince := args["since"]
+							_, hasUntil := args["until"]
+							assert.Equal(t, withSince, hasSince)
+							assert.Equal(t, withUntil, hasUntil)
+							if withSince {
+								assert.Equal(t, since, args["since"])
+							}
+							if withUntil {
+								assert.Equal(t, until, args["until"])
+							}
+						})
+					}
+				}
+			}
+		}
+	}
+}
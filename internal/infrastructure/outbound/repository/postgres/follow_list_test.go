@@ -0,0 +1,120 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestRepository_ListFollowers(t *testing.T) {
+	since := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		opts        model.FollowListOptions
+		offset      int32
+		mockSetup   func(*mocks.PgDB)
+		want        []int64
+		wantTotal   int64
+		expectedErr error
+	}{
+		{
+			name: "new mutual followers this week",
+			opts: model.FollowListOptions{Since: &since, MutualOnly: true},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "f.followee_id = @user_id") &&
+							strings.Contains(query, "f.created_at >= @since") &&
+							strings.Contains(query, "EXISTS (SELECT 1 FROM followers back")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["user_id"] == int64(1) && args["since"] == since
+					})).Return(setupMockRowsWithTotal(t, []int64{4, 2}, 2), nil)
+			},
+			want:      []int64{4, 2},
+			wantTotal: 2,
+		},
+		{
+			name:   "page past the end is counted separately",
+			offset: 20,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+					Return(setupMockRowsWithTotal(t, []int64{}, 0), nil)
+
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.AnythingOfType("*int64")).
+					Run(func(args mock.Arguments) { *args.Get(0).(*int64) = 3 }).
+					Return(nil)
+				db.On("QueryRow",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool { return strings.HasPrefix(query, "SELECT COUNT(*)") }),
+					mock.Anything).Return(mockRow)
+			},
+			want:      []int64{},
+			wantTotal: 3,
+		},
+		{
+			name: "query error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, total, err := repo.ListFollowers(context.Background(), 1, tt.opts, 10, tt.offset)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
+
+func TestRepository_ListFollowees(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockDB.On("Query",
+		mock.Anything,
+		mock.MatchedBy(func(query string) bool {
+			return strings.Contains(query, "SELECT f.followee_id") &&
+				strings.Contains(query, "f.follower_id = @user_id") &&
+				strings.Contains(query, "ORDER BY f.created_at ASC, f.followee_id ASC")
+		}),
+		mock.Anything).Return(setupMockRowsWithTotal(t, []int64{5, 6}, 2), nil)
+
+	repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+	got, total, err := repo.ListFollowees(context.Background(), 1, model.FollowListOptions{Sort: model.FollowListSortOldest}, 10, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{5, 6}, got)
+	assert.Equal(t, int64(2), total)
+}
@@ -0,0 +1,135 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type FollowStatsRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewFollowStatsRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *FollowStatsRepository {
+	return &FollowStatsRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *FollowStatsRepository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
+func (r *FollowStatsRepository) AggregateDay(ctx context.Context, day time.Time) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("aggregate_follow_source_stats", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("aggregate_follow_source_stats", time.Since(start))
+	}()
+
+	dayStart := utcDay(day)
+	sources := make([]string, 0, len(model.FollowSources)+1)
+	for _, source := range model.FollowSources {
+		sources = append(sources, string(source))
+	}
+	sources = append(sources, string(model.FollowSourceUnknown))
+
+	args := pgx.NamedArgs{
+		"day":       dayStart.Format(time.DateOnly),
+		"day_start": dayStart,
+		"day_end":   dayStart.AddDate(0, 0, 1),
+		"sources":   sources,
+		"unknown":   string(model.FollowSourceUnknown),
+	}
+
+	// Every source gets a row, so a source whose follows were all undone drops to zero
+	query := `
+		INSERT INTO follow_source_stats (day, source, follows, updated_at)
+		SELECT @day::date, s.source, COALESCE(c.follows, 0), NOW()
+		FROM unnest(@sources::text[]) AS s(source)
+		LEFT JOIN (
+			SELECT COALESCE(source, @unknown) AS source, COUNT(*) AS follows
+			FROM followers
+			WHERE target_type = 'user' AND created_at >= @day_start AND created_at < @day_end
+			GROUP BY 1
+		) c ON c.source = s.source
+		ON CONFLICT (day, source) DO UPDATE
+		SET follows = EXCLUDED.follows, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err = r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to aggregate follow source stats",
+			slog.Time("day", dayStart),
+			slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+
+	return nil
+}
+
+func (r *FollowStatsRepository) ListDaily(ctx context.Context, from, to time.Time) (stats []model.FollowSourceStat, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_follow_source_stats", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_follow_source_stats", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"from": utcDay(from).Format(time.DateOnly),
+		"to":   utcDay(to).Format(time.DateOnly),
+	}
+
+	query := `
+		SELECT day::text, source, follows
+		FROM follow_source_stats
+		WHERE day BETWEEN @from::date AND @to::date
+		ORDER BY day, source
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query follow source stats",
+			slog.Time("from", from),
+			slog.Time("to", to),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	stats = make([]model.FollowSourceStat, 0)
+	for rows.Next() {
+		var day, source string
+		var stat model.FollowSourceStat
+		if err := rows.Scan(&day, &source, &stat.Follows); err != nil {
+			r.logger(ctx).Error("Failed to scan follow source stat row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		stat.Day, err = time.Parse(time.DateOnly, day)
+		if err != nil {
+			r.logger(ctx).Error("Failed to parse follow source stat day", slog.String("day", day), slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		stat.Source = model.FollowSource(source)
+		stats = append(stats, stat)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during follow source stats iteration", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return stats, nil
+}
+
+// utcDay truncates t to the start of its UTC day
+func utcDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
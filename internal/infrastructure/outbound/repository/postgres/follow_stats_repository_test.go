@@ -0,0 +1,101 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func newTestFollowStatsRepository(db *mocks.PgDB) *repository_postgres.FollowStatsRepository {
+	return repository_postgres.NewFollowStatsRepository(db, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+}
+
+func TestFollowStatsRepository_AggregateDay(t *testing.T) {
+	// 01:30 in UTC+3 is still the previous UTC day
+	day := time.Date(2025, 6, 2, 1, 30, 0, 0, time.FixedZone("UTC+3", 3*60*60))
+	dayStart := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("day recounted for every source", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "ON CONFLICT (day, source) DO UPDATE") && strings.Contains(query, "target_type = 'user'")
+			}),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				sources, ok := args["sources"].([]string)
+				return ok && len(sources) == len(model.FollowSources)+1 &&
+					args["day"] == "2025-06-01" &&
+					args["day_start"] == dayStart &&
+					args["day_end"] == dayStart.AddDate(0, 0, 1)
+			}),
+		).Return(pgconn.NewCommandTag("INSERT 0 6"), nil)
+
+		err := newTestFollowStatsRepository(mockDB).AggregateDay(context.Background(), day)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+
+		err := newTestFollowStatsRepository(mockDB).AggregateDay(context.Background(), day)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestFollowStatsRepository_ListDaily(t *testing.T) {
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC)
+
+	t.Run("stats by day and source", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockRows := mocks.NewRows(t)
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Scan", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*string) = "2025-06-01"
+			*args.Get(1).(*string) = "search"
+			*args.Get(2).(*int64) = 12
+		}).Return(nil).Once()
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
+		mockDB.On("Query",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool { return strings.Contains(query, "ORDER BY day, source") }),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool { return args["from"] == "2025-06-01" && args["to"] == "2025-06-07" }),
+		).Return(mockRows, nil)
+
+		stats, err := newTestFollowStatsRepository(mockDB).ListDaily(context.Background(), from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.FollowSourceStat{{Day: from, Source: model.FollowSourceSearch, Follows: 12}}, stats)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil, errors.New("db error"))
+
+		_, err := newTestFollowStatsRepository(mockDB).ListDaily(context.Background(), from, to)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
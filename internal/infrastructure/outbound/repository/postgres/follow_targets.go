@@ -0,0 +1,154 @@
+package repository_postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) CreateTarget(ctx context.Context, followerID int64, target model.FollowTarget) (follower model.Follower, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("create_target_follow", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("create_target_follow", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id": followerID,
+		"target_type": string(target.Type),
+		"target_id":   target.ID,
+	}
+
+	query := `
+		INSERT INTO followers (follower_id, followee_id, target_type, created_at)
+		VALUES (@follower_id, @target_id, @target_type, NOW())
+		ON CONFLICT (follower_id, target_type, followee_id) DO NOTHING
+		RETURNING id, follower_id, followee_id, target_type, created_at
+	`
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&follower.ID, &follower.FollowerID, &follower.FolloweeID, &follower.TargetType, &follower.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Follower{}, custom_errors.ErrFollowRelationExists
+		}
+		r.logger(ctx).Error("Failed to create target follow",
+			slog.Int64("follower_id", followerID),
+			slog.String("target_type", string(target.Type)),
+			slog.Int64("target_id", target.ID),
+			slog.String("error", err.Error()))
+		return model.Follower{}, custom_errors.ErrFollowRelationCreateFail
+	}
+
+	return follower, nil
+}
+
+func (r *Repository) DeleteTarget(ctx context.Context, followerID int64, target model.FollowTarget) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_target_follow", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_target_follow", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id": followerID,
+		"target_type": string(target.Type),
+		"target_id":   target.ID,
+	}
+
+	query := `
+		DELETE FROM followers
+		WHERE follower_id = @follower_id AND target_type = @target_type AND followee_id = @target_id
+	`
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to delete target follow",
+			slog.Int64("follower_id", followerID),
+			slog.String("target_type", string(target.Type)),
+			slog.Int64("target_id", target.ID),
+			slog.String("error", err.Error()))
+		return custom_errors.ErrFollowRelationDeleteFail
+	}
+
+	if result.RowsAffected() == 0 {
+		return custom_errors.ErrFollowRelationNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) GetFollowedTargets(ctx context.Context, followerID int64, targetType model.TargetType, limit, offset int32) (targets []int64, total int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_followed_targets", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_followed_targets", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id": followerID,
+		"target_type": string(targetType),
+		"limit":       limit,
+		"offset":      offset,
+	}
+
+	query := `
+		SELECT
+			followee_id,
+			COUNT(*) OVER() as total_count
+		FROM followers
+		WHERE follower_id = @follower_id AND target_type = @target_type
+		ORDER BY created_at DESC, followee_id
+		LIMIT @limit OFFSET @offset
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query followed targets",
+			slog.Int64("follower_id", followerID),
+			slog.String("target_type", string(targetType)),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	targets = make([]int64, 0)
+	for rows.Next() {
+		var targetID int64
+		if err := rows.Scan(&targetID, &total); err != nil {
+			r.logger(ctx).Error("Failed to scan followed target row",
+				slog.Int64("follower_id", followerID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+		targets = append(targets, targetID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during followed targets iteration",
+			slog.Int64("follower_id", followerID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+
+	if len(targets) == 0 && offset > 0 {
+		countQuery := `SELECT COUNT(*) FROM followers WHERE follower_id = @follower_id AND target_type = @target_type`
+		err := r.db.QueryRow(ctx, countQuery, pgx.NamedArgs{
+			"follower_id": followerID,
+			"target_type": string(targetType),
+		}).Scan(&total)
+		if err != nil {
+			r.logger(ctx).Error("Failed to count followed targets for empty result",
+				slog.Int64("follower_id", followerID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+	}
+
+	return targets, total, nil
+}
@@ -0,0 +1,217 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestRepository_CreateTarget(t *testing.T) {
+	board := model.FollowTarget{Type: model.TargetTypeBoard, ID: 7}
+	createdAt := time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		scanErr     error
+		expectedErr error
+	}{
+		{name: "successful board follow"},
+		{name: "follow relation exists", scanErr: pgx.ErrNoRows, expectedErr: custom_errors.ErrFollowRelationExists},
+		{name: "database error", scanErr: errors.New("db error"), expectedErr: custom_errors.ErrFollowRelationCreateFail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			mockRow := mocks.NewRow(t)
+			mockRow.On("Scan",
+				mock.AnythingOfType("*int64"),
+				mock.AnythingOfType("*int64"),
+				mock.AnythingOfType("*int64"),
+				mock.AnythingOfType("*model.TargetType"),
+				mock.AnythingOfType("*time.Time")).
+				Run(func(args mock.Arguments) {
+					if tt.scanErr != nil {
+						return
+					}
+					*args.Get(0).(*int64) = 11
+					*args.Get(1).(*int64) = 1
+					*args.Get(2).(*int64) = 7
+					*args.Get(3).(*model.TargetType) = model.TargetTypeBoard
+					*args.Get(4).(*time.Time) = createdAt
+				}).
+				Return(tt.scanErr)
+
+			mockDB.On("QueryRow",
+				mock.Anything,
+				mock.MatchedBy(func(query string) bool {
+					return strings.Contains(query, "ON CONFLICT (follower_id, target_type, followee_id) DO NOTHING")
+				}),
+				mock.MatchedBy(func(args pgx.NamedArgs) bool {
+					return args["follower_id"] == int64(1) &&
+						args["target_type"] == "board" &&
+						args["target_id"] == int64(7)
+				})).Return(mockRow)
+
+			repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			follower, err := repo.CreateTarget(context.Background(), 1, board)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Empty(t, follower)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, model.Follower{
+				ID:         11,
+				FollowerID: 1,
+				FolloweeID: 7,
+				TargetType: model.TargetTypeBoard,
+				CreatedAt:  createdAt,
+			}, follower)
+		})
+	}
+}
+
+func TestRepository_DeleteTarget(t *testing.T) {
+	tag := model.FollowTarget{Type: model.TargetTypeTag, ID: 3}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		expectedErr error
+	}{
+		{
+			name: "successful tag unfollow",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "target_type = @target_type AND followee_id = @target_id")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["target_type"] == "tag" && args["target_id"] == int64(3)
+					})).Return(createSuccessCommandTag(), nil)
+			},
+		},
+		{
+			name: "relation not found",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+					Return(createEmptyCommandTag(), nil)
+			},
+			expectedErr: custom_errors.ErrFollowRelationNotFound,
+		},
+		{
+			name: "database error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.AnythingOfType("string"), mock.Anything).
+					Return(createEmptyCommandTag(), errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrFollowRelationDeleteFail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			err := repo.DeleteTarget(context.Background(), 1, tag)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRepository_GetFollowedTargets(t *testing.T) {
+	tests := []struct {
+		name        string
+		offset      int32
+		mockSetup   func(*mocks.PgDB)
+		want        []int64
+		wantTotal   int64
+		expectedErr error
+	}{
+		{
+			name: "page of followed boards",
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRowsWithTotal(t, []int64{9, 7}, 2)
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "WHERE follower_id = @follower_id AND target_type = @target_type")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["follower_id"] == int64(1) && args["target_type"] == "board"
+					})).Return(rows, nil)
+			},
+			want:      []int64{9, 7},
+			wantTotal: 2,
+		},
+		{
+			name:   "empty page past the end counts separately",
+			offset: 20,
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRowsWithTotal(t, []int64{}, 0)
+				db.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(rows, nil)
+
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.AnythingOfType("*int64")).
+					Run(func(args mock.Arguments) { *args.Get(0).(*int64) = 4 }).
+					Return(nil)
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+			},
+			want:      []int64{},
+			wantTotal: 4,
+		},
+		{
+			name: "query error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, total, err := repo.GetFollowedTargets(context.Background(), 1, model.TargetTypeBoard, 10, tt.offset)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type FollowerGrowthRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewFollowerGrowthRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *FollowerGrowthRepository {
+	return &FollowerGrowthRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *FollowerGrowthRepository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
+func (r *FollowerGrowthRepository) AggregateDay(ctx context.Context, day time.Time) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("aggregate_follower_growth", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("aggregate_follower_growth", time.Since(start))
+	}()
+
+	dayStart := utcDay(day)
+	args := pgx.NamedArgs{
+		"day":       dayStart.Format(time.DateOnly),
+		"day_start": dayStart,
+		"day_end":   dayStart.AddDate(0, 0, 1),
+	}
+
+	// Every change other than a follow loses the followee a follower
+	query := `
+		INSERT INTO follower_growth (user_id, day, gained, lost)
+		SELECT followee_id, @day::date,
+			COUNT(*) FILTER (WHERE action = 'follow'),
+			COUNT(*) FILTER (WHERE action <> 'follow')
+		FROM relation_history
+		WHERE created_at >= @day_start AND created_at < @day_end
+		GROUP BY followee_id
+		ORDER BY followee_id
+		ON CONFLICT (user_id, day) DO UPDATE
+		SET gained = EXCLUDED.gained, lost = EXCLUDED.lost
+	`
+
+	_, err = r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to aggregate follower growth",
+			slog.Time("day", dayStart),
+			slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+
+	return nil
+}
+
+func (r *FollowerGrowthRepository) ListDaily(ctx context.Context, userID int64, from, to time.Time) (days []model.FollowerGrowthDay, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_follower_growth", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_follower_growth", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"from":    utcDay(from).Format(time.DateOnly),
+		"to":      utcDay(to).Format(time.DateOnly),
+	}
+
+	query := `
+		SELECT day::text, gained, lost
+		FROM follower_growth
+		WHERE user_id = @user_id AND day BETWEEN @from::date AND @to::date
+		ORDER BY day
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query follower growth",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	days = make([]model.FollowerGrowthDay, 0)
+	for rows.Next() {
+		var day string
+		var growth model.FollowerGrowthDay
+		if err := rows.Scan(&day, &growth.Gained, &growth.Lost); err != nil {
+			r.logger(ctx).Error("Failed to scan follower growth row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		growth.Day, err = time.Parse(time.DateOnly, day)
+		if err != nil {
+			r.logger(ctx).Error("Failed to parse follower growth day", slog.String("day", day), slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		days = append(days, growth)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during follower growth iteration", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return days, nil
+}
+
+func (r *FollowerGrowthRepository) TotalAt(ctx context.Context, userID int64, day time.Time) (total int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("follower_total_at", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("follower_total_at", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"day":     utcDay(day).Format(time.DateOnly),
+	}
+
+	// Walking back from the current count keeps totals right for follows older than the history
+	query := `
+		SELECT (
+			SELECT COUNT(*) FROM followers
+			WHERE followee_id = @user_id AND target_type = 'user'
+		) - COALESCE((
+			SELECT SUM(gained - lost) FROM follower_growth
+			WHERE user_id = @user_id AND day > @day::date
+		), 0)
+	`
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&total)
+	if err != nil {
+		r.logger(ctx).Error("Failed to count followers at day",
+			slog.Int64("user_id", userID),
+			slog.Time("day", day),
+			slog.String("error", err.Error()))
+		return 0, custom_errors.ErrDatabaseQuery
+	}
+
+	return total, nil
+}
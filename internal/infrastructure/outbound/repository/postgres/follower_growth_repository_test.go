@@ -0,0 +1,138 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func newTestFollowerGrowthRepository(db *mocks.PgDB) *repository_postgres.FollowerGrowthRepository {
+	return repository_postgres.NewFollowerGrowthRepository(db, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+}
+
+func TestFollowerGrowthRepository_AggregateDay(t *testing.T) {
+	// 01:30 in UTC+3 is still the previous UTC day
+	day := time.Date(2025, 6, 2, 1, 30, 0, 0, time.FixedZone("UTC+3", 3*60*60))
+	dayStart := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("day recounted from the relation history", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "FROM relation_history") &&
+					strings.Contains(query, "ORDER BY followee_id") &&
+					strings.Contains(query, "ON CONFLICT (user_id, day) DO UPDATE")
+			}),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				return args["day"] == "2025-06-01" &&
+					args["day_start"] == dayStart &&
+					args["day_end"] == dayStart.AddDate(0, 0, 1)
+			}),
+		).Return(pgconn.NewCommandTag("INSERT 0 3"), nil)
+
+		err := newTestFollowerGrowthRepository(mockDB).AggregateDay(context.Background(), day)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+
+		err := newTestFollowerGrowthRepository(mockDB).AggregateDay(context.Background(), day)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestFollowerGrowthRepository_ListDaily(t *testing.T) {
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	t.Run("days with changes", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockRows := mocks.NewRows(t)
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Scan", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*string) = "2025-06-03"
+			*args.Get(1).(*int64) = 5
+			*args.Get(2).(*int64) = 2
+		}).Return(nil).Once()
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
+		mockDB.On("Query",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "FROM follower_growth") && strings.Contains(query, "ORDER BY day")
+			}),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				return args["user_id"] == int64(1) && args["from"] == "2025-06-01" && args["to"] == "2025-06-30"
+			}),
+		).Return(mockRows, nil)
+
+		days, err := newTestFollowerGrowthRepository(mockDB).ListDaily(context.Background(), 1, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.FollowerGrowthDay{{Day: time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC), Gained: 5, Lost: 2}}, days)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil, errors.New("db error"))
+
+		_, err := newTestFollowerGrowthRepository(mockDB).ListDaily(context.Background(), 1, from, to)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestFollowerGrowthRepository_TotalAt(t *testing.T) {
+	day := time.Date(2025, 6, 30, 18, 0, 0, 0, time.UTC)
+
+	t.Run("total walked back from current followers", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockRow := mocks.NewRow(t)
+		mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*int64) = 42
+		}).Return(nil)
+		mockDB.On("QueryRow",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool { return strings.Contains(query, "day > @day::date") }),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool { return args["user_id"] == int64(1) && args["day"] == "2025-06-30" }),
+		).Return(mockRow)
+
+		total, err := newTestFollowerGrowthRepository(mockDB).TotalAt(context.Background(), 1, day)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), total)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockRow := mocks.NewRow(t)
+		mockRow.On("Scan", mock.Anything).Return(errors.New("db error"))
+		mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+
+		_, err := newTestFollowerGrowthRepository(mockDB).TotalAt(context.Background(), 1, day)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
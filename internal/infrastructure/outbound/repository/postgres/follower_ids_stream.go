@@ -0,0 +1,66 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// GetFollowerIDsAfter pages through the followers of followeeID with a keyset on follower_id,
+// served by idx_followers_followee_follower, so every chunk costs the same however deep it is.
+func (r *Repository) GetFollowerIDsAfter(ctx context.Context, followeeID, afterID int64, limit int32) (followerIDs []int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_follower_ids_after", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_follower_ids_after", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"followee_id": followeeID,
+		"after_id":    afterID,
+		"limit":       limit,
+	}
+
+	query := `
+		SELECT follower_id
+		FROM followers
+		WHERE followee_id = @followee_id AND target_type = 'user' AND follower_id > @after_id
+		ORDER BY follower_id
+		LIMIT @limit
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query follower ids",
+			slog.Int64("followee_id", followeeID),
+			slog.Int64("after_id", afterID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	followerIDs = make([]int64, 0, limit)
+	for rows.Next() {
+		var followerID int64
+		if err := rows.Scan(&followerID); err != nil {
+			r.logger(ctx).Error("Failed to scan follower id row",
+				slog.Int64("followee_id", followeeID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		followerIDs = append(followerIDs, followerID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during follower ids iteration",
+			slog.Int64("followee_id", followeeID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return followerIDs, nil
+}
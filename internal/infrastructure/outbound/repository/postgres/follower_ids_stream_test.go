@@ -0,0 +1,97 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func setupMockIDRows(t *testing.T, ids []int64) *mocks.Rows {
+	mockRows := mocks.NewRows(t)
+	for _, id := range ids {
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Scan", mock.AnythingOfType("*int64")).
+			Run(func(args mock.Arguments) { *args.Get(0).(*int64) = id }).
+			Return(nil).
+			Once()
+	}
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil).Maybe()
+	mockRows.On("Close").Return()
+	return mockRows
+}
+
+func TestRepository_GetFollowerIDsAfter(t *testing.T) {
+	isKeyset := func(query string) bool {
+		return strings.Contains(query, "follower_id > @after_id") &&
+			strings.Contains(query, "ORDER BY follower_id")
+	}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		want        []int64
+		expectedErr error
+	}{
+		{
+			name: "chunk after cursor",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(isKeyset),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["followee_id"] == int64(1) &&
+							args["after_id"] == int64(5) &&
+							args["limit"] == int32(3)
+					})).Return(setupMockIDRows(t, []int64{8, 9, 12}), nil)
+			},
+			want: []int64{8, 9, 12},
+		},
+		{
+			name: "no followers left",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.MatchedBy(isKeyset), mock.Anything).Return(setupMockIDRows(t, nil), nil)
+			},
+			want: []int64{},
+		},
+		{
+			name: "query error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, err := repo.GetFollowerIDsAfter(context.Background(), 1, 5, 3)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) LockFollowers(ctx context.Context, followeeID int64, followerIDs []int64) (following []int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("lock_followers", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("lock_followers", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"followee_id":  followeeID,
+		"follower_ids": followerIDs,
+	}
+
+	// Locking in id order keeps two transactions locking overlapping followers from deadlocking
+	query := `
+		SELECT follower_id
+		FROM followers
+		WHERE followee_id = @followee_id AND follower_id = ANY(@follower_ids) AND target_type = 'user'
+		ORDER BY follower_id
+		FOR SHARE
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to lock followers",
+			slog.Int64("followee_id", followeeID),
+			slog.Int("count", len(followerIDs)),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	following = make([]int64, 0, len(followerIDs))
+	for rows.Next() {
+		var followerID int64
+		if err := rows.Scan(&followerID); err != nil {
+			r.logger(ctx).Error("Failed to scan locked follower row",
+				slog.Int64("followee_id", followeeID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		following = append(following, followerID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during locked followers iteration",
+			slog.Int64("followee_id", followeeID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return following, nil
+}
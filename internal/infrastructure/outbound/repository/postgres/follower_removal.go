@@ -0,0 +1,61 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) DeleteFollowers(ctx context.Context, followeeID int64, followerIDs []int64) (removed []int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_followers", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_followers", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"followee_id":  followeeID,
+		"follower_ids": followerIDs,
+	}
+
+	query := `
+		DELETE FROM followers
+		WHERE followee_id = @followee_id AND follower_id = ANY(@follower_ids) AND target_type = 'user'
+		RETURNING follower_id
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to delete followers",
+			slog.Int64("followee_id", followeeID),
+			slog.Int("count", len(followerIDs)),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrFollowRelationDeleteFail
+	}
+	defer rows.Close()
+
+	removed = make([]int64, 0, len(followerIDs))
+	for rows.Next() {
+		var followerID int64
+		if err := rows.Scan(&followerID); err != nil {
+			r.logger(ctx).Error("Failed to scan removed follower row",
+				slog.Int64("followee_id", followeeID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrFollowRelationDeleteFail
+		}
+		removed = append(removed, followerID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during removed followers iteration",
+			slog.Int64("followee_id", followeeID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrFollowRelationDeleteFail
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,80 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestRepository_DeleteFollowers(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		want        []int64
+		expectedErr error
+	}{
+		{
+			name: "returns the removed followers",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "DELETE FROM followers") &&
+							strings.Contains(query, "follower_id = ANY(@follower_ids)") &&
+							strings.Contains(query, "RETURNING follower_id")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["followee_id"] == int64(1) &&
+							assert.ObjectsAreEqual([]int64{2, 3, 4}, args["follower_ids"])
+					})).Return(setupMockIDRows(t, []int64{2, 4}), nil)
+			},
+			want: []int64{2, 4},
+		},
+		{
+			name: "none of them were following",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(setupMockIDRows(t, nil), nil)
+			},
+			want: []int64{},
+		},
+		{
+			name: "query error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrFollowRelationDeleteFail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, err := repo.DeleteFollowers(context.Background(), 1, []int64{2, 3, 4})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
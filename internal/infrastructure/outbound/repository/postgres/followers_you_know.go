@@ -0,0 +1,84 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) GetFollowersYouKnow(ctx context.Context, viewerID, targetID int64, limit int32) (known []int64, total int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_followers_you_know", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_followers_you_know", time.Since(start))
+	}()
+
+	r.logger(ctx).Debug("Getting followers you know",
+		slog.Int64("viewer_id", viewerID),
+		slog.Int64("target_id", targetID))
+
+	args := pgx.NamedArgs{
+		"viewer_id": viewerID,
+		"target_id": targetID,
+		"limit":     limit,
+	}
+
+	// The target's followers are read in created_at order from idx_followers_followee_created_at
+	// and each one is probed against the viewer's followees through unique_follower_target.
+	query := `
+		SELECT
+			target_followers.follower_id,
+			COUNT(*) OVER() as total_count
+		FROM followers target_followers
+		JOIN followers viewer_followees
+			ON viewer_followees.follower_id = @viewer_id
+			AND viewer_followees.followee_id = target_followers.follower_id
+			AND viewer_followees.target_type = 'user'
+		WHERE target_followers.followee_id = @target_id AND target_followers.target_type = 'user'
+		ORDER BY target_followers.created_at DESC, target_followers.follower_id
+		LIMIT @limit
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query followers you know",
+			slog.Int64("viewer_id", viewerID),
+			slog.Int64("target_id", targetID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	known = make([]int64, 0, limit)
+	for rows.Next() {
+		var followerID int64
+		if err := rows.Scan(&followerID, &total); err != nil {
+			r.logger(ctx).Error("Failed to scan follower you know row",
+				slog.Int64("viewer_id", viewerID),
+				slog.Int64("target_id", targetID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+		known = append(known, followerID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during followers you know iteration",
+			slog.Int64("viewer_id", viewerID),
+			slog.Int64("target_id", targetID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+
+	r.logger(ctx).Debug("Successfully retrieved followers you know",
+		slog.Int64("viewer_id", viewerID),
+		slog.Int64("target_id", targetID),
+		slog.Int("count", len(known)),
+		slog.Int64("total", total))
+
+	return known, total, nil
+}
@@ -0,0 +1,87 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func TestRepository_GetFollowersYouKnow(t *testing.T) {
+	isIntersection := func(query string) bool {
+		return strings.Contains(query, "viewer_followees.follower_id = @viewer_id") &&
+			strings.Contains(query, "viewer_followees.followee_id = target_followers.follower_id") &&
+			strings.Contains(query, "target_followers.followee_id = @target_id")
+	}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		want        []int64
+		wantTotal   int64
+		expectedErr error
+	}{
+		{
+			name: "sample with total",
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRowsWithTotal(t, []int64{3, 4}, 14)
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(isIntersection),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["viewer_id"] == int64(1) &&
+							args["target_id"] == int64(2) &&
+							args["limit"] == int32(3)
+					})).Return(rows, nil)
+			},
+			want:      []int64{3, 4},
+			wantTotal: 14,
+		},
+		{
+			name: "empty intersection",
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRowsWithTotal(t, []int64{}, 0)
+				db.On("Query", mock.Anything, mock.MatchedBy(isIntersection), mock.Anything).Return(rows, nil)
+			},
+			want:      []int64{},
+			wantTotal: 0,
+		},
+		{
+			name: "query error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, total, err := repo.GetFollowersYouKnow(context.Background(), 1, 2, 3)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
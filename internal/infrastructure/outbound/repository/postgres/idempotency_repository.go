@@ -0,0 +1,129 @@
+package repository_postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type IdempotencyRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewIdempotencyRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *IdempotencyRepository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, userID int64, key string) (record model.IdempotencyRecord, found bool, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_idempotency_key", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_idempotency_key", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"key":     key,
+	}
+
+	query := `
+		SELECT user_id, idempotency_key, operation, target_id, created_at, expires_at
+		FROM idempotency_keys
+		WHERE user_id = @user_id AND idempotency_key = @key AND expires_at > NOW()
+	`
+
+	var operation string
+	err = r.db.QueryRow(ctx, query, args).Scan(&record.UserID, &record.Key, &operation, &record.TargetID, &record.CreatedAt, &record.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.IdempotencyRecord{}, false, nil
+		}
+		r.logger(ctx).Error("Failed to get idempotency key",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return model.IdempotencyRecord{}, false, custom_errors.ErrDatabaseQuery
+	}
+	record.Operation = model.IdempotentOperation(operation)
+
+	return record, true, nil
+}
+
+func (r *IdempotencyRepository) Claim(ctx context.Context, record model.IdempotencyRecord) (claimed bool, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("claim_idempotency_key", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("claim_idempotency_key", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"user_id":    record.UserID,
+		"key":        record.Key,
+		"operation":  string(record.Operation),
+		"target_id":  record.TargetID,
+		"expires_at": record.ExpiresAt,
+	}
+
+	// An expired record is taken over, a live one is kept and no row is returned
+	query := `
+		INSERT INTO idempotency_keys (user_id, idempotency_key, operation, target_id, created_at, expires_at)
+		VALUES (@user_id, @key, @operation, @target_id, NOW(), @expires_at)
+		ON CONFLICT (user_id, idempotency_key) DO UPDATE
+		SET operation = EXCLUDED.operation,
+			target_id = EXCLUDED.target_id,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= NOW()
+		RETURNING true
+	`
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&claimed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		r.logger(ctx).Error("Failed to claim idempotency key",
+			slog.Int64("user_id", record.UserID),
+			slog.String("operation", string(record.Operation)),
+			slog.String("error", err.Error()))
+		return false, custom_errors.ErrDatabaseQuery
+	}
+
+	return claimed, nil
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_expired_idempotency_keys", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_expired_idempotency_keys", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"now": now,
+	}
+
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= @now`
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to delete expired idempotency keys",
+			slog.Time("now", now),
+			slog.String("error", err.Error()))
+		return 0, custom_errors.ErrDatabaseQuery
+	}
+
+	return result.RowsAffected(), nil
+}
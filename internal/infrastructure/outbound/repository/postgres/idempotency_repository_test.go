@@ -0,0 +1,160 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func newTestIdempotencyRepository(db *mocks.PgDB) *repository_postgres.IdempotencyRepository {
+	return repository_postgres.NewIdempotencyRepository(db, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+}
+
+func TestIdempotencyRepository_Get(t *testing.T) {
+	expiresAt := time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		want        model.IdempotencyRecord
+		wantFound   bool
+		expectedErr error
+	}{
+		{
+			name: "live record found",
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					*args.Get(0).(*int64) = 1
+					*args.Get(1).(*string) = "key-1"
+					*args.Get(2).(*string) = "follow"
+					*args.Get(3).(*int64) = 2
+					*args.Get(5).(*time.Time) = expiresAt
+				}).Return(nil)
+				db.On("QueryRow",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool { return strings.Contains(query, "expires_at > NOW()") }),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool { return args["user_id"] == int64(1) && args["key"] == "key-1" }),
+				).Return(mockRow)
+			},
+			want:      model.IdempotencyRecord{UserID: 1, Key: "key-1", Operation: model.IdempotentOperationFollow, TargetID: 2, ExpiresAt: expiresAt},
+			wantFound: true,
+		},
+		{
+			name: "no record",
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+			},
+		},
+		{
+			name: "database error",
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error"))
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			got, found, err := newTestIdempotencyRepository(mockDB).Get(context.Background(), 1, "key-1")
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.False(t, found)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIdempotencyRepository_Claim(t *testing.T) {
+	record := model.IdempotencyRecord{UserID: 1, Key: "key-1", Operation: model.IdempotentOperationUnfollow, TargetID: 2, ExpiresAt: time.Now().Add(time.Hour)}
+
+	tests := []struct {
+		name        string
+		scanErr     error
+		wantClaimed bool
+		expectedErr error
+	}{
+		{
+			name:        "key claimed",
+			wantClaimed: true,
+		},
+		{
+			name:    "live record with the same key",
+			scanErr: pgx.ErrNoRows,
+		},
+		{
+			name:        "database error",
+			scanErr:     errors.New("db error"),
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			mockRow := mocks.NewRow(t)
+			scan := mockRow.On("Scan", mock.AnythingOfType("*bool"))
+			if tt.scanErr != nil {
+				scan.Return(tt.scanErr)
+			} else {
+				scan.Run(func(args mock.Arguments) { *args.Get(0).(*bool) = true }).Return(nil)
+			}
+			mockDB.On("QueryRow",
+				mock.Anything,
+				mock.MatchedBy(func(query string) bool {
+					return strings.Contains(query, "ON CONFLICT (user_id, idempotency_key) DO UPDATE") &&
+						strings.Contains(query, "WHERE idempotency_keys.expires_at <= NOW()")
+				}),
+				mock.MatchedBy(func(args pgx.NamedArgs) bool {
+					return args["operation"] == "unfollow" && args["target_id"] == int64(2)
+				}),
+			).Return(mockRow)
+
+			claimed, err := newTestIdempotencyRepository(mockDB).Claim(context.Background(), record)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantClaimed, claimed)
+		})
+	}
+}
+
+func TestIdempotencyRepository_DeleteExpired(t *testing.T) {
+	mockDB := mocks.NewPgDB(t)
+	mockDB.On("Exec", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(pgconn.NewCommandTag("DELETE 3"), nil)
+
+	deleted, err := newTestIdempotencyRepository(mockDB).DeleteExpired(context.Background(), time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+}
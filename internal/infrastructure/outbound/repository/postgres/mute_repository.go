@@ -0,0 +1,243 @@
+package repository_postgres
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// activeMuteCondition keeps mutes that expired but were not cleaned up yet out of every read
+const activeMuteCondition = `(expires_at IS NULL OR expires_at > NOW())`
+
+type MuteRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewMuteRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *MuteRepository {
+	return &MuteRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *MuteRepository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
+func (r *MuteRepository) Upsert(ctx context.Context, mute model.Mute) (saved model.Mute, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("upsert_mute", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("upsert_mute", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id": mute.FollowerID,
+		"followee_id": mute.FolloweeID,
+		"expires_at":  mute.ExpiresAt,
+	}
+
+	// Muting again only moves the expiry, unless the old mute already expired
+	query := `
+		INSERT INTO mutes (follower_id, followee_id, created_at, expires_at)
+		VALUES (@follower_id, @followee_id, NOW(), @expires_at)
+		ON CONFLICT (follower_id, followee_id) DO UPDATE
+		SET created_at = CASE
+				WHEN mutes.expires_at IS NOT NULL AND mutes.expires_at <= NOW() THEN EXCLUDED.created_at
+				ELSE mutes.created_at
+			END,
+			expires_at = EXCLUDED.expires_at
+		RETURNING follower_id, followee_id, created_at, expires_at
+	`
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&saved.FollowerID, &saved.FolloweeID, &saved.CreatedAt, &saved.ExpiresAt)
+	if err != nil {
+		r.logger(ctx).Error("Failed to upsert mute",
+			slog.Int64("follower_id", mute.FollowerID),
+			slog.Int64("followee_id", mute.FolloweeID),
+			slog.String("error", err.Error()))
+		return model.Mute{}, custom_errors.ErrDatabaseQuery
+	}
+
+	return saved, nil
+}
+
+func (r *MuteRepository) Delete(ctx context.Context, followerID, followeeID int64) (mute model.Mute, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_mute", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_mute", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id": followerID,
+		"followee_id": followeeID,
+	}
+
+	query := `
+		DELETE FROM mutes
+		WHERE follower_id = @follower_id AND followee_id = @followee_id AND ` + activeMuteCondition + `
+		RETURNING follower_id, followee_id, created_at, expires_at
+	`
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&mute.FollowerID, &mute.FolloweeID, &mute.CreatedAt, &mute.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Mute{}, model.ErrMuteNotFound
+		}
+		r.logger(ctx).Error("Failed to delete mute",
+			slog.Int64("follower_id", followerID),
+			slog.Int64("followee_id", followeeID),
+			slog.String("error", err.Error()))
+		return model.Mute{}, custom_errors.ErrDatabaseQuery
+	}
+
+	return mute, nil
+}
+
+func (r *MuteRepository) List(ctx context.Context, followerID int64, limit, offset int32) (mutes []model.Mute, total int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_mutes", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_mutes", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id": followerID,
+		"limit":       limit,
+		"offset":      offset,
+	}
+
+	where := `follower_id = @follower_id AND ` + activeMuteCondition
+
+	query := `
+		SELECT
+			follower_id, followee_id, created_at, expires_at,
+			COUNT(*) OVER() as total_count
+		FROM mutes
+		WHERE ` + where + `
+		ORDER BY created_at DESC, followee_id DESC
+		LIMIT @limit OFFSET @offset
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query mutes",
+			slog.Int64("follower_id", followerID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	mutes = make([]model.Mute, 0)
+	for rows.Next() {
+		var mute model.Mute
+		if err := rows.Scan(&mute.FollowerID, &mute.FolloweeID, &mute.CreatedAt, &mute.ExpiresAt, &total); err != nil {
+			r.logger(ctx).Error("Failed to scan mute row",
+				slog.Int64("follower_id", followerID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+		mutes = append(mutes, mute)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during mutes iteration",
+			slog.Int64("follower_id", followerID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+
+	if len(mutes) == 0 && offset > 0 {
+		countQuery := `SELECT COUNT(*) FROM mutes WHERE ` + where
+		err := r.db.QueryRow(ctx, countQuery, args).Scan(&total)
+		if err != nil {
+			r.logger(ctx).Error("Failed to count mutes for empty result",
+				slog.Int64("follower_id", followerID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+	}
+
+	return mutes, total, nil
+}
+
+func (r *MuteRepository) FilterMuted(ctx context.Context, followerID int64, authorIDs []int64) (muted []int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("filter_muted", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("filter_muted", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id": followerID,
+		"author_ids":  authorIDs,
+	}
+
+	query := `
+		SELECT followee_id
+		FROM mutes
+		WHERE follower_id = @follower_id AND followee_id = ANY(@author_ids) AND ` + activeMuteCondition + `
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to filter muted authors",
+			slog.Int64("follower_id", followerID),
+			slog.Int("count", len(authorIDs)),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	muted = make([]int64, 0)
+	for rows.Next() {
+		var authorID int64
+		if err := rows.Scan(&authorID); err != nil {
+			r.logger(ctx).Error("Failed to scan muted author",
+				slog.Int64("follower_id", followerID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		muted = append(muted, authorID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during muted authors iteration",
+			slog.Int64("follower_id", followerID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return muted, nil
+}
+
+func (r *MuteRepository) DeleteExpired(ctx context.Context, now time.Time) (deleted int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("delete_expired_mutes", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("delete_expired_mutes", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"now": now,
+	}
+
+	query := `DELETE FROM mutes WHERE expires_at <= @now`
+
+	result, err := r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to delete expired mutes",
+			slog.Time("now", now),
+			slog.String("error", err.Error()))
+		return 0, custom_errors.ErrDatabaseQuery
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,231 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func newTestMuteRepository(db *mocks.PgDB) *repository_postgres.MuteRepository {
+	return repository_postgres.NewMuteRepository(db, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+}
+
+// setupMuteRow makes a row that scans into mute
+func setupMuteRow(t *testing.T, mute model.Mute) *mocks.Row {
+	mockRow := mocks.NewRow(t)
+	mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(0).(*int64) = mute.FollowerID
+		*args.Get(1).(*int64) = mute.FolloweeID
+		*args.Get(2).(*time.Time) = mute.CreatedAt
+		*args.Get(3).(**time.Time) = mute.ExpiresAt
+	}).Return(nil)
+	return mockRow
+}
+
+func TestMuteRepository_Upsert(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2025, 6, 8, 12, 0, 0, 0, time.UTC)
+	mute := model.Mute{FollowerID: 1, FolloweeID: 2, ExpiresAt: &expiresAt}
+
+	t.Run("mute saved", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		saved := model.Mute{FollowerID: 1, FolloweeID: 2, CreatedAt: createdAt, ExpiresAt: &expiresAt}
+		mockDB.On("QueryRow",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "ON CONFLICT (follower_id, followee_id) DO UPDATE")
+			}),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				return args["follower_id"] == int64(1) && args["followee_id"] == int64(2) && args["expires_at"] == &expiresAt
+			}),
+		).Return(setupMuteRow(t, saved))
+
+		got, err := newTestMuteRepository(mockDB).Upsert(context.Background(), mute)
+
+		require.NoError(t, err)
+		assert.Equal(t, saved, got)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockRow := mocks.NewRow(t)
+		mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error"))
+		mockDB.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+
+		_, err := newTestMuteRepository(mockDB).Upsert(context.Background(), mute)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestMuteRepository_Delete(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		want        model.Mute
+		expectedErr error
+	}{
+		{
+			name: "active mute removed",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("QueryRow",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "DELETE FROM mutes") && strings.Contains(query, "expires_at > NOW()")
+					}),
+					mock.Anything,
+				).Return(setupMuteRow(t, model.Mute{FollowerID: 1, FolloweeID: 2, CreatedAt: createdAt}))
+			},
+			want: model.Mute{FollowerID: 1, FolloweeID: 2, CreatedAt: createdAt},
+		},
+		{
+			name: "no active mute",
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+			},
+			expectedErr: model.ErrMuteNotFound,
+		},
+		{
+			name: "database error",
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error"))
+				db.On("QueryRow", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(mockRow)
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			got, err := newTestMuteRepository(mockDB).Delete(context.Background(), 1, 2)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMuteRepository_List(t *testing.T) {
+	createdAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("active mutes with total", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockRows := mocks.NewRows(t)
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(0).(*int64) = 1
+			*args.Get(1).(*int64) = 2
+			*args.Get(2).(*time.Time) = createdAt
+			*args.Get(4).(*int64) = 3
+		}).Return(nil).Once()
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Err").Return(nil)
+		mockRows.On("Close").Return()
+		mockDB.On("Query",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "expires_at > NOW()") && strings.Contains(query, "ORDER BY created_at DESC")
+			}),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				return args["follower_id"] == int64(1) && args["limit"] == int32(10) && args["offset"] == int32(0)
+			}),
+		).Return(mockRows, nil)
+
+		mutes, total, err := newTestMuteRepository(mockDB).List(context.Background(), 1, 10, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, []model.Mute{{FollowerID: 1, FolloweeID: 2, CreatedAt: createdAt}}, mutes)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil, errors.New("db error"))
+
+		_, _, err := newTestMuteRepository(mockDB).List(context.Background(), 1, 10, 0)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestMuteRepository_FilterMuted(t *testing.T) {
+	t.Run("muted authors returned", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Query",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool { return strings.Contains(query, "followee_id = ANY(@author_ids)") }),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				authorIDs, ok := args["author_ids"].([]int64)
+				return args["follower_id"] == int64(1) && ok && len(authorIDs) == 3
+			}),
+		).Return(setupMockIDRows(t, []int64{3}), nil)
+
+		muted, err := newTestMuteRepository(mockDB).FilterMuted(context.Background(), 1, []int64{2, 3, 4})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3}, muted)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil, errors.New("db error"))
+
+		_, err := newTestMuteRepository(mockDB).FilterMuted(context.Background(), 1, []int64{2})
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestMuteRepository_DeleteExpired(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("expired mutes removed", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool { return strings.Contains(query, "expires_at <= @now") }),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool { return args["now"] == now }),
+		).Return(pgconn.NewCommandTag("DELETE 4"), nil)
+
+		deleted, err := newTestMuteRepository(mockDB).DeleteExpired(context.Background(), now)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), deleted)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+
+		_, err := newTestMuteRepository(mockDB).DeleteExpired(context.Background(), now)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
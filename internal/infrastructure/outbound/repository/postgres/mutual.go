@@ -0,0 +1,142 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *Repository) GetMutualFollows(ctx context.Context, userID int64, limit, offset int32) (mutuals []int64, total int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_mutual_follows", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_mutual_follows", time.Since(start))
+	}()
+
+	r.logger(ctx).Info("Getting mutual follows", slog.Int64("user_id", userID))
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"limit":   limit,
+		"offset":  offset,
+	}
+
+	// A pair is mutual from the moment the second follow was created
+	query := `
+		SELECT
+			outgoing.followee_id,
+			COUNT(*) OVER() as total_count
+		FROM followers outgoing
+		JOIN followers incoming
+			ON incoming.follower_id = outgoing.followee_id
+			AND incoming.followee_id = outgoing.follower_id
+			AND incoming.target_type = 'user'
+		WHERE outgoing.follower_id = @user_id AND outgoing.target_type = 'user'
+		ORDER BY GREATEST(outgoing.created_at, incoming.created_at) DESC, outgoing.followee_id
+		LIMIT @limit OFFSET @offset
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query mutual follows",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	mutualList := make([]int64, 0)
+	var totalCount int64
+
+	for rows.Next() {
+		var mutualID int64
+		if err := rows.Scan(&mutualID, &totalCount); err != nil {
+			r.logger(ctx).Error("Failed to scan mutual follow row",
+				slog.Int64("user_id", userID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+		mutualList = append(mutualList, mutualID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during mutual follows iteration",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+
+	if len(mutualList) == 0 && offset > 0 {
+		countArgs := pgx.NamedArgs{
+			"user_id": userID,
+		}
+
+		countQuery := `
+		SELECT COUNT(*)
+		FROM followers outgoing
+		JOIN followers incoming
+			ON incoming.follower_id = outgoing.followee_id
+			AND incoming.followee_id = outgoing.follower_id
+			AND incoming.target_type = 'user'
+		WHERE outgoing.follower_id = @user_id AND outgoing.target_type = 'user'
+	`
+		err := r.db.QueryRow(ctx, countQuery, countArgs).Scan(&totalCount)
+		if err != nil {
+			r.logger(ctx).Error("Failed to count mutual follows for empty result",
+				slog.Int64("user_id", userID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+	}
+
+	r.logger(ctx).Info("Successfully retrieved mutual follows",
+		slog.Int64("user_id", userID),
+		slog.Int("count", len(mutualList)),
+		slog.Int64("total", totalCount))
+
+	return mutualList, totalCount, nil
+}
+
+func (r *Repository) IsMutual(ctx context.Context, userID, otherUserID int64) (mutual bool, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("check_mutual_follow", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("check_mutual_follow", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"user_id":       userID,
+		"other_user_id": otherUserID,
+	}
+
+	query := `
+		SELECT EXISTS(
+			SELECT 1
+			FROM followers outgoing
+			JOIN followers incoming
+				ON incoming.follower_id = outgoing.followee_id
+				AND incoming.followee_id = outgoing.follower_id
+				AND incoming.target_type = 'user'
+			WHERE outgoing.follower_id = @user_id AND outgoing.followee_id = @other_user_id AND outgoing.target_type = 'user'
+		)
+	`
+
+	err = r.db.QueryRow(ctx, query, args).Scan(&mutual)
+	if err != nil {
+		r.logger(ctx).Error("Failed to check mutual follow",
+			slog.Int64("user_id", userID),
+			slog.Int64("other_user_id", otherUserID),
+			slog.String("error", err.Error()))
+		return false, custom_errors.ErrDatabaseQuery
+	}
+
+	r.logger(ctx).Debug("Mutual follow check completed",
+		slog.Int64("user_id", userID),
+		slog.Int64("other_user_id", otherUserID),
+		slog.Bool("mutual", mutual))
+	return mutual, nil
+}
@@ -0,0 +1,172 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func isMutualSelfJoin(query string) bool {
+	return strings.Contains(query, "JOIN followers incoming") &&
+		strings.Contains(query, "incoming.follower_id = outgoing.followee_id") &&
+		strings.Contains(query, "incoming.followee_id = outgoing.follower_id")
+}
+
+func TestRepository_GetMutualFollows(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      int64
+		limit       int32
+		offset      int32
+		mockSetup   func(*mocks.PgDB)
+		want        []int64
+		wantTotal   int64
+		expectedErr error
+	}{
+		{
+			name:   "successful get mutual follows",
+			userID: 1,
+			limit:  10,
+			offset: 0,
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRowsWithTotal(t, []int64{2, 3}, 2)
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(isMutualSelfJoin),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["user_id"] == int64(1) &&
+							args["limit"] == int32(10) &&
+							args["offset"] == int32(0)
+					})).Return(rows, nil)
+			},
+			want:      []int64{2, 3},
+			wantTotal: 2,
+		},
+		{
+			name:   "first page without mutuals skips the count query",
+			userID: 1,
+			limit:  10,
+			offset: 0,
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRowsWithTotal(t, []int64{}, 0)
+				db.On("Query", mock.Anything, mock.MatchedBy(isMutualSelfJoin), mock.Anything).Return(rows, nil)
+			},
+			want:      []int64{},
+			wantTotal: 0,
+		},
+		{
+			name:   "page past the end still reports the total",
+			userID: 1,
+			limit:  10,
+			offset: 20,
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRowsWithTotal(t, []int64{}, 0)
+				db.On("Query", mock.Anything, mock.MatchedBy(isMutualSelfJoin), mock.Anything).Return(rows, nil)
+
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.AnythingOfType("*int64")).Run(func(args mock.Arguments) {
+					*args.Get(0).(*int64) = 15
+				}).Return(nil)
+				db.On("QueryRow", mock.Anything, mock.MatchedBy(isMutualSelfJoin), mock.Anything).Return(mockRow)
+			},
+			want:      []int64{},
+			wantTotal: 15,
+		},
+		{
+			name:   "query error",
+			userID: 1,
+			limit:  10,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockDB)
+			}
+
+			repo := repository_postgres.NewFollowRepository(mockDB, log, metrics)
+			got, total, err := repo.GetMutualFollows(context.Background(), tt.userID, tt.limit, tt.offset)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
+
+func TestRepository_IsMutual(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		want        bool
+		expectedErr error
+	}{
+		{
+			name: "mutual",
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.AnythingOfType("*bool")).Run(func(args mock.Arguments) {
+					*args.Get(0).(*bool) = true
+				}).Return(nil)
+				db.On("QueryRow",
+					mock.Anything,
+					mock.MatchedBy(isMutualSelfJoin),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["user_id"] == int64(1) && args["other_user_id"] == int64(2)
+					})).Return(mockRow)
+			},
+			want: true,
+		},
+		{
+			name: "database error",
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.AnythingOfType("*bool")).Return(errors.New("db error"))
+				db.On("QueryRow", mock.Anything, mock.Anything, mock.Anything).Return(mockRow)
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewFollowRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, err := repo.IsMutual(context.Background(), 1, 2)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
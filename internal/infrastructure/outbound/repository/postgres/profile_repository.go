@@ -0,0 +1,240 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ProfileRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewProfileRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *ProfileRepository {
+	return &ProfileRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *ProfileRepository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
+func (r *ProfileRepository) Upsert(ctx context.Context, users []*model.User) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("upsert_profiles", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("upsert_profiles", time.Since(start))
+	}()
+
+	userIDs := make([]int64, 0, len(users))
+	usernames := make([]string, 0, len(users))
+	fullNames := make([]*string, 0, len(users))
+	avatarURLs := make([]*string, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+		usernames = append(usernames, user.Username)
+		fullNames = append(fullNames, user.FullName)
+		avatarURLs = append(avatarURLs, user.AvatarURL)
+	}
+
+	args := pgx.NamedArgs{
+		"user_ids":    userIDs,
+		"usernames":   usernames,
+		"full_names":  fullNames,
+		"avatar_urls": avatarURLs,
+	}
+
+	query := `
+		INSERT INTO user_profiles (user_id, username, full_name, avatar_url, synced_at)
+		SELECT profile.user_id, profile.username, profile.full_name, profile.avatar_url, NOW()
+		FROM unnest(@user_ids::bigint[], @usernames::text[], @full_names::text[], @avatar_urls::text[])
+			AS profile(user_id, username, full_name, avatar_url)
+		ON CONFLICT (user_id) DO UPDATE
+			SET username = EXCLUDED.username,
+				full_name = EXCLUDED.full_name,
+				avatar_url = EXCLUDED.avatar_url,
+				synced_at = EXCLUDED.synced_at
+	`
+
+	_, err = r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to upsert user profiles",
+			slog.Int("count", len(users)),
+			slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+
+	return nil
+}
+
+func (r *ProfileRepository) SearchFollowers(ctx context.Context, userID int64, query string, limit int32) (matches []model.ProfileMatch, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("search_followers", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("search_followers", time.Since(start))
+	}()
+
+	return r.search(ctx, followersSide, userID, query, limit)
+}
+
+func (r *ProfileRepository) SearchFollowees(ctx context.Context, userID int64, query string, limit int32) (matches []model.ProfileMatch, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("search_followees", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("search_followees", time.Since(start))
+	}()
+
+	return r.search(ctx, followeesSide, userID, query, limit)
+}
+
+// search ranks the stored profiles on one side of userID's follow graph against query,
+// which must already be trimmed and lower-cased. Exact and prefix matches on the username
+// rank first, then prefix matches on any word of the full name; pg_trgm similarity adds
+// to those and on its own admits misspelled names through the % operator.
+func (r *ProfileRepository) search(ctx context.Context, side followListSide, userID int64, query string, limit int32) ([]model.ProfileMatch, error) {
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"query":   query,
+		"prefix":  escapeLike(query) + "%",
+		"limit":   limit,
+	}
+
+	sql := `
+		SELECT p.user_id, p.username, p.full_name, p.avatar_url, ranked.score
+		FROM followers f
+		JOIN user_profiles p ON p.user_id = f.` + side.listed + `
+		CROSS JOIN LATERAL (
+			SELECT
+				CASE
+					WHEN lower(p.username) = @query THEN 3
+					WHEN lower(p.username) LIKE @prefix THEN 2
+					WHEN lower(p.full_name) LIKE @prefix OR lower(p.full_name) LIKE '% ' || @prefix THEN 1
+					ELSE 0
+				END
+				+ GREATEST(similarity(lower(p.username), @query), similarity(lower(COALESCE(p.full_name, '')), @query))
+				AS score
+		) ranked
+		WHERE f.` + side.owner + ` = @user_id AND f.target_type = 'user'
+			AND (
+				lower(p.username) LIKE @prefix
+				OR lower(p.full_name) LIKE @prefix
+				OR lower(p.full_name) LIKE '% ' || @prefix
+				OR lower(p.username) % @query
+				OR lower(p.full_name) % @query
+			)
+		ORDER BY ranked.score DESC, p.username, p.user_id
+		LIMIT @limit
+	`
+
+	rows, err := r.db.Query(ctx, sql, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to search profiles",
+			slog.String("listed", side.listed),
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	matches := make([]model.ProfileMatch, 0, limit)
+	for rows.Next() {
+		user := &model.User{}
+		var score float64
+		if err := rows.Scan(&user.ID, &user.Username, &user.FullName, &user.AvatarURL, &score); err != nil {
+			r.logger(ctx).Error("Failed to scan profile match row",
+				slog.Int64("user_id", userID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		matches = append(matches, model.ProfileMatch{User: user, Score: score})
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during profile matches iteration",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return matches, nil
+}
+
+func (r *ProfileRepository) ListUnsynced(ctx context.Context, staleBefore time.Time, afterID int64, limit int32) (userIDs []int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_unsynced_profiles", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_unsynced_profiles", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"stale_before": staleBefore,
+		"after_id":     afterID,
+		"limit":        limit,
+	}
+
+	// The recursive walk is a loose index scan: each step jumps to the next distinct user id
+	// through the follower_id and followee_id indexes, so ids come out ascending and a page
+	// stops reading the followers table once the limit is reached
+	query := `
+		WITH RECURSIVE graph(user_id) AS (
+			SELECT LEAST(
+				(SELECT MIN(follower_id) FROM followers WHERE target_type = 'user' AND follower_id > @after_id),
+				(SELECT MIN(followee_id) FROM followers WHERE target_type = 'user' AND followee_id > @after_id)
+			)
+			UNION ALL
+			SELECT LEAST(
+				(SELECT MIN(follower_id) FROM followers WHERE target_type = 'user' AND follower_id > graph.user_id),
+				(SELECT MIN(followee_id) FROM followers WHERE target_type = 'user' AND followee_id > graph.user_id)
+			)
+			FROM graph
+			WHERE graph.user_id IS NOT NULL
+		)
+		SELECT graph.user_id
+		FROM graph
+		LEFT JOIN LATERAL (
+			SELECT synced_at FROM user_profiles WHERE user_profiles.user_id = graph.user_id
+		) p ON true
+		WHERE graph.user_id IS NOT NULL AND (p.synced_at IS NULL OR p.synced_at < @stale_before)
+		LIMIT @limit
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to list unsynced profiles",
+			slog.Int64("after_id", afterID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	userIDs = make([]int64, 0, limit)
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			r.logger(ctx).Error("Failed to scan unsynced profile row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during unsynced profiles iteration", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return userIDs, nil
+}
+
+// escapeLike makes LIKE treat the wildcards and the escape character in s literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
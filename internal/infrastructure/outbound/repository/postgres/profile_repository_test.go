@@ -0,0 +1,209 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func setupMockProfileRows(t *testing.T, matches []model.ProfileMatch) *mocks.Rows {
+	mockRows := mocks.NewRows(t)
+	for _, match := range matches {
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Scan",
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("*string"),
+			mock.AnythingOfType("**string"),
+			mock.AnythingOfType("**string"),
+			mock.AnythingOfType("*float64")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*int64) = match.User.ID
+				*args.Get(1).(*string) = match.User.Username
+				*args.Get(2).(**string) = match.User.FullName
+				*args.Get(3).(**string) = match.User.AvatarURL
+				*args.Get(4).(*float64) = match.Score
+			}).
+			Return(nil).
+			Once()
+	}
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil).Maybe()
+	mockRows.On("Close").Return()
+	return mockRows
+}
+
+func newTestProfileRepository(db *mocks.PgDB) *repository_postgres.ProfileRepository {
+	return repository_postgres.NewProfileRepository(db, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+}
+
+func TestProfileRepository_Upsert(t *testing.T) {
+	fullName := "Anna Smith"
+	users := []*model.User{
+		{ID: 1, Username: "anna", FullName: &fullName},
+		{ID: 2, Username: "bob"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "INSERT INTO user_profiles") &&
+					strings.Contains(query, "ON CONFLICT (user_id) DO UPDATE")
+			}),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				fullNames := args["full_names"].([]*string)
+				return assert.ObjectsAreEqual([]int64{1, 2}, args["user_ids"]) &&
+					assert.ObjectsAreEqual([]string{"anna", "bob"}, args["usernames"]) &&
+					len(fullNames) == 2 && *fullNames[0] == fullName && fullNames[1] == nil
+			})).Return(createSuccessCommandTag(), nil)
+
+		err := newTestProfileRepository(mockDB).Upsert(context.Background(), users)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("exec error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(createEmptyCommandTag(), errors.New("db error"))
+
+		err := newTestProfileRepository(mockDB).Upsert(context.Background(), users)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestProfileRepository_Search(t *testing.T) {
+	fullName := "Anna Smith"
+	matches := []model.ProfileMatch{
+		{User: &model.User{ID: 3, Username: "anna", FullName: &fullName}, Score: 4},
+		{User: &model.User{ID: 7, Username: "annabel"}, Score: 2.4},
+	}
+
+	tests := []struct {
+		name        string
+		search      func(*repository_postgres.ProfileRepository) ([]model.ProfileMatch, error)
+		mockSetup   func(*mocks.PgDB)
+		want        []model.ProfileMatch
+		expectedErr error
+	}{
+		{
+			name: "followers ranked by score",
+			search: func(repo *repository_postgres.ProfileRepository) ([]model.ProfileMatch, error) {
+				return repo.SearchFollowers(context.Background(), 1, "anna", 10)
+			},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "JOIN user_profiles p ON p.user_id = f.follower_id") &&
+							strings.Contains(query, "WHERE f.followee_id = @user_id") &&
+							strings.Contains(query, "lower(p.username) % @query") &&
+							strings.Contains(query, "ORDER BY ranked.score DESC")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["user_id"] == int64(1) &&
+							args["query"] == "anna" &&
+							args["prefix"] == "anna%" &&
+							args["limit"] == int32(10)
+					})).Return(setupMockProfileRows(t, matches), nil)
+			},
+			want: matches,
+		},
+		{
+			name: "followees with escaped prefix",
+			search: func(repo *repository_postgres.ProfileRepository) ([]model.ProfileMatch, error) {
+				return repo.SearchFollowees(context.Background(), 1, `a_b%`, 5)
+			},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "JOIN user_profiles p ON p.user_id = f.followee_id") &&
+							strings.Contains(query, "WHERE f.follower_id = @user_id")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["query"] == `a_b%` && args["prefix"] == `a\_b\%%`
+					})).Return(setupMockProfileRows(t, nil), nil)
+			},
+			want: []model.ProfileMatch{},
+		},
+		{
+			name: "query error",
+			search: func(repo *repository_postgres.ProfileRepository) ([]model.ProfileMatch, error) {
+				return repo.SearchFollowers(context.Background(), 1, "anna", 10)
+			},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			got, err := tt.search(newTestProfileRepository(mockDB))
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProfileRepository_ListUnsynced(t *testing.T) {
+	staleBefore := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("missing and stale profiles after cursor", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Query",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "WITH RECURSIVE graph") &&
+					strings.Contains(query, "p.synced_at IS NULL OR p.synced_at < @stale_before")
+			}),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				return args["stale_before"] == staleBefore &&
+					args["after_id"] == int64(10) &&
+					args["limit"] == int32(2)
+			})).Return(setupMockIDRows(t, []int64{11, 15}), nil)
+
+		got, err := newTestProfileRepository(mockDB).ListUnsynced(context.Background(), staleBefore, 10, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{11, 15}, got)
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+
+		got, err := newTestProfileRepository(mockDB).ListUnsynced(context.Background(), staleBefore, 0, 2)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+		assert.Nil(t, got)
+	})
+}
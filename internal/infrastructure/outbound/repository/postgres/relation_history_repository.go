@@ -0,0 +1,135 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type RelationHistoryRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewRelationHistoryRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *RelationHistoryRepository {
+	return &RelationHistoryRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *RelationHistoryRepository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
+func (r *RelationHistoryRepository) Record(ctx context.Context, entry model.RelationHistoryEntry) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("record_relation_history", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("record_relation_history", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"follower_id": entry.FollowerID,
+		"followee_id": entry.FolloweeID,
+		"action":      string(entry.Action),
+		"actor_id":    entry.ActorID,
+		"reason":      string(entry.Reason),
+	}
+
+	query := `
+		INSERT INTO relation_history (follower_id, followee_id, action, actor_id, reason, created_at)
+		VALUES (@follower_id, @followee_id, @action, @actor_id, @reason, NOW())
+	`
+
+	_, err = r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to record relation history",
+			slog.Int64("follower_id", entry.FollowerID),
+			slog.Int64("followee_id", entry.FolloweeID),
+			slog.String("action", string(entry.Action)),
+			slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+
+	return nil
+}
+
+func (r *RelationHistoryRepository) List(ctx context.Context, filter model.RelationHistoryFilter, limit, offset int32) (entries []model.RelationHistoryEntry, total int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_relation_history", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_relation_history", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"user_id": filter.UserID,
+		"limit":   limit,
+		"offset":  offset,
+	}
+
+	where := `follower_id = @user_id OR followee_id = @user_id`
+	if filter.OtherUserID != nil {
+		args["other_user_id"] = *filter.OtherUserID
+		where = `(follower_id = @user_id AND followee_id = @other_user_id)
+			OR (follower_id = @other_user_id AND followee_id = @user_id)`
+	}
+
+	query := `
+		SELECT
+			id, follower_id, followee_id, action, actor_id, reason, created_at,
+			COUNT(*) OVER() as total_count
+		FROM relation_history
+		WHERE ` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT @limit OFFSET @offset
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query relation history",
+			slog.Int64("user_id", filter.UserID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	entries = make([]model.RelationHistoryEntry, 0)
+	for rows.Next() {
+		var entry model.RelationHistoryEntry
+		var action, reason string
+		if err := rows.Scan(&entry.ID, &entry.FollowerID, &entry.FolloweeID, &action, &entry.ActorID, &reason, &entry.CreatedAt, &total); err != nil {
+			r.logger(ctx).Error("Failed to scan relation history row",
+				slog.Int64("user_id", filter.UserID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+		entry.Action = model.RelationAction(action)
+		entry.Reason = model.RelationReason(reason)
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during relation history iteration",
+			slog.Int64("user_id", filter.UserID),
+			slog.String("error", err.Error()))
+		return nil, 0, custom_errors.ErrDatabaseQuery
+	}
+
+	if len(entries) == 0 && offset > 0 {
+		countQuery := `SELECT COUNT(*) FROM relation_history WHERE ` + where
+		err := r.db.QueryRow(ctx, countQuery, args).Scan(&total)
+		if err != nil {
+			r.logger(ctx).Error("Failed to count relation history for empty result",
+				slog.Int64("user_id", filter.UserID),
+				slog.String("error", err.Error()))
+			return nil, 0, custom_errors.ErrDatabaseQuery
+		}
+	}
+
+	return entries, total, nil
+}
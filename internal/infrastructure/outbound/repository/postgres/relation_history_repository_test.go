@@ -0,0 +1,196 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func setupMockHistoryRows(t *testing.T, entries []model.RelationHistoryEntry, total int64) *mocks.Rows {
+	mockRows := mocks.NewRows(t)
+	for _, entry := range entries {
+		mockRows.On("Next").Return(true).Once()
+		mockRows.On("Scan",
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("*int64"),
+			mock.AnythingOfType("*string"),
+			mock.AnythingOfType("**int64"),
+			mock.AnythingOfType("*string"),
+			mock.AnythingOfType("*time.Time"),
+			mock.AnythingOfType("*int64")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*int64) = entry.ID
+				*args.Get(1).(*int64) = entry.FollowerID
+				*args.Get(2).(*int64) = entry.FolloweeID
+				*args.Get(3).(*string) = string(entry.Action)
+				*args.Get(4).(**int64) = entry.ActorID
+				*args.Get(5).(*string) = string(entry.Reason)
+				*args.Get(6).(*time.Time) = entry.CreatedAt
+				*args.Get(7).(*int64) = total
+			}).
+			Return(nil).
+			Once()
+	}
+	mockRows.On("Next").Return(false).Once()
+	mockRows.On("Err").Return(nil).Maybe()
+	mockRows.On("Close").Return()
+	return mockRows
+}
+
+func newTestRelationHistoryRepository(db *mocks.PgDB) *repository_postgres.RelationHistoryRepository {
+	return repository_postgres.NewRelationHistoryRepository(db, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+}
+
+func TestRelationHistoryRepository_Record(t *testing.T) {
+	entry := model.RelationHistoryEntry{
+		FollowerID: 1,
+		FolloweeID: 2,
+		Action:     model.RelationActionUnfollow,
+		Reason:     model.RelationReasonCascade,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec",
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "INSERT INTO relation_history")
+			}),
+			mock.MatchedBy(func(args pgx.NamedArgs) bool {
+				return args["follower_id"] == int64(1) &&
+					args["followee_id"] == int64(2) &&
+					args["action"] == "unfollow" &&
+					args["actor_id"] == (*int64)(nil) &&
+					args["reason"] == "cascade"
+			})).Return(createSuccessCommandTag(), nil)
+
+		err := newTestRelationHistoryRepository(mockDB).Record(context.Background(), entry)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("exec error", func(t *testing.T) {
+		mockDB := mocks.NewPgDB(t)
+		mockDB.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(createEmptyCommandTag(), errors.New("db error"))
+
+		err := newTestRelationHistoryRepository(mockDB).Record(context.Background(), entry)
+
+		assert.ErrorIs(t, err, custom_errors.ErrDatabaseQuery)
+	})
+}
+
+func TestRelationHistoryRepository_List(t *testing.T) {
+	actorID := int64(2)
+	otherUserID := int64(2)
+	entries := []model.RelationHistoryEntry{
+		{ID: 7, FollowerID: 2, FolloweeID: 1, Action: model.RelationActionUnfollow, ActorID: &actorID, Reason: model.RelationReasonUser, CreatedAt: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 3, FollowerID: 2, FolloweeID: 1, Action: model.RelationActionFollow, ActorID: &actorID, Reason: model.RelationReasonUser, CreatedAt: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name        string
+		filter      model.RelationHistoryFilter
+		offset      int32
+		mockSetup   func(*mocks.PgDB)
+		want        []model.RelationHistoryEntry
+		wantTotal   int64
+		expectedErr error
+	}{
+		{
+			name:   "all history of a user",
+			filter: model.RelationHistoryFilter{UserID: 1},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "follower_id = @user_id OR followee_id = @user_id") &&
+							strings.Contains(query, "ORDER BY created_at DESC, id DESC")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						_, hasOther := args["other_user_id"]
+						return args["user_id"] == int64(1) && args["limit"] == int32(10) && !hasOther
+					})).Return(setupMockHistoryRows(t, entries, 2), nil)
+			},
+			want:      entries,
+			wantTotal: 2,
+		},
+		{
+			name:   "history of a pair in both directions",
+			filter: model.RelationHistoryFilter{UserID: 1, OtherUserID: &otherUserID},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "(follower_id = @user_id AND followee_id = @other_user_id)") &&
+							strings.Contains(query, "(follower_id = @other_user_id AND followee_id = @user_id)")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["user_id"] == int64(1) && args["other_user_id"] == int64(2)
+					})).Return(setupMockHistoryRows(t, entries[:1], 1), nil)
+			},
+			want:      entries[:1],
+			wantTotal: 1,
+		},
+		{
+			name:   "page past the end still counts",
+			filter: model.RelationHistoryFilter{UserID: 1},
+			offset: 20,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(setupMockHistoryRows(t, nil, 0), nil)
+
+				mockRow := mocks.NewRow(t)
+				mockRow.On("Scan", mock.AnythingOfType("*int64")).
+					Run(func(args mock.Arguments) { *args.Get(0).(*int64) = 4 }).
+					Return(nil)
+				db.On("QueryRow", mock.Anything, mock.MatchedBy(func(query string) bool {
+					return strings.Contains(query, "SELECT COUNT(*) FROM relation_history")
+				}), mock.Anything).Return(mockRow)
+			},
+			want:      []model.RelationHistoryEntry{},
+			wantTotal: 4,
+		},
+		{
+			name:   "query error",
+			filter: model.RelationHistoryFilter{UserID: 1},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			got, total, err := newTestRelationHistoryRepository(mockDB).List(context.Background(), tt.filter, 10, tt.offset)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
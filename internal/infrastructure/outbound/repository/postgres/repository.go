@@ -2,6 +2,7 @@ package repository_postgres
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	model "pinstack-relation-service/internal/domain/models"
 	ports "pinstack-relation-service/internal/domain/ports/output"
@@ -22,57 +23,80 @@ func NewFollowRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvide
 	return &Repository{db: db, log: log, metrics: metrics}
 }
 
-func (r *Repository) Create(ctx context.Context, followerID, followeeID int64) (follower model.Follower, err error) {
+func (r *Repository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
+// Create follows followeeID in a single statement that is safe against concurrent follows of
+// the same user. created is false when the follow already existed, follower is then the existing one
+// and keeps its original attribution.
+func (r *Repository) Create(ctx context.Context, followerID, followeeID int64, attribution model.FollowAttribution) (follower model.Follower, created bool, err error) {
 	start := time.Now()
 	defer func() {
 		r.metrics.IncrementDatabaseQueries("create_follow_relation", err == nil)
 		r.metrics.RecordDatabaseQueryDuration("create_follow_relation", time.Since(start))
 	}()
 
-	r.log.Info("Creating follow relation", slog.Int64("follower_id", followerID), slog.Int64("followee_id", followeeID))
+	r.logger(ctx).Info("Creating follow relation", slog.Int64("follower_id", followerID), slog.Int64("followee_id", followeeID))
 
 	if followerID == followeeID {
-		r.log.Error("Attempt to follow yourself",
+		r.logger(ctx).Error("Attempt to follow yourself",
 			slog.Int64("user_id", followerID))
-		return model.Follower{}, custom_errors.ErrSelfFollow
+		return model.Follower{}, false, custom_errors.ErrSelfFollow
 	}
 
 	args := pgx.NamedArgs{
 		"follower_id": followerID,
 		"followee_id": followeeID,
+		"source":      string(attribution.Source),
+		"context_id":  attribution.ContextID,
 	}
 
+	// DO NOTHING returns no row when a concurrent transaction created the follow first, the no-op
+	// update locks and returns that row instead. xmax is zero only for a freshly inserted row.
 	query := `
-		INSERT INTO followers (follower_id, followee_id, created_at)
-		VALUES (@follower_id, @followee_id, NOW())
-		ON CONFLICT (follower_id, followee_id) DO NOTHING
-		RETURNING id, follower_id, followee_id, created_at
+		INSERT INTO followers (follower_id, followee_id, target_type, source, context_id, created_at)
+		VALUES (@follower_id, @followee_id, 'user', NULLIF(@source, ''), NULLIF(@context_id, ''), NOW())
+		ON CONFLICT (follower_id, target_type, followee_id) DO UPDATE SET follower_id = EXCLUDED.follower_id
+		RETURNING id, follower_id, followee_id, created_at, (xmax = 0) AS created
 	`
 
 	var followerData model.Follower
-	err = r.db.QueryRow(ctx, query, args).Scan(&followerData.ID, &followerData.FollowerID, &followerData.FolloweeID, &followerData.CreatedAt)
+	err = r.db.QueryRow(ctx, query, args).Scan(&followerData.ID, &followerData.FollowerID, &followerData.FolloweeID, &followerData.CreatedAt, &created)
 	if err != nil {
-		r.log.Error("Failed to create follow relation",
+		r.logger(ctx).Error("Failed to create follow relation",
 			slog.Int64("follower_id", followerID),
 			slog.Int64("followee_id", followeeID),
 			slog.String("error", err.Error()))
-		return model.Follower{}, custom_errors.ErrFollowRelationCreateFail
+		return model.Follower{}, false, custom_errors.ErrFollowRelationCreateFail
+	}
+	followerData.TargetType = model.TargetTypeUser
+
+	if !created {
+		r.logger(ctx).Info("Follow relation already exists",
+			slog.Int64("follower_id", followerID),
+			slog.Int64("followee_id", followeeID))
+		return followerData, false, nil
 	}
+	followerData.Source = attribution.Source
+	followerData.ContextID = attribution.ContextID
 
-	r.log.Info("Follow relation created successfully",
+	r.logger(ctx).Info("Follow relation created successfully",
 		slog.Int64("follower_id", followerID),
 		slog.Int64("followee_id", followeeID))
-	return followerData, nil
+	return followerData, true, nil
 }
 
-func (r *Repository) Delete(ctx context.Context, followerID, followeeID int64) (err error) {
+// Delete removes the follow and returns the removed row. The DELETE alone decides whether the
+// follow existed, so of two concurrent unfollows only one gets the row back.
+func (r *Repository) Delete(ctx context.Context, followerID, followeeID int64) (follower model.Follower, err error) {
 	start := time.Now()
 	defer func() {
 		r.metrics.IncrementDatabaseQueries("delete_follow_relation", err == nil)
 		r.metrics.RecordDatabaseQueryDuration("delete_follow_relation", time.Since(start))
 	}()
 
-	r.log.Info("Deleting follow relation", slog.Int64("follower_id", followerID), slog.Int64("followee_id", followeeID))
+	r.logger(ctx).Info("Deleting follow relation", slog.Int64("follower_id", followerID), slog.Int64("followee_id", followeeID))
 
 	args := pgx.NamedArgs{
 		"follower_id": followerID,
@@ -81,30 +105,30 @@ func (r *Repository) Delete(ctx context.Context, followerID, followeeID int64) (
 
 	query := `
 		DELETE FROM followers 
-		WHERE follower_id = @follower_id AND followee_id = @followee_id
+		WHERE follower_id = @follower_id AND followee_id = @followee_id AND target_type = 'user'
+		RETURNING id, follower_id, followee_id, created_at
 	`
 
-	result, err := r.db.Exec(ctx, query, args)
+	err = r.db.QueryRow(ctx, query, args).Scan(&follower.ID, &follower.FollowerID, &follower.FolloweeID, &follower.CreatedAt)
 	if err != nil {
-		r.log.Error("Failed to delete follow relation",
+		if errors.Is(err, pgx.ErrNoRows) {
+			r.logger(ctx).Warn("Follow relation not found",
+				slog.Int64("follower_id", followerID),
+				slog.Int64("followee_id", followeeID))
+			return model.Follower{}, custom_errors.ErrFollowRelationNotFound
+		}
+		r.logger(ctx).Error("Failed to delete follow relation",
 			slog.Int64("follower_id", followerID),
 			slog.Int64("followee_id", followeeID),
 			slog.String("error", err.Error()))
-		return custom_errors.ErrFollowRelationDeleteFail
+		return model.Follower{}, custom_errors.ErrFollowRelationDeleteFail
 	}
+	follower.TargetType = model.TargetTypeUser
 
-	rowsAffected := result.RowsAffected()
-	if rowsAffected == 0 {
-		r.log.Warn("Follow relation not found",
-			slog.Int64("follower_id", followerID),
-			slog.Int64("followee_id", followeeID))
-		return custom_errors.ErrFollowRelationNotFound
-	}
-
-	r.log.Info("Follow relation deleted successfully",
+	r.logger(ctx).Info("Follow relation deleted successfully",
 		slog.Int64("follower_id", followerID),
 		slog.Int64("followee_id", followeeID))
-	return nil
+	return follower, nil
 }
 
 func (r *Repository) GetFollowers(ctx context.Context, followeeID int64, limit, offset int32) (followers []int64, total int64, err error) {
@@ -114,7 +138,7 @@ func (r *Repository) GetFollowers(ctx context.Context, followeeID int64, limit,
 		r.metrics.RecordDatabaseQueryDuration("get_followers", time.Since(start))
 	}()
 
-	r.log.Info("Getting followers", slog.Int64("followee_id", followeeID))
+	r.logger(ctx).Info("Getting followers", slog.Int64("followee_id", followeeID))
 
 	args := pgx.NamedArgs{
 		"followee_id": followeeID,
@@ -127,14 +151,14 @@ func (r *Repository) GetFollowers(ctx context.Context, followeeID int64, limit,
 			follower_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE followee_id = @followee_id
+		WHERE followee_id = @followee_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
 
 	rows, err := r.db.Query(ctx, query, args)
 	if err != nil {
-		r.log.Error("Failed to query followers",
+		r.logger(ctx).Error("Failed to query followers",
 			slog.Int64("followee_id", followeeID),
 			slog.String("error", err.Error()))
 		return nil, 0, custom_errors.ErrDatabaseQuery
@@ -147,7 +171,7 @@ func (r *Repository) GetFollowers(ctx context.Context, followeeID int64, limit,
 	for rows.Next() {
 		var followerID int64
 		if err := rows.Scan(&followerID, &totalCount); err != nil {
-			r.log.Error("Failed to scan follower row",
+			r.logger(ctx).Error("Failed to scan follower row",
 				slog.Int64("followee_id", followeeID),
 				slog.String("error", err.Error()))
 			return nil, 0, custom_errors.ErrDatabaseQuery
@@ -156,7 +180,7 @@ func (r *Repository) GetFollowers(ctx context.Context, followeeID int64, limit,
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Error("Error during followers iteration",
+		r.logger(ctx).Error("Error during followers iteration",
 			slog.Int64("followee_id", followeeID),
 			slog.String("error", err.Error()))
 		return nil, 0, custom_errors.ErrDatabaseQuery
@@ -167,17 +191,17 @@ func (r *Repository) GetFollowers(ctx context.Context, followeeID int64, limit,
 			"followee_id": followeeID,
 		}
 
-		countQuery := `SELECT COUNT(*) FROM followers WHERE followee_id = @followee_id`
+		countQuery := `SELECT COUNT(*) FROM followers WHERE followee_id = @followee_id AND target_type = 'user'`
 		err := r.db.QueryRow(ctx, countQuery, countArgs).Scan(&totalCount)
 		if err != nil {
-			r.log.Error("Failed to count followers for empty result",
+			r.logger(ctx).Error("Failed to count followers for empty result",
 				slog.Int64("followee_id", followeeID),
 				slog.String("error", err.Error()))
 			return nil, 0, custom_errors.ErrDatabaseQuery
 		}
 	}
 
-	r.log.Info("Successfully retrieved followers",
+	r.logger(ctx).Info("Successfully retrieved followers",
 		slog.Int64("followee_id", followeeID),
 		slog.Int("count", len(followersList)),
 		slog.Int64("total", totalCount))
@@ -192,7 +216,7 @@ func (r *Repository) GetFollowees(ctx context.Context, followerID int64, limit,
 		r.metrics.RecordDatabaseQueryDuration("get_followees", time.Since(start))
 	}()
 
-	r.log.Info("Getting followees", slog.Int64("follower_id", followerID))
+	r.logger(ctx).Info("Getting followees", slog.Int64("follower_id", followerID))
 
 	args := pgx.NamedArgs{
 		"follower_id": followerID,
@@ -205,14 +229,14 @@ func (r *Repository) GetFollowees(ctx context.Context, followerID int64, limit,
 			followee_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE follower_id = @follower_id
+		WHERE follower_id = @follower_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
 
 	rows, err := r.db.Query(ctx, query, args)
 	if err != nil {
-		r.log.Error("Failed to query followees",
+		r.logger(ctx).Error("Failed to query followees",
 			slog.Int64("follower_id", followerID),
 			slog.String("error", err.Error()))
 		return nil, 0, custom_errors.ErrDatabaseQuery
@@ -225,7 +249,7 @@ func (r *Repository) GetFollowees(ctx context.Context, followerID int64, limit,
 	for rows.Next() {
 		var followeeID int64
 		if err := rows.Scan(&followeeID, &totalCount); err != nil {
-			r.log.Error("Failed to scan followee row",
+			r.logger(ctx).Error("Failed to scan followee row",
 				slog.Int64("follower_id", followerID),
 				slog.String("error", err.Error()))
 			return nil, 0, custom_errors.ErrDatabaseQuery
@@ -234,7 +258,7 @@ func (r *Repository) GetFollowees(ctx context.Context, followerID int64, limit,
 	}
 
 	if err := rows.Err(); err != nil {
-		r.log.Error("Error during followees iteration",
+		r.logger(ctx).Error("Error during followees iteration",
 			slog.Int64("follower_id", followerID),
 			slog.String("error", err.Error()))
 		return nil, 0, custom_errors.ErrDatabaseQuery
@@ -245,17 +269,17 @@ func (r *Repository) GetFollowees(ctx context.Context, followerID int64, limit,
 			"follower_id": followerID,
 		}
 
-		countQuery := `SELECT COUNT(*) FROM followers WHERE follower_id = @follower_id`
+		countQuery := `SELECT COUNT(*) FROM followers WHERE follower_id = @follower_id AND target_type = 'user'`
 		err := r.db.QueryRow(ctx, countQuery, countArgs).Scan(&totalCount)
 		if err != nil {
-			r.log.Error("Failed to count followees for empty result",
+			r.logger(ctx).Error("Failed to count followees for empty result",
 				slog.Int64("follower_id", followerID),
 				slog.String("error", err.Error()))
 			return nil, 0, custom_errors.ErrDatabaseQuery
 		}
 	}
 
-	r.log.Info("Successfully retrieved followees",
+	r.logger(ctx).Info("Successfully retrieved followees",
 		slog.Int64("follower_id", followerID),
 		slog.Int("count", len(followeesList)),
 		slog.Int64("total", totalCount))
@@ -270,7 +294,7 @@ func (r *Repository) Exists(ctx context.Context, followerID, followeeID int64) (
 		r.metrics.RecordDatabaseQueryDuration("check_follow_relation_exists", time.Since(start))
 	}()
 
-	r.log.Info("Checking if follow relation exists",
+	r.logger(ctx).Info("Checking if follow relation exists",
 		slog.Int64("follower_id", followerID),
 		slog.Int64("followee_id", followeeID))
 
@@ -283,23 +307,94 @@ func (r *Repository) Exists(ctx context.Context, followerID, followeeID int64) (
 		SELECT EXISTS(
 			SELECT 1 
 			FROM followers 
-			WHERE follower_id = @follower_id AND followee_id = @followee_id
+			WHERE follower_id = @follower_id AND followee_id = @followee_id AND target_type = 'user'
 		)
 	`
 
 	var existsResult bool
 	err = r.db.QueryRow(ctx, query, args).Scan(&existsResult)
 	if err != nil {
-		r.log.Error("Failed to check follow relation existence",
+		r.logger(ctx).Error("Failed to check follow relation existence",
 			slog.Int64("follower_id", followerID),
 			slog.Int64("followee_id", followeeID),
 			slog.String("error", err.Error()))
 		return false, custom_errors.ErrDatabaseQuery
 	}
 
-	r.log.Info("Follow relation check completed",
+	r.logger(ctx).Info("Follow relation check completed",
 		slog.Int64("follower_id", followerID),
 		slog.Int64("followee_id", followeeID),
 		slog.Bool("exists", existsResult))
 	return existsResult, nil
 }
+
+func (r *Repository) GetRelationships(ctx context.Context, viewerID int64, targetIDs []int64) (relationships map[int64]model.Relationship, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_relationships", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_relationships", time.Since(start))
+	}()
+
+	r.logger(ctx).Debug("Getting relationships",
+		slog.Int64("viewer_id", viewerID),
+		slog.Int("targets", len(targetIDs)))
+
+	args := pgx.NamedArgs{
+		"viewer_id":  viewerID,
+		"target_ids": targetIDs,
+	}
+
+	query := `
+		SELECT follower_id, followee_id
+		FROM followers
+		WHERE target_type = 'user'
+			AND ((follower_id = @viewer_id AND followee_id = ANY(@target_ids))
+				OR (followee_id = @viewer_id AND follower_id = ANY(@target_ids)))
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query relationships",
+			slog.Int64("viewer_id", viewerID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	relationships = make(map[int64]model.Relationship)
+	for rows.Next() {
+		var followerID, followeeID int64
+		if err := rows.Scan(&followerID, &followeeID); err != nil {
+			r.logger(ctx).Error("Failed to scan relationship row",
+				slog.Int64("viewer_id", viewerID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+
+		if followerID == viewerID {
+			relationship := relationships[followeeID]
+			relationship.TargetID = followeeID
+			relationship.Following = true
+			relationships[followeeID] = relationship
+		}
+		if followeeID == viewerID {
+			relationship := relationships[followerID]
+			relationship.TargetID = followerID
+			relationship.FollowedBy = true
+			relationships[followerID] = relationship
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during relationships iteration",
+			slog.Int64("viewer_id", viewerID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	r.logger(ctx).Debug("Successfully retrieved relationships",
+		slog.Int64("viewer_id", viewerID),
+		slog.Int("related", len(relationships)))
+
+	return relationships, nil
+}
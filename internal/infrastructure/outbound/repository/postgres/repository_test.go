@@ -3,6 +3,8 @@ package repository_postgres_test
 import (
 	"context"
 	"errors"
+	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
@@ -62,6 +64,7 @@ func TestRepository_Create(t *testing.T) {
 		wantErr        bool
 		expectedErr    error
 		expectedResult model.Follower
+		wantCreated    bool
 	}{
 		{
 			name:       "successful follow",
@@ -73,17 +76,20 @@ func TestRepository_Create(t *testing.T) {
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
-					mock.AnythingOfType("*time.Time")).
+					mock.AnythingOfType("*time.Time"),
+					mock.AnythingOfType("*bool")).
 					Run(func(args mock.Arguments) {
 						idArg := args.Get(0).(*int64)
 						followerIDArg := args.Get(1).(*int64)
 						followeeIDArg := args.Get(2).(*int64)
 						createdAtArg := args.Get(3).(*time.Time)
+						createdArg := args.Get(4).(*bool)
 
 						*idArg = 1
 						*followerIDArg = 1
 						*followeeIDArg = 2
 						*createdAtArg = time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+						*createdArg = true
 					}).
 					Return(nil)
 
@@ -99,6 +105,45 @@ func TestRepository_Create(t *testing.T) {
 				FolloweeID: 2,
 				CreatedAt:  time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC),
 			},
+			wantCreated: true,
+		},
+		{
+			name:       "follow already exists",
+			followerID: 1,
+			followeeID: 2,
+			mockSetup: func(db *mocks.PgDB) {
+				mockRow := new(mocks.Row)
+				mockRow.On("Scan",
+					mock.AnythingOfType("*int64"),
+					mock.AnythingOfType("*int64"),
+					mock.AnythingOfType("*int64"),
+					mock.AnythingOfType("*time.Time"),
+					mock.AnythingOfType("*bool")).
+					Run(func(args mock.Arguments) {
+						*args.Get(0).(*int64) = 7
+						*args.Get(1).(*int64) = 1
+						*args.Get(2).(*int64) = 2
+						*args.Get(3).(*time.Time) = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+						*args.Get(4).(*bool) = false
+					}).
+					Return(nil)
+
+				db.On("QueryRow",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "ON CONFLICT (follower_id, target_type, followee_id) DO UPDATE") &&
+							strings.Contains(query, "(xmax = 0) AS created")
+					}),
+					mock.Anything).Return(mockRow)
+			},
+			wantErr: false,
+			expectedResult: model.Follower{
+				ID:         7,
+				FollowerID: 1,
+				FolloweeID: 2,
+				CreatedAt:  time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+			},
+			wantCreated: false,
 		},
 		{
 			name:           "self follow error",
@@ -119,7 +164,8 @@ func TestRepository_Create(t *testing.T) {
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
 					mock.AnythingOfType("*int64"),
-					mock.AnythingOfType("*time.Time")).
+					mock.AnythingOfType("*time.Time"),
+					mock.AnythingOfType("*bool")).
 					Return(errors.New("db error"))
 
 				db.On("QueryRow",
@@ -144,7 +190,7 @@ func TestRepository_Create(t *testing.T) {
 			}
 
 			repo := repository_postgres.NewFollowRepository(mockDB, log, metrics)
-			result, err := repo.Create(context.Background(), tt.followerID, tt.followeeID)
+			result, created, err := repo.Create(context.Background(), tt.followerID, tt.followeeID, model.FollowAttribution{})
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -152,12 +198,14 @@ func TestRepository_Create(t *testing.T) {
 					assert.ErrorIs(t, err, tt.expectedErr)
 				}
 				assert.Empty(t, result)
+				assert.False(t, created)
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expectedResult.ID, result.ID)
 				assert.Equal(t, tt.expectedResult.FollowerID, result.FollowerID)
 				assert.Equal(t, tt.expectedResult.FolloweeID, result.FolloweeID)
 				assert.Equal(t, tt.expectedResult.CreatedAt, result.CreatedAt)
+				assert.Equal(t, tt.wantCreated, created)
 			}
 		})
 	}
@@ -165,34 +213,61 @@ func TestRepository_Create(t *testing.T) {
 
 func TestRepository_Delete(t *testing.T) {
 	tests := []struct {
-		name        string
-		followerID  int64
-		followeeID  int64
-		mockSetup   func(*mocks.PgDB)
-		wantErr     bool
-		expectedErr error
+		name           string
+		followerID     int64
+		followeeID     int64
+		mockSetup      func(*mocks.PgDB)
+		wantErr        bool
+		expectedErr    error
+		expectedResult model.Follower
 	}{
 		{
 			name:       "successful unfollow",
 			followerID: 1,
 			followeeID: 2,
 			mockSetup: func(db *mocks.PgDB) {
-				db.On("Exec",
+				mockRow := new(mocks.Row)
+				mockRow.On("Scan",
+					mock.AnythingOfType("*int64"),
+					mock.AnythingOfType("*int64"),
+					mock.AnythingOfType("*int64"),
+					mock.AnythingOfType("*time.Time")).
+					Run(func(args mock.Arguments) {
+						*args.Get(0).(*int64) = 7
+						*args.Get(1).(*int64) = 1
+						*args.Get(2).(*int64) = 2
+						*args.Get(3).(*time.Time) = time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC)
+					}).
+					Return(nil)
+
+				db.On("QueryRow",
 					mock.Anything,
-					mock.AnythingOfType("string"),
-					mock.Anything).Return(createSuccessCommandTag(), nil)
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "RETURNING id, follower_id, followee_id, created_at")
+					}),
+					mock.Anything).Return(mockRow)
 			},
 			wantErr: false,
+			expectedResult: model.Follower{
+				ID:         7,
+				FollowerID: 1,
+				FolloweeID: 2,
+				TargetType: model.TargetTypeUser,
+				CreatedAt:  time.Date(2025, 6, 16, 12, 0, 0, 0, time.UTC),
+			},
 		},
 		{
 			name:       "relation not found",
 			followerID: 1,
 			followeeID: 2,
 			mockSetup: func(db *mocks.PgDB) {
-				db.On("Exec",
+				mockRow := new(mocks.Row)
+				mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pgx.ErrNoRows)
+
+				db.On("QueryRow",
 					mock.Anything,
 					mock.AnythingOfType("string"),
-					mock.Anything).Return(createEmptyCommandTag(), nil)
+					mock.Anything).Return(mockRow)
 			},
 			wantErr:     true,
 			expectedErr: custom_errors.ErrFollowRelationNotFound,
@@ -202,10 +277,13 @@ func TestRepository_Delete(t *testing.T) {
 			followerID: 1,
 			followeeID: 2,
 			mockSetup: func(db *mocks.PgDB) {
-				db.On("Exec",
+				mockRow := new(mocks.Row)
+				mockRow.On("Scan", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+				db.On("QueryRow",
 					mock.Anything,
 					mock.AnythingOfType("string"),
-					mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+					mock.Anything).Return(mockRow)
 			},
 			wantErr:     true,
 			expectedErr: custom_errors.ErrFollowRelationDeleteFail,
@@ -223,7 +301,7 @@ func TestRepository_Delete(t *testing.T) {
 			}
 
 			repo := repository_postgres.NewFollowRepository(mockDB, log, metrics)
-			err := repo.Delete(context.Background(), tt.followerID, tt.followeeID)
+			result, err := repo.Delete(context.Background(), tt.followerID, tt.followeeID)
 			if tt.wantErr {
 				assert.Error(t, err)
 				if tt.expectedErr != nil {
@@ -231,6 +309,7 @@ func TestRepository_Delete(t *testing.T) {
 				}
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
 			}
 		})
 	}
@@ -265,7 +344,7 @@ func TestRepository_GetFollowers(t *testing.T) {
 			follower_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE followee_id = @followee_id
+		WHERE followee_id = @followee_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
@@ -297,7 +376,7 @@ func TestRepository_GetFollowers(t *testing.T) {
 			follower_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE followee_id = @followee_id
+		WHERE followee_id = @followee_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
@@ -329,7 +408,7 @@ func TestRepository_GetFollowers(t *testing.T) {
 			follower_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE followee_id = @followee_id
+		WHERE followee_id = @followee_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
@@ -346,7 +425,7 @@ func TestRepository_GetFollowers(t *testing.T) {
 				db.On("QueryRow",
 					mock.Anything,
 					mock.MatchedBy(func(query string) bool {
-						return query == `SELECT COUNT(*) FROM followers WHERE followee_id = @followee_id`
+						return query == `SELECT COUNT(*) FROM followers WHERE followee_id = @followee_id AND target_type = 'user'`
 					}),
 					mock.MatchedBy(func(args pgx.NamedArgs) bool {
 						return args["followee_id"] == int64(1)
@@ -371,7 +450,7 @@ func TestRepository_GetFollowers(t *testing.T) {
 			follower_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE followee_id = @followee_id
+		WHERE followee_id = @followee_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
@@ -402,7 +481,7 @@ func TestRepository_GetFollowers(t *testing.T) {
 			follower_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE followee_id = @followee_id
+		WHERE followee_id = @followee_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
@@ -471,7 +550,7 @@ func TestRepository_GetFollowees(t *testing.T) {
 			followee_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE follower_id = @follower_id
+		WHERE follower_id = @follower_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
@@ -503,7 +582,7 @@ func TestRepository_GetFollowees(t *testing.T) {
 			followee_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE follower_id = @follower_id
+		WHERE follower_id = @follower_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
@@ -535,7 +614,7 @@ func TestRepository_GetFollowees(t *testing.T) {
 			followee_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE follower_id = @follower_id
+		WHERE follower_id = @follower_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
@@ -552,7 +631,7 @@ func TestRepository_GetFollowees(t *testing.T) {
 				db.On("QueryRow",
 					mock.Anything,
 					mock.MatchedBy(func(query string) bool {
-						return query == `SELECT COUNT(*) FROM followers WHERE follower_id = @follower_id`
+						return query == `SELECT COUNT(*) FROM followers WHERE follower_id = @follower_id AND target_type = 'user'`
 					}),
 					mock.MatchedBy(func(args pgx.NamedArgs) bool {
 						return args["follower_id"] == int64(1)
@@ -577,7 +656,7 @@ func TestRepository_GetFollowees(t *testing.T) {
 			followee_id,
 			COUNT(*) OVER() as total_count
 		FROM followers 
-		WHERE follower_id = @follower_id
+		WHERE follower_id = @follower_id AND target_type = 'user'
 		ORDER BY created_at DESC
 		LIMIT @limit OFFSET @offset
 	`
@@ -696,3 +775,187 @@ func TestRepository_Exists(t *testing.T) {
 		})
 	}
 }
+
+func setupMockRelationshipRows(t *testing.T, pairs [][2]int64, scanErr, iterErr error) *mocks.Rows {
+	mockRows := mocks.NewRows(t)
+	for range pairs {
+		mockRows.On("Next").Return(true).Once()
+	}
+	if scanErr == nil {
+		mockRows.On("Next").Return(false).Once()
+		mockRows.On("Err").Return(iterErr)
+	}
+	for _, pair := range pairs {
+		mockRows.On("Scan", mock.AnythingOfType("*int64"), mock.AnythingOfType("*int64")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*int64) = pair[0]
+				*args.Get(1).(*int64) = pair[1]
+			}).
+			Return(scanErr).
+			Once()
+		if scanErr != nil {
+			break
+		}
+	}
+	mockRows.On("Close").Return()
+	return mockRows
+}
+
+func TestRepository_GetRelationships(t *testing.T) {
+	expectedQuery := `
+		SELECT follower_id, followee_id
+		FROM followers
+		WHERE target_type = 'user'
+			AND ((follower_id = @viewer_id AND followee_id = ANY(@target_ids))
+				OR (followee_id = @viewer_id AND follower_id = ANY(@target_ids)))
+	`
+
+	tests := []struct {
+		name        string
+		viewerID    int64
+		targetIDs   []int64
+		mockSetup   func(*mocks.PgDB)
+		want        map[int64]model.Relationship
+		expectedErr error
+	}{
+		{
+			name:      "both directions are merged per target",
+			viewerID:  1,
+			targetIDs: []int64{2, 3, 4, 5},
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRelationshipRows(t, [][2]int64{{1, 2}, {2, 1}, {3, 1}, {1, 4}}, nil, nil)
+				db.On("Query",
+					mock.Anything,
+					expectedQuery,
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						ids, ok := args["target_ids"].([]int64)
+						return args["viewer_id"] == int64(1) && ok && len(ids) == 4
+					})).Return(rows, nil)
+			},
+			want: map[int64]model.Relationship{
+				2: {TargetID: 2, Following: true, FollowedBy: true},
+				3: {TargetID: 3, FollowedBy: true},
+				4: {TargetID: 4, Following: true},
+			},
+		},
+		{
+			name:      "no relationships",
+			viewerID:  1,
+			targetIDs: []int64{2},
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRelationshipRows(t, nil, nil, nil)
+				db.On("Query", mock.Anything, expectedQuery, mock.Anything).Return(rows, nil)
+			},
+			want: map[int64]model.Relationship{},
+		},
+		{
+			name:      "query error",
+			viewerID:  1,
+			targetIDs: []int64{2},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, expectedQuery, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name:      "scan error",
+			viewerID:  1,
+			targetIDs: []int64{2},
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRelationshipRows(t, [][2]int64{{1, 2}}, errors.New("scan error"), nil)
+				db.On("Query", mock.Anything, expectedQuery, mock.Anything).Return(rows, nil)
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name:      "iteration error",
+			viewerID:  1,
+			targetIDs: []int64{2},
+			mockSetup: func(db *mocks.PgDB) {
+				rows := setupMockRelationshipRows(t, nil, nil, errors.New("connection reset"))
+				db.On("Query", mock.Anything, expectedQuery, mock.Anything).Return(rows, nil)
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			log := logger.New("dev")
+			metrics := prometheus.NewPrometheusMetricsProvider()
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockDB)
+			}
+
+			repo := repository_postgres.NewFollowRepository(mockDB, log, metrics)
+			got, err := repo.GetRelationships(context.Background(), tt.viewerID, tt.targetIDs)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// pairRows is a minimal pgx.Rows over follower/followee pairs, used where mock bookkeeping would dominate a benchmark
+type pairRows struct {
+	pgx.Rows
+	pairs [][2]int64
+	pos   int
+}
+
+func (r *pairRows) Next() bool {
+	r.pos++
+	return r.pos <= len(r.pairs)
+}
+
+func (r *pairRows) Scan(dest ...any) error {
+	*dest[0].(*int64) = r.pairs[r.pos-1][0]
+	*dest[1].(*int64) = r.pairs[r.pos-1][1]
+	return nil
+}
+
+func (r *pairRows) Err() error { return nil }
+func (r *pairRows) Close()     {}
+
+type pairDB struct {
+	repository_postgres.PgDB
+	pairs [][2]int64
+}
+
+func (d *pairDB) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	return &pairRows{pairs: d.pairs}, nil
+}
+
+func BenchmarkRepository_GetRelationships(b *testing.B) {
+	const viewerID = int64(1)
+	targetIDs := make([]int64, model.MaxRelationshipTargets)
+	var pairs [][2]int64
+	for i := range targetIDs {
+		targetIDs[i] = int64(i + 2)
+		switch i % 3 {
+		case 0:
+			pairs = append(pairs, [2]int64{viewerID, targetIDs[i]}, [2]int64{targetIDs[i], viewerID})
+		case 1:
+			pairs = append(pairs, [2]int64{viewerID, targetIDs[i]})
+		}
+	}
+
+	log := &logger.Logger{Logger: slog.New(slog.DiscardHandler)}
+	repo := repository_postgres.NewFollowRepository(&pairDB{pairs: pairs}, log, prometheus.NewPrometheusMetricsProvider())
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		relationships, err := repo.GetRelationships(ctx, viewerID, targetIDs)
+		if err != nil || len(relationships) == 0 {
+			b.Fatalf("unexpected result: %d relationships, err %v", len(relationships), err)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package repository_postgres
+
+import (
+	"context"
+	"log/slog"
+	model "pinstack-relation-service/internal/domain/models"
+	ports "pinstack-relation-service/internal/domain/ports/output"
+	"time"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type SuggestionRepository struct {
+	log     ports.Logger
+	db      PgDB
+	metrics ports.MetricsProvider
+}
+
+func NewSuggestionRepository(db PgDB, log ports.Logger, metrics ports.MetricsProvider) *SuggestionRepository {
+	return &SuggestionRepository{db: db, log: log, metrics: metrics}
+}
+
+func (r *SuggestionRepository) logger(ctx context.Context) ports.Logger {
+	return ports.LoggerFromContext(ctx, r.log)
+}
+
+// Rank walks two hops out from userID. Every followee among the user's most recent
+// SuggestionSeedLimit followees that follows a candidate adds a weight to the
+// candidate's score, halving every SuggestionRecencyHalfLife since that follow
+// happened, so the score grows with both the mutual-connection count and recency.
+// The user and the users they already follow are never candidates. The service
+// has no block relation yet; once it does, blocked pairs must be excluded here too.
+func (r *SuggestionRepository) Rank(ctx context.Context, userID int64, limit int32) (suggestions []model.Suggestion, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("rank_suggestions", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("rank_suggestions", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"user_id":           userID,
+		"seed_limit":        model.SuggestionSeedLimit,
+		"half_life_seconds": model.SuggestionRecencyHalfLife.Seconds(),
+		"limit":             limit,
+	}
+
+	query := `
+		WITH seeds AS (
+			SELECT followee_id
+			FROM followers
+			WHERE follower_id = @user_id AND target_type = 'user'
+			ORDER BY created_at DESC
+			LIMIT @seed_limit
+		)
+		SELECT
+			candidates.followee_id,
+			COUNT(*) AS mutual_count,
+			SUM(POWER(0.5, EXTRACT(EPOCH FROM NOW() - candidates.created_at) / @half_life_seconds))::float8 AS score
+		FROM seeds
+		JOIN followers candidates ON candidates.follower_id = seeds.followee_id
+		WHERE candidates.target_type = 'user'
+			AND candidates.followee_id <> @user_id
+			AND NOT EXISTS (
+				SELECT 1 FROM followers existing
+				WHERE existing.follower_id = @user_id AND existing.target_type = 'user'
+					AND existing.followee_id = candidates.followee_id
+			)
+		GROUP BY candidates.followee_id
+		ORDER BY score DESC, mutual_count DESC, candidates.followee_id
+		LIMIT @limit
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to rank suggestions",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	return r.scanSuggestions(ctx, userID, rows, limit)
+}
+
+func (r *SuggestionRepository) GetStored(ctx context.Context, userID int64, limit int32) (suggestions []model.Suggestion, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("get_stored_suggestions", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("get_stored_suggestions", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"limit":   limit,
+	}
+
+	// Rows can be up to a batch run old, so follows made since then are filtered out here
+	query := `
+		SELECT s.candidate_id, s.mutual_count, s.score
+		FROM follow_suggestions s
+		WHERE s.user_id = @user_id
+			AND NOT EXISTS (
+				SELECT 1 FROM followers existing
+				WHERE existing.follower_id = @user_id AND existing.target_type = 'user'
+					AND existing.followee_id = s.candidate_id
+			)
+		ORDER BY s.score DESC, s.mutual_count DESC, s.candidate_id
+		LIMIT @limit
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to query stored suggestions",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	return r.scanSuggestions(ctx, userID, rows, limit)
+}
+
+func (r *SuggestionRepository) Store(ctx context.Context, userID int64, suggestions []model.Suggestion) (err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("store_suggestions", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("store_suggestions", time.Since(start))
+	}()
+
+	candidateIDs := make([]int64, 0, len(suggestions))
+	mutualCounts := make([]int64, 0, len(suggestions))
+	scores := make([]float64, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		candidateIDs = append(candidateIDs, suggestion.UserID)
+		mutualCounts = append(mutualCounts, suggestion.MutualCount)
+		scores = append(scores, suggestion.Score)
+	}
+
+	args := pgx.NamedArgs{
+		"user_id":       userID,
+		"candidate_ids": candidateIDs,
+		"mutual_counts": mutualCounts,
+		"scores":        scores,
+	}
+
+	// Upsert the new ranking and drop the candidates that fell out of it in one statement
+	query := `
+		WITH upserted AS (
+			INSERT INTO follow_suggestions (user_id, candidate_id, mutual_count, score, computed_at)
+			SELECT @user_id, ranked.candidate_id, ranked.mutual_count, ranked.score, NOW()
+			FROM unnest(@candidate_ids::bigint[], @mutual_counts::bigint[], @scores::float8[])
+				AS ranked(candidate_id, mutual_count, score)
+			ON CONFLICT (user_id, candidate_id) DO UPDATE
+				SET mutual_count = EXCLUDED.mutual_count,
+					score = EXCLUDED.score,
+					computed_at = EXCLUDED.computed_at
+			RETURNING candidate_id
+		)
+		DELETE FROM follow_suggestions
+		WHERE user_id = @user_id
+			AND candidate_id NOT IN (SELECT candidate_id FROM upserted)
+	`
+
+	_, err = r.db.Exec(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to store suggestions",
+			slog.Int64("user_id", userID),
+			slog.Int("count", len(suggestions)),
+			slog.String("error", err.Error()))
+		return custom_errors.ErrDatabaseQuery
+	}
+
+	return nil
+}
+
+func (r *SuggestionRepository) ListActiveUsers(ctx context.Context, since time.Time, afterID int64, limit int32) (userIDs []int64, err error) {
+	start := time.Now()
+	defer func() {
+		r.metrics.IncrementDatabaseQueries("list_active_users", err == nil)
+		r.metrics.RecordDatabaseQueryDuration("list_active_users", time.Since(start))
+	}()
+
+	args := pgx.NamedArgs{
+		"since":    since,
+		"after_id": afterID,
+		"limit":    limit,
+	}
+
+	query := `
+		SELECT DISTINCT follower_id
+		FROM followers
+		WHERE created_at >= @since AND follower_id > @after_id
+		ORDER BY follower_id
+		LIMIT @limit
+	`
+
+	rows, err := r.db.Query(ctx, query, args)
+	if err != nil {
+		r.logger(ctx).Error("Failed to list active users",
+			slog.Time("since", since),
+			slog.Int64("after_id", afterID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+	defer rows.Close()
+
+	userIDs = make([]int64, 0, limit)
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			r.logger(ctx).Error("Failed to scan active user row", slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during active users iteration", slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return userIDs, nil
+}
+
+func (r *SuggestionRepository) scanSuggestions(ctx context.Context, userID int64, rows pgx.Rows, limit int32) ([]model.Suggestion, error) {
+	suggestions := make([]model.Suggestion, 0, limit)
+	for rows.Next() {
+		var suggestion model.Suggestion
+		if err := rows.Scan(&suggestion.UserID, &suggestion.MutualCount, &suggestion.Score); err != nil {
+			r.logger(ctx).Error("Failed to scan suggestion row",
+				slog.Int64("user_id", userID),
+				slog.String("error", err.Error()))
+			return nil, custom_errors.ErrDatabaseQuery
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger(ctx).Error("Error during suggestions iteration",
+			slog.Int64("user_id", userID),
+			slog.String("error", err.Error()))
+		return nil, custom_errors.ErrDatabaseQuery
+	}
+
+	return suggestions, nil
+}
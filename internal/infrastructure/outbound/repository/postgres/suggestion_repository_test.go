@@ -0,0 +1,217 @@
+package repository_postgres_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	model "pinstack-relation-service/internal/domain/models"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/outbound/metrics/prometheus"
+	repository_postgres "pinstack-relation-service/internal/infrastructure/outbound/repository/postgres"
+	"pinstack-relation-service/mocks"
+
+	"github.com/soloda1/pinstack-proto-definitions/custom_errors"
+)
+
+func setupMockSuggestionRows(t *testing.T, suggestions []model.Suggestion) *mocks.Rows {
+	mockRows := mocks.NewRows(t)
+	for range suggestions {
+		mockRows.On("Next").Return(true).Once()
+	}
+	mockRows.On("Next").Return(false).Once()
+	for _, suggestion := range suggestions {
+		mockRows.On("Scan", mock.AnythingOfType("*int64"), mock.AnythingOfType("*int64"), mock.AnythingOfType("*float64")).
+			Run(func(args mock.Arguments) {
+				*args.Get(0).(*int64) = suggestion.UserID
+				*args.Get(1).(*int64) = suggestion.MutualCount
+				*args.Get(2).(*float64) = suggestion.Score
+			}).
+			Return(nil).
+			Once()
+	}
+	mockRows.On("Err").Return(nil).Maybe()
+	mockRows.On("Close").Return()
+	return mockRows
+}
+
+func TestSuggestionRepository_Rank(t *testing.T) {
+	ranked := []model.Suggestion{{UserID: 3, MutualCount: 4, Score: 3.5}, {UserID: 4, MutualCount: 1, Score: 0.9}}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*mocks.PgDB)
+		want        []model.Suggestion
+		expectedErr error
+	}{
+		{
+			name: "second degree candidates",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "JOIN followers candidates ON candidates.follower_id = seeds.followee_id") &&
+							strings.Contains(query, "candidates.followee_id <> @user_id") &&
+							strings.Contains(query, "existing.follower_id = @user_id")
+					}),
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						return args["user_id"] == int64(1) &&
+							args["limit"] == int32(50) &&
+							args["seed_limit"] == model.SuggestionSeedLimit &&
+							args["half_life_seconds"] == model.SuggestionRecencyHalfLife.Seconds()
+					})).Return(setupMockSuggestionRows(t, ranked), nil)
+			},
+			want: ranked,
+		},
+		{
+			name: "query error",
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+		{
+			name: "scan error",
+			mockSetup: func(db *mocks.PgDB) {
+				rows := mocks.NewRows(t)
+				rows.On("Next").Return(true).Once()
+				rows.On("Scan", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("scan error"))
+				rows.On("Close").Return()
+				db.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(rows, nil)
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewSuggestionRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			got, err := repo.Rank(context.Background(), 1, 50)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSuggestionRepository_GetStored(t *testing.T) {
+	stored := []model.Suggestion{{UserID: 7, MutualCount: 2, Score: 1.2}}
+
+	mockDB := mocks.NewPgDB(t)
+	mockDB.On("Query",
+		mock.Anything,
+		mock.MatchedBy(func(query string) bool {
+			return strings.Contains(query, "FROM follow_suggestions s") &&
+				strings.Contains(query, "existing.followee_id = s.candidate_id")
+		}),
+		pgx.NamedArgs{"user_id": int64(1), "limit": int32(50)}).Return(setupMockSuggestionRows(t, stored), nil)
+
+	repo := repository_postgres.NewSuggestionRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+	got, err := repo.GetStored(context.Background(), 1, 50)
+
+	require.NoError(t, err)
+	assert.Equal(t, stored, got)
+}
+
+func TestSuggestionRepository_Store(t *testing.T) {
+	tests := []struct {
+		name        string
+		suggestions []model.Suggestion
+		mockSetup   func(*mocks.PgDB)
+		expectedErr error
+	}{
+		{
+			name:        "ranking is passed as parallel arrays",
+			suggestions: []model.Suggestion{{UserID: 3, MutualCount: 4, Score: 3.5}, {UserID: 4, MutualCount: 1, Score: 0.9}},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec",
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "ON CONFLICT (user_id, candidate_id) DO UPDATE") &&
+							strings.Contains(query, "candidate_id NOT IN (SELECT candidate_id FROM upserted)")
+					}),
+					pgx.NamedArgs{
+						"user_id":       int64(1),
+						"candidate_ids": []int64{3, 4},
+						"mutual_counts": []int64{4, 1},
+						"scores":        []float64{3.5, 0.9},
+					}).Return(pgconn.NewCommandTag("DELETE 0"), nil)
+			},
+		},
+		{
+			name:        "empty ranking clears stored candidates",
+			suggestions: nil,
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.Anything,
+					mock.MatchedBy(func(args pgx.NamedArgs) bool {
+						ids, ok := args["candidate_ids"].([]int64)
+						return ok && ids != nil && len(ids) == 0
+					})).Return(pgconn.NewCommandTag("DELETE 5"), nil)
+			},
+		},
+		{
+			name:        "exec error",
+			suggestions: []model.Suggestion{{UserID: 3}},
+			mockSetup: func(db *mocks.PgDB) {
+				db.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(pgconn.CommandTag{}, errors.New("db error"))
+			},
+			expectedErr: custom_errors.ErrDatabaseQuery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := mocks.NewPgDB(t)
+			tt.mockSetup(mockDB)
+
+			repo := repository_postgres.NewSuggestionRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+			err := repo.Store(context.Background(), 1, tt.suggestions)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSuggestionRepository_ListActiveUsers(t *testing.T) {
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := mocks.NewRows(t)
+	rows.On("Next").Return(true).Twice()
+	rows.On("Next").Return(false).Once()
+	for _, id := range []int64{4, 9} {
+		rows.On("Scan", mock.AnythingOfType("*int64")).
+			Run(func(args mock.Arguments) { *args.Get(0).(*int64) = id }).
+			Return(nil).
+			Once()
+	}
+	rows.On("Err").Return(nil)
+	rows.On("Close").Return()
+
+	mockDB := mocks.NewPgDB(t)
+	mockDB.On("Query", mock.Anything, mock.AnythingOfType("string"),
+		pgx.NamedArgs{"since": since, "after_id": int64(3), "limit": int32(2)}).Return(rows, nil)
+
+	repo := repository_postgres.NewSuggestionRepository(mockDB, logger.New("dev"), prometheus.NewPrometheusMetricsProvider())
+	got, err := repo.ListActiveUsers(context.Background(), since, 3, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{4, 9}, got)
+}
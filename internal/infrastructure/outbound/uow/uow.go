@@ -53,3 +53,23 @@ func (t *PostgresTransaction) FollowRepository() repository_port.FollowRepositor
 func (t *PostgresTransaction) OutboxRepository() outbox_port.OutboxRepository {
 	return outbox_postgres.NewOutboxRepository(t.tx, t.log, t.metrics)
 }
+
+func (t *PostgresTransaction) FollowActionRepository() repository_port.FollowActionRepository {
+	return repository_postgres.NewFollowActionRepository(t.tx, t.log, t.metrics)
+}
+
+func (t *PostgresTransaction) RelationHistoryRepository() repository_port.RelationHistoryRepository {
+	return repository_postgres.NewRelationHistoryRepository(t.tx, t.log, t.metrics)
+}
+
+func (t *PostgresTransaction) IdempotencyRepository() repository_port.IdempotencyRepository {
+	return repository_postgres.NewIdempotencyRepository(t.tx, t.log, t.metrics)
+}
+
+func (t *PostgresTransaction) MuteRepository() repository_port.MuteRepository {
+	return repository_postgres.NewMuteRepository(t.tx, t.log, t.metrics)
+}
+
+func (t *PostgresTransaction) AudienceListRepository() repository_port.AudienceListRepository {
+	return repository_postgres.NewAudienceListRepository(t.tx, t.log, t.metrics)
+}
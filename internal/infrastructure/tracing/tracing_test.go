@@ -0,0 +1,83 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"pinstack-relation-service/internal/infrastructure/config"
+	"pinstack-relation-service/internal/infrastructure/logger"
+	"pinstack-relation-service/internal/infrastructure/tracing"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	_, err := tracing.Setup(context.Background(), config.Tracing{}, logger.New("test"))
+	require.NoError(t, err)
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestPropagation_RoundTrip(t *testing.T) {
+	setupRecorder(t)
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "Follow")
+	defer span.End()
+
+	traceContext := tracing.InjectContext(ctx)
+	require.Contains(t, traceContext, "traceparent")
+
+	restored := trace.SpanContextFromContext(tracing.ExtractContext(context.Background(), traceContext))
+	assert.Equal(t, span.SpanContext().TraceID(), restored.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), restored.SpanID())
+	assert.True(t, restored.IsRemote())
+
+	assert.Equal(t, context.Background(), tracing.ExtractContext(context.Background(), nil))
+}
+
+func TestPgxTracer(t *testing.T) {
+	recorder := setupRecorder(t)
+	tracer := tracing.NewPgxTracer()
+
+	parentCtx, parent := otel.Tracer("test").Start(context.Background(), "Follow")
+
+	ctx := tracer.TraceQueryStart(parentCtx, nil, pgx.TraceQueryStartData{SQL: "\n\t\tinsert INTO followers VALUES (1, 2)"})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("INSERT 0 1")})
+
+	ctx = tracer.TraceQueryStart(parentCtx, nil, pgx.TraceQueryStartData{SQL: "SELECT 1"})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: errors.New("connection reset")})
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	insert := spans[0]
+	assert.Equal(t, "INSERT", insert.Name())
+	assert.Equal(t, trace.SpanKindClient, insert.SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), insert.Parent().SpanID())
+	assert.Equal(t, codes.Unset, insert.Status().Code)
+
+	failed := spans[1]
+	assert.Equal(t, "SELECT", failed.Name())
+	assert.Equal(t, codes.Error, failed.Status().Code)
+}
+
+func TestSetup_RequiresExporter(t *testing.T) {
+	_, err := tracing.Setup(context.Background(), config.Tracing{Enabled: true, SampleRatio: 1}, logger.New("test"))
+	assert.Error(t, err)
+}
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS follow_actions;
\ No newline at end of file
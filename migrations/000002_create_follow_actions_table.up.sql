@@ -0,0 +1,11 @@
+CREATE TABLE follow_actions (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    follower_id BIGINT NOT NULL,
+    followee_id BIGINT NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('follow', 'unfollow')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_follow_actions_follower_created_at ON follow_actions(follower_id, created_at);
+CREATE INDEX idx_follow_actions_pair ON follow_actions(follower_id, followee_id, action, created_at DESC);
+CREATE INDEX idx_follow_actions_created_at ON follow_actions(created_at);
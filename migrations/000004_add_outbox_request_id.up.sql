@@ -0,0 +1 @@
+ALTER TABLE outbox ADD COLUMN request_id TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+CREATE INDEX idx_followers_followee_created_at ON followers(followee_id, created_at DESC, follower_id);
@@ -0,0 +1,11 @@
+CREATE TABLE follow_suggestions (
+    user_id BIGINT NOT NULL,
+    candidate_id BIGINT NOT NULL,
+    mutual_count BIGINT NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, candidate_id)
+);
+
+CREATE INDEX idx_follow_suggestions_user_score ON follow_suggestions(user_id, score DESC);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_followers_followee_created_at;
+CREATE INDEX idx_followers_followee_created_at ON followers(followee_id, created_at DESC, follower_id);
+
+DROP INDEX IF EXISTS idx_followee_id;
+CREATE INDEX idx_followee_id ON followers(followee_id);
+
+DELETE FROM followers WHERE target_type <> 'user';
+
+ALTER TABLE followers DROP CONSTRAINT unique_follower_target;
+ALTER TABLE followers ADD CONSTRAINT unique_follower_followee UNIQUE (follower_id, followee_id);
+
+ALTER TABLE followers DROP COLUMN target_type;
\ No newline at end of file
@@ -0,0 +1,11 @@
+ALTER TABLE followers
+    ADD COLUMN target_type TEXT NOT NULL DEFAULT 'user' CHECK (target_type IN ('user', 'board', 'tag'));
+
+ALTER TABLE followers DROP CONSTRAINT unique_follower_followee;
+ALTER TABLE followers ADD CONSTRAINT unique_follower_target UNIQUE (follower_id, target_type, followee_id);
+
+DROP INDEX IF EXISTS idx_followee_id;
+CREATE INDEX idx_followee_id ON followers(followee_id, target_type);
+
+DROP INDEX IF EXISTS idx_followers_followee_created_at;
+CREATE INDEX idx_followers_followee_created_at ON followers(followee_id, target_type, created_at DESC, follower_id);
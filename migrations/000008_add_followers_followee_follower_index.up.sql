@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_followers_followee_follower ON followers(followee_id, target_type, follower_id);
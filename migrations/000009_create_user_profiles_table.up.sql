@@ -0,0 +1,13 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE user_profiles (
+    user_id BIGINT PRIMARY KEY,
+    username TEXT NOT NULL,
+    full_name TEXT,
+    avatar_url TEXT,
+    synced_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_profiles_username_trgm ON user_profiles USING GIN (lower(username) gin_trgm_ops);
+CREATE INDEX idx_user_profiles_full_name_trgm ON user_profiles USING GIN (lower(full_name) gin_trgm_ops);
+CREATE INDEX idx_user_profiles_synced_at ON user_profiles(synced_at);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS relation_history;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE relation_history (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    follower_id BIGINT NOT NULL,
+    followee_id BIGINT NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('follow', 'unfollow', 'block', 'remove')),
+    actor_id BIGINT,
+    reason TEXT NOT NULL CHECK (reason IN ('user', 'admin', 'cascade', 'anti_spam')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_relation_history_pair ON relation_history(follower_id, followee_id, created_at DESC);
+CREATE INDEX idx_relation_history_follower ON relation_history(follower_id, created_at DESC);
+CREATE INDEX idx_relation_history_followee ON relation_history(followee_id, created_at DESC);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS mutes;
\ No newline at end of file
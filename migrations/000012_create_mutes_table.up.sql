@@ -0,0 +1,10 @@
+CREATE TABLE mutes (
+    follower_id BIGINT NOT NULL,
+    followee_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ,
+    PRIMARY KEY (follower_id, followee_id)
+);
+
+CREATE INDEX idx_mutes_follower_created_at ON mutes(follower_id, created_at DESC);
+CREATE INDEX idx_mutes_expires_at ON mutes(expires_at) WHERE expires_at IS NOT NULL;
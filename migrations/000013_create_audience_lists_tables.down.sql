@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS audience_list_members;
+DROP TABLE IF EXISTS audience_lists;
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE audience_lists (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    owner_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (owner_id, name)
+);
+
+CREATE TABLE audience_list_members (
+    list_id BIGINT NOT NULL REFERENCES audience_lists(id) ON DELETE CASCADE,
+    member_id BIGINT NOT NULL,
+    added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (list_id, member_id)
+);
+
+CREATE INDEX idx_audience_list_members_list_added_at ON audience_list_members(list_id, added_at DESC);
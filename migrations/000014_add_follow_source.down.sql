@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS follow_source_stats;
+
+DROP INDEX IF EXISTS idx_followers_created_at;
+
+ALTER TABLE followers
+    DROP COLUMN IF EXISTS context_id,
+    DROP COLUMN IF EXISTS source;
\ No newline at end of file
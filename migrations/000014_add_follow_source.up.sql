@@ -0,0 +1,13 @@
+ALTER TABLE followers
+    ADD COLUMN source TEXT CHECK (source IN ('suggestion', 'search', 'profile', 'board', 'import')),
+    ADD COLUMN context_id TEXT;
+
+CREATE INDEX idx_followers_created_at ON followers(created_at) WHERE target_type = 'user';
+
+CREATE TABLE follow_source_stats (
+    day DATE NOT NULL,
+    source TEXT NOT NULL,
+    follows BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (day, source)
+);
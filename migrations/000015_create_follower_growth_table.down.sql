@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS follower_growth;
+
+DROP INDEX IF EXISTS idx_relation_history_created_at;
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE follower_growth (
+    user_id BIGINT NOT NULL,
+    day DATE NOT NULL,
+    gained BIGINT NOT NULL DEFAULT 0,
+    lost BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, day)
+);
+
+CREATE INDEX idx_relation_history_created_at ON relation_history(created_at);
+
+INSERT INTO follower_growth (user_id, day, gained, lost)
+SELECT followee_id,
+       (created_at AT TIME ZONE 'UTC')::date,
+       COUNT(*) FILTER (WHERE action = 'follow'),
+       COUNT(*) FILTER (WHERE action <> 'follow')
+FROM relation_history
+GROUP BY 1, 2;
@@ -199,6 +199,65 @@ func (_c *Client_GetUserByUsername_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetUsers provides a mock function with given fields: ctx, ids
+func (_m *Client) GetUsers(ctx context.Context, ids []int64) (map[int64]*model.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 map[int64]*model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) (map[int64]*model.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) map[int64]*model.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type Client_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *Client_Expecter) GetUsers(ctx interface{}, ids interface{}) *Client_GetUsers_Call {
+	return &Client_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx, ids)}
+}
+
+func (_c *Client_GetUsers_Call) Run(run func(ctx context.Context, ids []int64)) *Client_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *Client_GetUsers_Call) Return(_a0 map[int64]*model.User, _a1 error) *Client_GetUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_GetUsers_Call) RunAndReturn(run func(context.Context, []int64) (map[int64]*model.User, error)) *Client_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClient(t interface {
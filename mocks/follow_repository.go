@@ -22,32 +22,39 @@ func (_m *FollowRepository) EXPECT() *FollowRepository_Expecter {
 	return &FollowRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: ctx, followerID, followeeID
-func (_m *FollowRepository) Create(ctx context.Context, followerID int64, followeeID int64) (model.Follower, error) {
-	ret := _m.Called(ctx, followerID, followeeID)
+// Create provides a mock function with given fields: ctx, followerID, followeeID, attribution
+func (_m *FollowRepository) Create(ctx context.Context, followerID int64, followeeID int64, attribution model.FollowAttribution) (model.Follower, bool, error) {
+	ret := _m.Called(ctx, followerID, followeeID, attribution)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 model.Follower
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Follower, error)); ok {
-		return rf(ctx, followerID, followeeID)
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, model.FollowAttribution) (model.Follower, bool, error)); ok {
+		return rf(ctx, followerID, followeeID, attribution)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Follower); ok {
-		r0 = rf(ctx, followerID, followeeID)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, model.FollowAttribution) model.Follower); ok {
+		r0 = rf(ctx, followerID, followeeID, attribution)
 	} else {
 		r0 = ret.Get(0).(model.Follower)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
-		r1 = rf(ctx, followerID, followeeID)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, model.FollowAttribution) bool); ok {
+		r1 = rf(ctx, followerID, followeeID, attribution)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(bool)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, model.FollowAttribution) error); ok {
+		r2 = rf(ctx, followerID, followeeID, attribution)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // FollowRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
@@ -59,43 +66,173 @@ type FollowRepository_Create_Call struct {
 //   - ctx context.Context
 //   - followerID int64
 //   - followeeID int64
-func (_e *FollowRepository_Expecter) Create(ctx interface{}, followerID interface{}, followeeID interface{}) *FollowRepository_Create_Call {
-	return &FollowRepository_Create_Call{Call: _e.mock.On("Create", ctx, followerID, followeeID)}
+//   - attribution model.FollowAttribution
+func (_e *FollowRepository_Expecter) Create(ctx interface{}, followerID interface{}, followeeID interface{}, attribution interface{}) *FollowRepository_Create_Call {
+	return &FollowRepository_Create_Call{Call: _e.mock.On("Create", ctx, followerID, followeeID, attribution)}
 }
 
-func (_c *FollowRepository_Create_Call) Run(run func(ctx context.Context, followerID int64, followeeID int64)) *FollowRepository_Create_Call {
+func (_c *FollowRepository_Create_Call) Run(run func(ctx context.Context, followerID int64, followeeID int64, attribution model.FollowAttribution)) *FollowRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(model.FollowAttribution))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_Create_Call) Return(_a0 model.Follower, _a1 bool, _a2 error) *FollowRepository_Create_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowRepository_Create_Call) RunAndReturn(run func(context.Context, int64, int64, model.FollowAttribution) (model.Follower, bool, error)) *FollowRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateMany provides a mock function with given fields: ctx, followerID, followeeIDs, attribution
+func (_m *FollowRepository) CreateMany(ctx context.Context, followerID int64, followeeIDs []int64, attribution model.FollowAttribution) ([]model.Follower, error) {
+	ret := _m.Called(ctx, followerID, followeeIDs, attribution)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMany")
+	}
+
+	var r0 []model.Follower
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64, model.FollowAttribution) ([]model.Follower, error)); ok {
+		return rf(ctx, followerID, followeeIDs, attribution)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64, model.FollowAttribution) []model.Follower); ok {
+		r0 = rf(ctx, followerID, followeeIDs, attribution)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Follower)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64, model.FollowAttribution) error); ok {
+		r1 = rf(ctx, followerID, followeeIDs, attribution)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowRepository_CreateMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMany'
+type FollowRepository_CreateMany_Call struct {
+	*mock.Call
+}
+
+// CreateMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - followeeIDs []int64
+//   - attribution model.FollowAttribution
+func (_e *FollowRepository_Expecter) CreateMany(ctx interface{}, followerID interface{}, followeeIDs interface{}, attribution interface{}) *FollowRepository_CreateMany_Call {
+	return &FollowRepository_CreateMany_Call{Call: _e.mock.On("CreateMany", ctx, followerID, followeeIDs, attribution)}
+}
+
+func (_c *FollowRepository_CreateMany_Call) Run(run func(ctx context.Context, followerID int64, followeeIDs []int64, attribution model.FollowAttribution)) *FollowRepository_CreateMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64), args[3].(model.FollowAttribution))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_CreateMany_Call) Return(_a0 []model.Follower, _a1 error) *FollowRepository_CreateMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowRepository_CreateMany_Call) RunAndReturn(run func(context.Context, int64, []int64, model.FollowAttribution) ([]model.Follower, error)) *FollowRepository_CreateMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTarget provides a mock function with given fields: ctx, followerID, target
+func (_m *FollowRepository) CreateTarget(ctx context.Context, followerID int64, target model.FollowTarget) (model.Follower, error) {
+	ret := _m.Called(ctx, followerID, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTarget")
+	}
+
+	var r0 model.Follower
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowTarget) (model.Follower, error)); ok {
+		return rf(ctx, followerID, target)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowTarget) model.Follower); ok {
+		r0 = rf(ctx, followerID, target)
+	} else {
+		r0 = ret.Get(0).(model.Follower)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.FollowTarget) error); ok {
+		r1 = rf(ctx, followerID, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowRepository_CreateTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTarget'
+type FollowRepository_CreateTarget_Call struct {
+	*mock.Call
+}
+
+// CreateTarget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - target model.FollowTarget
+func (_e *FollowRepository_Expecter) CreateTarget(ctx interface{}, followerID interface{}, target interface{}) *FollowRepository_CreateTarget_Call {
+	return &FollowRepository_CreateTarget_Call{Call: _e.mock.On("CreateTarget", ctx, followerID, target)}
+}
+
+func (_c *FollowRepository_CreateTarget_Call) Run(run func(ctx context.Context, followerID int64, target model.FollowTarget)) *FollowRepository_CreateTarget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.FollowTarget))
 	})
 	return _c
 }
 
-func (_c *FollowRepository_Create_Call) Return(_a0 model.Follower, _a1 error) *FollowRepository_Create_Call {
+func (_c *FollowRepository_CreateTarget_Call) Return(_a0 model.Follower, _a1 error) *FollowRepository_CreateTarget_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *FollowRepository_Create_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Follower, error)) *FollowRepository_Create_Call {
+func (_c *FollowRepository_CreateTarget_Call) RunAndReturn(run func(context.Context, int64, model.FollowTarget) (model.Follower, error)) *FollowRepository_CreateTarget_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Delete provides a mock function with given fields: ctx, followerID, followeeID
-func (_m *FollowRepository) Delete(ctx context.Context, followerID int64, followeeID int64) error {
+func (_m *FollowRepository) Delete(ctx context.Context, followerID int64, followeeID int64) (model.Follower, error) {
 	ret := _m.Called(ctx, followerID, followeeID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+	var r0 model.Follower
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (model.Follower, error)); ok {
+		return rf(ctx, followerID, followeeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) model.Follower); ok {
 		r0 = rf(ctx, followerID, followeeID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.Follower)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, followerID, followeeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // FollowRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
@@ -118,12 +255,180 @@ func (_c *FollowRepository_Delete_Call) Run(run func(ctx context.Context, follow
 	return _c
 }
 
-func (_c *FollowRepository_Delete_Call) Return(_a0 error) *FollowRepository_Delete_Call {
+func (_c *FollowRepository_Delete_Call) Return(_a0 model.Follower, _a1 error) *FollowRepository_Delete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) (model.Follower, error)) *FollowRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFollowers provides a mock function with given fields: ctx, followeeID, followerIDs
+func (_m *FollowRepository) DeleteFollowers(ctx context.Context, followeeID int64, followerIDs []int64) ([]int64, error) {
+	ret := _m.Called(ctx, followeeID, followerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFollowers")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]int64, error)); ok {
+		return rf(ctx, followeeID, followerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) []int64); ok {
+		r0 = rf(ctx, followeeID, followerIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, followeeID, followerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowRepository_DeleteFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFollowers'
+type FollowRepository_DeleteFollowers_Call struct {
+	*mock.Call
+}
+
+// DeleteFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followeeID int64
+//   - followerIDs []int64
+func (_e *FollowRepository_Expecter) DeleteFollowers(ctx interface{}, followeeID interface{}, followerIDs interface{}) *FollowRepository_DeleteFollowers_Call {
+	return &FollowRepository_DeleteFollowers_Call{Call: _e.mock.On("DeleteFollowers", ctx, followeeID, followerIDs)}
+}
+
+func (_c *FollowRepository_DeleteFollowers_Call) Run(run func(ctx context.Context, followeeID int64, followerIDs []int64)) *FollowRepository_DeleteFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_DeleteFollowers_Call) Return(_a0 []int64, _a1 error) *FollowRepository_DeleteFollowers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowRepository_DeleteFollowers_Call) RunAndReturn(run func(context.Context, int64, []int64) ([]int64, error)) *FollowRepository_DeleteFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMany provides a mock function with given fields: ctx, followerID, followeeIDs
+func (_m *FollowRepository) DeleteMany(ctx context.Context, followerID int64, followeeIDs []int64) ([]model.Follower, error) {
+	ret := _m.Called(ctx, followerID, followeeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMany")
+	}
+
+	var r0 []model.Follower
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]model.Follower, error)); ok {
+		return rf(ctx, followerID, followeeIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) []model.Follower); ok {
+		r0 = rf(ctx, followerID, followeeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Follower)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, followerID, followeeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowRepository_DeleteMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMany'
+type FollowRepository_DeleteMany_Call struct {
+	*mock.Call
+}
+
+// DeleteMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - followeeIDs []int64
+func (_e *FollowRepository_Expecter) DeleteMany(ctx interface{}, followerID interface{}, followeeIDs interface{}) *FollowRepository_DeleteMany_Call {
+	return &FollowRepository_DeleteMany_Call{Call: _e.mock.On("DeleteMany", ctx, followerID, followeeIDs)}
+}
+
+func (_c *FollowRepository_DeleteMany_Call) Run(run func(ctx context.Context, followerID int64, followeeIDs []int64)) *FollowRepository_DeleteMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_DeleteMany_Call) Return(_a0 []model.Follower, _a1 error) *FollowRepository_DeleteMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowRepository_DeleteMany_Call) RunAndReturn(run func(context.Context, int64, []int64) ([]model.Follower, error)) *FollowRepository_DeleteMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTarget provides a mock function with given fields: ctx, followerID, target
+func (_m *FollowRepository) DeleteTarget(ctx context.Context, followerID int64, target model.FollowTarget) error {
+	ret := _m.Called(ctx, followerID, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTarget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowTarget) error); ok {
+		r0 = rf(ctx, followerID, target)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowRepository_DeleteTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTarget'
+type FollowRepository_DeleteTarget_Call struct {
+	*mock.Call
+}
+
+// DeleteTarget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - target model.FollowTarget
+func (_e *FollowRepository_Expecter) DeleteTarget(ctx interface{}, followerID interface{}, target interface{}) *FollowRepository_DeleteTarget_Call {
+	return &FollowRepository_DeleteTarget_Call{Call: _e.mock.On("DeleteTarget", ctx, followerID, target)}
+}
+
+func (_c *FollowRepository_DeleteTarget_Call) Run(run func(ctx context.Context, followerID int64, target model.FollowTarget)) *FollowRepository_DeleteTarget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.FollowTarget))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_DeleteTarget_Call) Return(_a0 error) *FollowRepository_DeleteTarget_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *FollowRepository_Delete_Call) RunAndReturn(run func(context.Context, int64, int64) error) *FollowRepository_Delete_Call {
+func (_c *FollowRepository_DeleteTarget_Call) RunAndReturn(run func(context.Context, int64, model.FollowTarget) error) *FollowRepository_DeleteTarget_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -186,36 +491,36 @@ func (_c *FollowRepository_Exists_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
-// GetFollowees provides a mock function with given fields: ctx, followerID, limit, offset
-func (_m *FollowRepository) GetFollowees(ctx context.Context, followerID int64, limit int32, offset int32) ([]int64, int64, error) {
-	ret := _m.Called(ctx, followerID, limit, offset)
+// GetFollowedTargets provides a mock function with given fields: ctx, followerID, targetType, limit, offset
+func (_m *FollowRepository) GetFollowedTargets(ctx context.Context, followerID int64, targetType model.TargetType, limit int32, offset int32) ([]int64, int64, error) {
+	ret := _m.Called(ctx, followerID, targetType, limit, offset)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowees")
+		panic("no return value specified for GetFollowedTargets")
 	}
 
 	var r0 []int64
 	var r1 int64
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]int64, int64, error)); ok {
-		return rf(ctx, followerID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TargetType, int32, int32) ([]int64, int64, error)); ok {
+		return rf(ctx, followerID, targetType, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []int64); ok {
-		r0 = rf(ctx, followerID, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TargetType, int32, int32) []int64); ok {
+		r0 = rf(ctx, followerID, targetType, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]int64)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
-		r1 = rf(ctx, followerID, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.TargetType, int32, int32) int64); ok {
+		r1 = rf(ctx, followerID, targetType, limit, offset)
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
-		r2 = rf(ctx, followerID, limit, offset)
+	if rf, ok := ret.Get(2).(func(context.Context, int64, model.TargetType, int32, int32) error); ok {
+		r2 = rf(ctx, followerID, targetType, limit, offset)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -223,53 +528,54 @@ func (_m *FollowRepository) GetFollowees(ctx context.Context, followerID int64,
 	return r0, r1, r2
 }
 
-// FollowRepository_GetFollowees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowees'
-type FollowRepository_GetFollowees_Call struct {
+// FollowRepository_GetFollowedTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowedTargets'
+type FollowRepository_GetFollowedTargets_Call struct {
 	*mock.Call
 }
 
-// GetFollowees is a helper method to define mock.On call
+// GetFollowedTargets is a helper method to define mock.On call
 //   - ctx context.Context
 //   - followerID int64
+//   - targetType model.TargetType
 //   - limit int32
 //   - offset int32
-func (_e *FollowRepository_Expecter) GetFollowees(ctx interface{}, followerID interface{}, limit interface{}, offset interface{}) *FollowRepository_GetFollowees_Call {
-	return &FollowRepository_GetFollowees_Call{Call: _e.mock.On("GetFollowees", ctx, followerID, limit, offset)}
+func (_e *FollowRepository_Expecter) GetFollowedTargets(ctx interface{}, followerID interface{}, targetType interface{}, limit interface{}, offset interface{}) *FollowRepository_GetFollowedTargets_Call {
+	return &FollowRepository_GetFollowedTargets_Call{Call: _e.mock.On("GetFollowedTargets", ctx, followerID, targetType, limit, offset)}
 }
 
-func (_c *FollowRepository_GetFollowees_Call) Run(run func(ctx context.Context, followerID int64, limit int32, offset int32)) *FollowRepository_GetFollowees_Call {
+func (_c *FollowRepository_GetFollowedTargets_Call) Run(run func(ctx context.Context, followerID int64, targetType model.TargetType, limit int32, offset int32)) *FollowRepository_GetFollowedTargets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int32), args[3].(int32))
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.TargetType), args[3].(int32), args[4].(int32))
 	})
 	return _c
 }
 
-func (_c *FollowRepository_GetFollowees_Call) Return(_a0 []int64, _a1 int64, _a2 error) *FollowRepository_GetFollowees_Call {
+func (_c *FollowRepository_GetFollowedTargets_Call) Return(_a0 []int64, _a1 int64, _a2 error) *FollowRepository_GetFollowedTargets_Call {
 	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *FollowRepository_GetFollowees_Call) RunAndReturn(run func(context.Context, int64, int32, int32) ([]int64, int64, error)) *FollowRepository_GetFollowees_Call {
+func (_c *FollowRepository_GetFollowedTargets_Call) RunAndReturn(run func(context.Context, int64, model.TargetType, int32, int32) ([]int64, int64, error)) *FollowRepository_GetFollowedTargets_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFollowers provides a mock function with given fields: ctx, followeeID, limit, offset
-func (_m *FollowRepository) GetFollowers(ctx context.Context, followeeID int64, limit int32, offset int32) ([]int64, int64, error) {
-	ret := _m.Called(ctx, followeeID, limit, offset)
+// GetFollowees provides a mock function with given fields: ctx, followerID, limit, offset
+func (_m *FollowRepository) GetFollowees(ctx context.Context, followerID int64, limit int32, offset int32) ([]int64, int64, error) {
+	ret := _m.Called(ctx, followerID, limit, offset)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowers")
+		panic("no return value specified for GetFollowees")
 	}
 
 	var r0 []int64
 	var r1 int64
 	var r2 error
 	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]int64, int64, error)); ok {
-		return rf(ctx, followeeID, limit, offset)
+		return rf(ctx, followerID, limit, offset)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []int64); ok {
-		r0 = rf(ctx, followeeID, limit, offset)
+		r0 = rf(ctx, followerID, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]int64)
@@ -277,13 +583,142 @@ func (_m *FollowRepository) GetFollowers(ctx context.Context, followeeID int64,
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
-		r1 = rf(ctx, followeeID, limit, offset)
+		r1 = rf(ctx, followerID, limit, offset)
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
 
 	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
-		r2 = rf(ctx, followeeID, limit, offset)
+		r2 = rf(ctx, followerID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowRepository_GetFollowees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowees'
+type FollowRepository_GetFollowees_Call struct {
+	*mock.Call
+}
+
+// GetFollowees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - limit int32
+//   - offset int32
+func (_e *FollowRepository_Expecter) GetFollowees(ctx interface{}, followerID interface{}, limit interface{}, offset interface{}) *FollowRepository_GetFollowees_Call {
+	return &FollowRepository_GetFollowees_Call{Call: _e.mock.On("GetFollowees", ctx, followerID, limit, offset)}
+}
+
+func (_c *FollowRepository_GetFollowees_Call) Run(run func(ctx context.Context, followerID int64, limit int32, offset int32)) *FollowRepository_GetFollowees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_GetFollowees_Call) Return(_a0 []int64, _a1 int64, _a2 error) *FollowRepository_GetFollowees_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowRepository_GetFollowees_Call) RunAndReturn(run func(context.Context, int64, int32, int32) ([]int64, int64, error)) *FollowRepository_GetFollowees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowerIDsAfter provides a mock function with given fields: ctx, followeeID, afterID, limit
+func (_m *FollowRepository) GetFollowerIDsAfter(ctx context.Context, followeeID int64, afterID int64, limit int32) ([]int64, error) {
+	ret := _m.Called(ctx, followeeID, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowerIDsAfter")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32) ([]int64, error)); ok {
+		return rf(ctx, followeeID, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32) []int64); ok {
+		r0 = rf(ctx, followeeID, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int32) error); ok {
+		r1 = rf(ctx, followeeID, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowRepository_GetFollowerIDsAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowerIDsAfter'
+type FollowRepository_GetFollowerIDsAfter_Call struct {
+	*mock.Call
+}
+
+// GetFollowerIDsAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followeeID int64
+//   - afterID int64
+//   - limit int32
+func (_e *FollowRepository_Expecter) GetFollowerIDsAfter(ctx interface{}, followeeID interface{}, afterID interface{}, limit interface{}) *FollowRepository_GetFollowerIDsAfter_Call {
+	return &FollowRepository_GetFollowerIDsAfter_Call{Call: _e.mock.On("GetFollowerIDsAfter", ctx, followeeID, afterID, limit)}
+}
+
+func (_c *FollowRepository_GetFollowerIDsAfter_Call) Run(run func(ctx context.Context, followeeID int64, afterID int64, limit int32)) *FollowRepository_GetFollowerIDsAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_GetFollowerIDsAfter_Call) Return(_a0 []int64, _a1 error) *FollowRepository_GetFollowerIDsAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowRepository_GetFollowerIDsAfter_Call) RunAndReturn(run func(context.Context, int64, int64, int32) ([]int64, error)) *FollowRepository_GetFollowerIDsAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function with given fields: ctx, followeeID, limit, offset
+func (_m *FollowRepository) GetFollowers(ctx context.Context, followeeID int64, limit int32, offset int32) ([]int64, int64, error) {
+	ret := _m.Called(ctx, followeeID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 []int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]int64, int64, error)); ok {
+		return rf(ctx, followeeID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []int64); ok {
+		r0 = rf(ctx, followeeID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
+		r1 = rf(ctx, followeeID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
+		r2 = rf(ctx, followeeID, limit, offset)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -322,6 +757,458 @@ func (_c *FollowRepository_GetFollowers_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetFollowersYouKnow provides a mock function with given fields: ctx, viewerID, targetID, limit
+func (_m *FollowRepository) GetFollowersYouKnow(ctx context.Context, viewerID int64, targetID int64, limit int32) ([]int64, int64, error) {
+	ret := _m.Called(ctx, viewerID, targetID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowersYouKnow")
+	}
+
+	var r0 []int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32) ([]int64, int64, error)); ok {
+		return rf(ctx, viewerID, targetID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32) []int64); ok {
+		r0 = rf(ctx, viewerID, targetID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int32) int64); ok {
+		r1 = rf(ctx, viewerID, targetID, limit)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, int32) error); ok {
+		r2 = rf(ctx, viewerID, targetID, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowRepository_GetFollowersYouKnow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowersYouKnow'
+type FollowRepository_GetFollowersYouKnow_Call struct {
+	*mock.Call
+}
+
+// GetFollowersYouKnow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerID int64
+//   - targetID int64
+//   - limit int32
+func (_e *FollowRepository_Expecter) GetFollowersYouKnow(ctx interface{}, viewerID interface{}, targetID interface{}, limit interface{}) *FollowRepository_GetFollowersYouKnow_Call {
+	return &FollowRepository_GetFollowersYouKnow_Call{Call: _e.mock.On("GetFollowersYouKnow", ctx, viewerID, targetID, limit)}
+}
+
+func (_c *FollowRepository_GetFollowersYouKnow_Call) Run(run func(ctx context.Context, viewerID int64, targetID int64, limit int32)) *FollowRepository_GetFollowersYouKnow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_GetFollowersYouKnow_Call) Return(_a0 []int64, _a1 int64, _a2 error) *FollowRepository_GetFollowersYouKnow_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowRepository_GetFollowersYouKnow_Call) RunAndReturn(run func(context.Context, int64, int64, int32) ([]int64, int64, error)) *FollowRepository_GetFollowersYouKnow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMutualFollows provides a mock function with given fields: ctx, userID, limit, offset
+func (_m *FollowRepository) GetMutualFollows(ctx context.Context, userID int64, limit int32, offset int32) ([]int64, int64, error) {
+	ret := _m.Called(ctx, userID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMutualFollows")
+	}
+
+	var r0 []int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]int64, int64, error)); ok {
+		return rf(ctx, userID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []int64); ok {
+		r0 = rf(ctx, userID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
+		r1 = rf(ctx, userID, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
+		r2 = rf(ctx, userID, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowRepository_GetMutualFollows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMutualFollows'
+type FollowRepository_GetMutualFollows_Call struct {
+	*mock.Call
+}
+
+// GetMutualFollows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - limit int32
+//   - offset int32
+func (_e *FollowRepository_Expecter) GetMutualFollows(ctx interface{}, userID interface{}, limit interface{}, offset interface{}) *FollowRepository_GetMutualFollows_Call {
+	return &FollowRepository_GetMutualFollows_Call{Call: _e.mock.On("GetMutualFollows", ctx, userID, limit, offset)}
+}
+
+func (_c *FollowRepository_GetMutualFollows_Call) Run(run func(ctx context.Context, userID int64, limit int32, offset int32)) *FollowRepository_GetMutualFollows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_GetMutualFollows_Call) Return(_a0 []int64, _a1 int64, _a2 error) *FollowRepository_GetMutualFollows_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowRepository_GetMutualFollows_Call) RunAndReturn(run func(context.Context, int64, int32, int32) ([]int64, int64, error)) *FollowRepository_GetMutualFollows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRelationships provides a mock function with given fields: ctx, viewerID, targetIDs
+func (_m *FollowRepository) GetRelationships(ctx context.Context, viewerID int64, targetIDs []int64) (map[int64]model.Relationship, error) {
+	ret := _m.Called(ctx, viewerID, targetIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelationships")
+	}
+
+	var r0 map[int64]model.Relationship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) (map[int64]model.Relationship, error)); ok {
+		return rf(ctx, viewerID, targetIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) map[int64]model.Relationship); ok {
+		r0 = rf(ctx, viewerID, targetIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]model.Relationship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, viewerID, targetIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowRepository_GetRelationships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRelationships'
+type FollowRepository_GetRelationships_Call struct {
+	*mock.Call
+}
+
+// GetRelationships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerID int64
+//   - targetIDs []int64
+func (_e *FollowRepository_Expecter) GetRelationships(ctx interface{}, viewerID interface{}, targetIDs interface{}) *FollowRepository_GetRelationships_Call {
+	return &FollowRepository_GetRelationships_Call{Call: _e.mock.On("GetRelationships", ctx, viewerID, targetIDs)}
+}
+
+func (_c *FollowRepository_GetRelationships_Call) Run(run func(ctx context.Context, viewerID int64, targetIDs []int64)) *FollowRepository_GetRelationships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_GetRelationships_Call) Return(_a0 map[int64]model.Relationship, _a1 error) *FollowRepository_GetRelationships_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowRepository_GetRelationships_Call) RunAndReturn(run func(context.Context, int64, []int64) (map[int64]model.Relationship, error)) *FollowRepository_GetRelationships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMutual provides a mock function with given fields: ctx, userID, otherUserID
+func (_m *FollowRepository) IsMutual(ctx context.Context, userID int64, otherUserID int64) (bool, error) {
+	ret := _m.Called(ctx, userID, otherUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMutual")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
+		return rf(ctx, userID, otherUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = rf(ctx, userID, otherUserID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, otherUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowRepository_IsMutual_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMutual'
+type FollowRepository_IsMutual_Call struct {
+	*mock.Call
+}
+
+// IsMutual is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - otherUserID int64
+func (_e *FollowRepository_Expecter) IsMutual(ctx interface{}, userID interface{}, otherUserID interface{}) *FollowRepository_IsMutual_Call {
+	return &FollowRepository_IsMutual_Call{Call: _e.mock.On("IsMutual", ctx, userID, otherUserID)}
+}
+
+func (_c *FollowRepository_IsMutual_Call) Run(run func(ctx context.Context, userID int64, otherUserID int64)) *FollowRepository_IsMutual_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_IsMutual_Call) Return(_a0 bool, _a1 error) *FollowRepository_IsMutual_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowRepository_IsMutual_Call) RunAndReturn(run func(context.Context, int64, int64) (bool, error)) *FollowRepository_IsMutual_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFollowees provides a mock function with given fields: ctx, followerID, opts, limit, offset
+func (_m *FollowRepository) ListFollowees(ctx context.Context, followerID int64, opts model.FollowListOptions, limit int32, offset int32) ([]int64, int64, error) {
+	ret := _m.Called(ctx, followerID, opts, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowees")
+	}
+
+	var r0 []int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowListOptions, int32, int32) ([]int64, int64, error)); ok {
+		return rf(ctx, followerID, opts, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowListOptions, int32, int32) []int64); ok {
+		r0 = rf(ctx, followerID, opts, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.FollowListOptions, int32, int32) int64); ok {
+		r1 = rf(ctx, followerID, opts, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, model.FollowListOptions, int32, int32) error); ok {
+		r2 = rf(ctx, followerID, opts, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowRepository_ListFollowees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollowees'
+type FollowRepository_ListFollowees_Call struct {
+	*mock.Call
+}
+
+// ListFollowees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - opts model.FollowListOptions
+//   - limit int32
+//   - offset int32
+func (_e *FollowRepository_Expecter) ListFollowees(ctx interface{}, followerID interface{}, opts interface{}, limit interface{}, offset interface{}) *FollowRepository_ListFollowees_Call {
+	return &FollowRepository_ListFollowees_Call{Call: _e.mock.On("ListFollowees", ctx, followerID, opts, limit, offset)}
+}
+
+func (_c *FollowRepository_ListFollowees_Call) Run(run func(ctx context.Context, followerID int64, opts model.FollowListOptions, limit int32, offset int32)) *FollowRepository_ListFollowees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.FollowListOptions), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_ListFollowees_Call) Return(_a0 []int64, _a1 int64, _a2 error) *FollowRepository_ListFollowees_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowRepository_ListFollowees_Call) RunAndReturn(run func(context.Context, int64, model.FollowListOptions, int32, int32) ([]int64, int64, error)) *FollowRepository_ListFollowees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFollowers provides a mock function with given fields: ctx, followeeID, opts, limit, offset
+func (_m *FollowRepository) ListFollowers(ctx context.Context, followeeID int64, opts model.FollowListOptions, limit int32, offset int32) ([]int64, int64, error) {
+	ret := _m.Called(ctx, followeeID, opts, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowers")
+	}
+
+	var r0 []int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowListOptions, int32, int32) ([]int64, int64, error)); ok {
+		return rf(ctx, followeeID, opts, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowListOptions, int32, int32) []int64); ok {
+		r0 = rf(ctx, followeeID, opts, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.FollowListOptions, int32, int32) int64); ok {
+		r1 = rf(ctx, followeeID, opts, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, model.FollowListOptions, int32, int32) error); ok {
+		r2 = rf(ctx, followeeID, opts, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowRepository_ListFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollowers'
+type FollowRepository_ListFollowers_Call struct {
+	*mock.Call
+}
+
+// ListFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followeeID int64
+//   - opts model.FollowListOptions
+//   - limit int32
+//   - offset int32
+func (_e *FollowRepository_Expecter) ListFollowers(ctx interface{}, followeeID interface{}, opts interface{}, limit interface{}, offset interface{}) *FollowRepository_ListFollowers_Call {
+	return &FollowRepository_ListFollowers_Call{Call: _e.mock.On("ListFollowers", ctx, followeeID, opts, limit, offset)}
+}
+
+func (_c *FollowRepository_ListFollowers_Call) Run(run func(ctx context.Context, followeeID int64, opts model.FollowListOptions, limit int32, offset int32)) *FollowRepository_ListFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.FollowListOptions), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_ListFollowers_Call) Return(_a0 []int64, _a1 int64, _a2 error) *FollowRepository_ListFollowers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowRepository_ListFollowers_Call) RunAndReturn(run func(context.Context, int64, model.FollowListOptions, int32, int32) ([]int64, int64, error)) *FollowRepository_ListFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockFollowers provides a mock function with given fields: ctx, followeeID, followerIDs
+func (_m *FollowRepository) LockFollowers(ctx context.Context, followeeID int64, followerIDs []int64) ([]int64, error) {
+	ret := _m.Called(ctx, followeeID, followerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockFollowers")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]int64, error)); ok {
+		return rf(ctx, followeeID, followerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) []int64); ok {
+		r0 = rf(ctx, followeeID, followerIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, followeeID, followerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowRepository_LockFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockFollowers'
+type FollowRepository_LockFollowers_Call struct {
+	*mock.Call
+}
+
+// LockFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followeeID int64
+//   - followerIDs []int64
+func (_e *FollowRepository_Expecter) LockFollowers(ctx interface{}, followeeID interface{}, followerIDs interface{}) *FollowRepository_LockFollowers_Call {
+	return &FollowRepository_LockFollowers_Call{Call: _e.mock.On("LockFollowers", ctx, followeeID, followerIDs)}
+}
+
+func (_c *FollowRepository_LockFollowers_Call) Run(run func(ctx context.Context, followeeID int64, followerIDs []int64)) *FollowRepository_LockFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowRepository_LockFollowers_Call) Return(_a0 []int64, _a1 error) *FollowRepository_LockFollowers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowRepository_LockFollowers_Call) RunAndReturn(run func(context.Context, int64, []int64) ([]int64, error)) *FollowRepository_LockFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewFollowRepository creates a new instance of FollowRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFollowRepository(t interface {
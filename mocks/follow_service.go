@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 	model "pinstack-relation-service/internal/domain/models"
+	time "time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -22,17 +23,1669 @@ func (_m *FollowService) EXPECT() *FollowService_Expecter {
 	return &FollowService_Expecter{mock: &_m.Mock}
 }
 
+// AddAudienceMembers provides a mock function with given fields: ctx, ownerID, listID, memberIDs
+func (_m *FollowService) AddAudienceMembers(ctx context.Context, ownerID int64, listID int64, memberIDs []int64) ([]int64, error) {
+	ret := _m.Called(ctx, ownerID, listID, memberIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAudienceMembers")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64) ([]int64, error)); ok {
+		return rf(ctx, ownerID, listID, memberIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64) []int64); ok {
+		r0 = rf(ctx, ownerID, listID, memberIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []int64) error); ok {
+		r1 = rf(ctx, ownerID, listID, memberIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_AddAudienceMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAudienceMembers'
+type FollowService_AddAudienceMembers_Call struct {
+	*mock.Call
+}
+
+// AddAudienceMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID int64
+//   - listID int64
+//   - memberIDs []int64
+func (_e *FollowService_Expecter) AddAudienceMembers(ctx interface{}, ownerID interface{}, listID interface{}, memberIDs interface{}) *FollowService_AddAudienceMembers_Call {
+	return &FollowService_AddAudienceMembers_Call{Call: _e.mock.On("AddAudienceMembers", ctx, ownerID, listID, memberIDs)}
+}
+
+func (_c *FollowService_AddAudienceMembers_Call) Run(run func(ctx context.Context, ownerID int64, listID int64, memberIDs []int64)) *FollowService_AddAudienceMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_AddAudienceMembers_Call) Return(_a0 []int64, _a1 error) *FollowService_AddAudienceMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_AddAudienceMembers_Call) RunAndReturn(run func(context.Context, int64, int64, []int64) ([]int64, error)) *FollowService_AddAudienceMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkFollow provides a mock function with given fields: ctx, followerID, followeeIDs
+func (_m *FollowService) BulkFollow(ctx context.Context, followerID int64, followeeIDs []int64) ([]model.BulkResult, error) {
+	ret := _m.Called(ctx, followerID, followeeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkFollow")
+	}
+
+	var r0 []model.BulkResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]model.BulkResult, error)); ok {
+		return rf(ctx, followerID, followeeIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) []model.BulkResult); ok {
+		r0 = rf(ctx, followerID, followeeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BulkResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, followerID, followeeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_BulkFollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkFollow'
+type FollowService_BulkFollow_Call struct {
+	*mock.Call
+}
+
+// BulkFollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - followeeIDs []int64
+func (_e *FollowService_Expecter) BulkFollow(ctx interface{}, followerID interface{}, followeeIDs interface{}) *FollowService_BulkFollow_Call {
+	return &FollowService_BulkFollow_Call{Call: _e.mock.On("BulkFollow", ctx, followerID, followeeIDs)}
+}
+
+func (_c *FollowService_BulkFollow_Call) Run(run func(ctx context.Context, followerID int64, followeeIDs []int64)) *FollowService_BulkFollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_BulkFollow_Call) Return(_a0 []model.BulkResult, _a1 error) *FollowService_BulkFollow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_BulkFollow_Call) RunAndReturn(run func(context.Context, int64, []int64) ([]model.BulkResult, error)) *FollowService_BulkFollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUnfollow provides a mock function with given fields: ctx, followerID, followeeIDs
+func (_m *FollowService) BulkUnfollow(ctx context.Context, followerID int64, followeeIDs []int64) ([]model.BulkResult, error) {
+	ret := _m.Called(ctx, followerID, followeeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUnfollow")
+	}
+
+	var r0 []model.BulkResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]model.BulkResult, error)); ok {
+		return rf(ctx, followerID, followeeIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) []model.BulkResult); ok {
+		r0 = rf(ctx, followerID, followeeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.BulkResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, followerID, followeeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_BulkUnfollow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUnfollow'
+type FollowService_BulkUnfollow_Call struct {
+	*mock.Call
+}
+
+// BulkUnfollow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - followeeIDs []int64
+func (_e *FollowService_Expecter) BulkUnfollow(ctx interface{}, followerID interface{}, followeeIDs interface{}) *FollowService_BulkUnfollow_Call {
+	return &FollowService_BulkUnfollow_Call{Call: _e.mock.On("BulkUnfollow", ctx, followerID, followeeIDs)}
+}
+
+func (_c *FollowService_BulkUnfollow_Call) Run(run func(ctx context.Context, followerID int64, followeeIDs []int64)) *FollowService_BulkUnfollow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_BulkUnfollow_Call) Return(_a0 []model.BulkResult, _a1 error) *FollowService_BulkUnfollow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_BulkUnfollow_Call) RunAndReturn(run func(context.Context, int64, []int64) ([]model.BulkResult, error)) *FollowService_BulkUnfollow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAudienceList provides a mock function with given fields: ctx, ownerID, name
+func (_m *FollowService) CreateAudienceList(ctx context.Context, ownerID int64, name string) (model.AudienceList, error) {
+	ret := _m.Called(ctx, ownerID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAudienceList")
+	}
+
+	var r0 model.AudienceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (model.AudienceList, error)); ok {
+		return rf(ctx, ownerID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) model.AudienceList); ok {
+		r0 = rf(ctx, ownerID, name)
+	} else {
+		r0 = ret.Get(0).(model.AudienceList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, ownerID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_CreateAudienceList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAudienceList'
+type FollowService_CreateAudienceList_Call struct {
+	*mock.Call
+}
+
+// CreateAudienceList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID int64
+//   - name string
+func (_e *FollowService_Expecter) CreateAudienceList(ctx interface{}, ownerID interface{}, name interface{}) *FollowService_CreateAudienceList_Call {
+	return &FollowService_CreateAudienceList_Call{Call: _e.mock.On("CreateAudienceList", ctx, ownerID, name)}
+}
+
+func (_c *FollowService_CreateAudienceList_Call) Run(run func(ctx context.Context, ownerID int64, name string)) *FollowService_CreateAudienceList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *FollowService_CreateAudienceList_Call) Return(_a0 model.AudienceList, _a1 error) *FollowService_CreateAudienceList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_CreateAudienceList_Call) RunAndReturn(run func(context.Context, int64, string) (model.AudienceList, error)) *FollowService_CreateAudienceList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAudienceList provides a mock function with given fields: ctx, ownerID, listID
+func (_m *FollowService) DeleteAudienceList(ctx context.Context, ownerID int64, listID int64) error {
+	ret := _m.Called(ctx, ownerID, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAudienceList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, ownerID, listID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowService_DeleteAudienceList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAudienceList'
+type FollowService_DeleteAudienceList_Call struct {
+	*mock.Call
+}
+
+// DeleteAudienceList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID int64
+//   - listID int64
+func (_e *FollowService_Expecter) DeleteAudienceList(ctx interface{}, ownerID interface{}, listID interface{}) *FollowService_DeleteAudienceList_Call {
+	return &FollowService_DeleteAudienceList_Call{Call: _e.mock.On("DeleteAudienceList", ctx, ownerID, listID)}
+}
+
+func (_c *FollowService_DeleteAudienceList_Call) Run(run func(ctx context.Context, ownerID int64, listID int64)) *FollowService_DeleteAudienceList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_DeleteAudienceList_Call) Return(_a0 error) *FollowService_DeleteAudienceList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowService_DeleteAudienceList_Call) RunAndReturn(run func(context.Context, int64, int64) error) *FollowService_DeleteAudienceList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterMuted provides a mock function with given fields: ctx, viewerID, authorIDs
+func (_m *FollowService) FilterMuted(ctx context.Context, viewerID int64, authorIDs []int64) ([]int64, error) {
+	ret := _m.Called(ctx, viewerID, authorIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterMuted")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]int64, error)); ok {
+		return rf(ctx, viewerID, authorIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) []int64); ok {
+		r0 = rf(ctx, viewerID, authorIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, viewerID, authorIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_FilterMuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterMuted'
+type FollowService_FilterMuted_Call struct {
+	*mock.Call
+}
+
+// FilterMuted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerID int64
+//   - authorIDs []int64
+func (_e *FollowService_Expecter) FilterMuted(ctx interface{}, viewerID interface{}, authorIDs interface{}) *FollowService_FilterMuted_Call {
+	return &FollowService_FilterMuted_Call{Call: _e.mock.On("FilterMuted", ctx, viewerID, authorIDs)}
+}
+
+func (_c *FollowService_FilterMuted_Call) Run(run func(ctx context.Context, viewerID int64, authorIDs []int64)) *FollowService_FilterMuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_FilterMuted_Call) Return(_a0 []int64, _a1 error) *FollowService_FilterMuted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_FilterMuted_Call) RunAndReturn(run func(context.Context, int64, []int64) ([]int64, error)) *FollowService_FilterMuted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Follow provides a mock function with given fields: ctx, followerID, followeeID
 func (_m *FollowService) Follow(ctx context.Context, followerID int64, followeeID int64) error {
 	ret := _m.Called(ctx, followerID, followeeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Follow")
+		panic("no return value specified for Follow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, followerID, followeeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowService_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
+type FollowService_Follow_Call struct {
+	*mock.Call
+}
+
+// Follow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - followeeID int64
+func (_e *FollowService_Expecter) Follow(ctx interface{}, followerID interface{}, followeeID interface{}) *FollowService_Follow_Call {
+	return &FollowService_Follow_Call{Call: _e.mock.On("Follow", ctx, followerID, followeeID)}
+}
+
+func (_c *FollowService_Follow_Call) Run(run func(ctx context.Context, followerID int64, followeeID int64)) *FollowService_Follow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_Follow_Call) Return(_a0 error) *FollowService_Follow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowService_Follow_Call) RunAndReturn(run func(context.Context, int64, int64) error) *FollowService_Follow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FollowTarget provides a mock function with given fields: ctx, followerID, target
+func (_m *FollowService) FollowTarget(ctx context.Context, followerID int64, target model.FollowTarget) error {
+	ret := _m.Called(ctx, followerID, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowTarget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowTarget) error); ok {
+		r0 = rf(ctx, followerID, target)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowService_FollowTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FollowTarget'
+type FollowService_FollowTarget_Call struct {
+	*mock.Call
+}
+
+// FollowTarget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - target model.FollowTarget
+func (_e *FollowService_Expecter) FollowTarget(ctx interface{}, followerID interface{}, target interface{}) *FollowService_FollowTarget_Call {
+	return &FollowService_FollowTarget_Call{Call: _e.mock.On("FollowTarget", ctx, followerID, target)}
+}
+
+func (_c *FollowService_FollowTarget_Call) Run(run func(ctx context.Context, followerID int64, target model.FollowTarget)) *FollowService_FollowTarget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.FollowTarget))
+	})
+	return _c
+}
+
+func (_c *FollowService_FollowTarget_Call) Return(_a0 error) *FollowService_FollowTarget_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowService_FollowTarget_Call) RunAndReturn(run func(context.Context, int64, model.FollowTarget) error) *FollowService_FollowTarget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowSourceStats provides a mock function with given fields: ctx, from, to
+func (_m *FollowService) GetFollowSourceStats(ctx context.Context, from time.Time, to time.Time) ([]model.FollowSourceStat, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowSourceStats")
+	}
+
+	var r0 []model.FollowSourceStat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]model.FollowSourceStat, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []model.FollowSourceStat); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.FollowSourceStat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_GetFollowSourceStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowSourceStats'
+type FollowService_GetFollowSourceStats_Call struct {
+	*mock.Call
+}
+
+// GetFollowSourceStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *FollowService_Expecter) GetFollowSourceStats(ctx interface{}, from interface{}, to interface{}) *FollowService_GetFollowSourceStats_Call {
+	return &FollowService_GetFollowSourceStats_Call{Call: _e.mock.On("GetFollowSourceStats", ctx, from, to)}
+}
+
+func (_c *FollowService_GetFollowSourceStats_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *FollowService_GetFollowSourceStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *FollowService_GetFollowSourceStats_Call) Return(_a0 []model.FollowSourceStat, _a1 error) *FollowService_GetFollowSourceStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_GetFollowSourceStats_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]model.FollowSourceStat, error)) *FollowService_GetFollowSourceStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowees provides a mock function with given fields: ctx, followerID, limit, page
+func (_m *FollowService) GetFollowees(ctx context.Context, followerID int64, limit int32, page int32) ([]*model.User, int64, error) {
+	ret := _m.Called(ctx, followerID, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowees")
+	}
+
+	var r0 []*model.User
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]*model.User, int64, error)); ok {
+		return rf(ctx, followerID, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []*model.User); ok {
+		r0 = rf(ctx, followerID, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
+		r1 = rf(ctx, followerID, limit, page)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
+		r2 = rf(ctx, followerID, limit, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowService_GetFollowees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowees'
+type FollowService_GetFollowees_Call struct {
+	*mock.Call
+}
+
+// GetFollowees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - limit int32
+//   - page int32
+func (_e *FollowService_Expecter) GetFollowees(ctx interface{}, followerID interface{}, limit interface{}, page interface{}) *FollowService_GetFollowees_Call {
+	return &FollowService_GetFollowees_Call{Call: _e.mock.On("GetFollowees", ctx, followerID, limit, page)}
+}
+
+func (_c *FollowService_GetFollowees_Call) Run(run func(ctx context.Context, followerID int64, limit int32, page int32)) *FollowService_GetFollowees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_GetFollowees_Call) Return(_a0 []*model.User, _a1 int64, _a2 error) *FollowService_GetFollowees_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowService_GetFollowees_Call) RunAndReturn(run func(context.Context, int64, int32, int32) ([]*model.User, int64, error)) *FollowService_GetFollowees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowerGrowth provides a mock function with given fields: ctx, userID, from, to, granularity
+func (_m *FollowService) GetFollowerGrowth(ctx context.Context, userID int64, from time.Time, to time.Time, granularity model.FollowerGrowthGranularity) ([]model.FollowerGrowthBucket, error) {
+	ret := _m.Called(ctx, userID, from, to, granularity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowerGrowth")
+	}
+
+	var r0 []model.FollowerGrowthBucket
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, model.FollowerGrowthGranularity) ([]model.FollowerGrowthBucket, error)); ok {
+		return rf(ctx, userID, from, to, granularity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time, time.Time, model.FollowerGrowthGranularity) []model.FollowerGrowthBucket); ok {
+		r0 = rf(ctx, userID, from, to, granularity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.FollowerGrowthBucket)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, time.Time, time.Time, model.FollowerGrowthGranularity) error); ok {
+		r1 = rf(ctx, userID, from, to, granularity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_GetFollowerGrowth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowerGrowth'
+type FollowService_GetFollowerGrowth_Call struct {
+	*mock.Call
+}
+
+// GetFollowerGrowth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - from time.Time
+//   - to time.Time
+//   - granularity model.FollowerGrowthGranularity
+func (_e *FollowService_Expecter) GetFollowerGrowth(ctx interface{}, userID interface{}, from interface{}, to interface{}, granularity interface{}) *FollowService_GetFollowerGrowth_Call {
+	return &FollowService_GetFollowerGrowth_Call{Call: _e.mock.On("GetFollowerGrowth", ctx, userID, from, to, granularity)}
+}
+
+func (_c *FollowService_GetFollowerGrowth_Call) Run(run func(ctx context.Context, userID int64, from time.Time, to time.Time, granularity model.FollowerGrowthGranularity)) *FollowService_GetFollowerGrowth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(time.Time), args[3].(time.Time), args[4].(model.FollowerGrowthGranularity))
+	})
+	return _c
+}
+
+func (_c *FollowService_GetFollowerGrowth_Call) Return(_a0 []model.FollowerGrowthBucket, _a1 error) *FollowService_GetFollowerGrowth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_GetFollowerGrowth_Call) RunAndReturn(run func(context.Context, int64, time.Time, time.Time, model.FollowerGrowthGranularity) ([]model.FollowerGrowthBucket, error)) *FollowService_GetFollowerGrowth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowers provides a mock function with given fields: ctx, followeeID, limit, page
+func (_m *FollowService) GetFollowers(ctx context.Context, followeeID int64, limit int32, page int32) ([]*model.User, int64, error) {
+	ret := _m.Called(ctx, followeeID, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowers")
+	}
+
+	var r0 []*model.User
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]*model.User, int64, error)); ok {
+		return rf(ctx, followeeID, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []*model.User); ok {
+		r0 = rf(ctx, followeeID, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
+		r1 = rf(ctx, followeeID, limit, page)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
+		r2 = rf(ctx, followeeID, limit, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowService_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
+type FollowService_GetFollowers_Call struct {
+	*mock.Call
+}
+
+// GetFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followeeID int64
+//   - limit int32
+//   - page int32
+func (_e *FollowService_Expecter) GetFollowers(ctx interface{}, followeeID interface{}, limit interface{}, page interface{}) *FollowService_GetFollowers_Call {
+	return &FollowService_GetFollowers_Call{Call: _e.mock.On("GetFollowers", ctx, followeeID, limit, page)}
+}
+
+func (_c *FollowService_GetFollowers_Call) Run(run func(ctx context.Context, followeeID int64, limit int32, page int32)) *FollowService_GetFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_GetFollowers_Call) Return(_a0 []*model.User, _a1 int64, _a2 error) *FollowService_GetFollowers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowService_GetFollowers_Call) RunAndReturn(run func(context.Context, int64, int32, int32) ([]*model.User, int64, error)) *FollowService_GetFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFollowersYouKnow provides a mock function with given fields: ctx, viewerID, targetID, limit
+func (_m *FollowService) GetFollowersYouKnow(ctx context.Context, viewerID int64, targetID int64, limit int32) ([]*model.User, int64, error) {
+	ret := _m.Called(ctx, viewerID, targetID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFollowersYouKnow")
+	}
+
+	var r0 []*model.User
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32) ([]*model.User, int64, error)); ok {
+		return rf(ctx, viewerID, targetID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32) []*model.User); ok {
+		r0 = rf(ctx, viewerID, targetID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int32) int64); ok {
+		r1 = rf(ctx, viewerID, targetID, limit)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, int32) error); ok {
+		r2 = rf(ctx, viewerID, targetID, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowService_GetFollowersYouKnow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowersYouKnow'
+type FollowService_GetFollowersYouKnow_Call struct {
+	*mock.Call
+}
+
+// GetFollowersYouKnow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerID int64
+//   - targetID int64
+//   - limit int32
+func (_e *FollowService_Expecter) GetFollowersYouKnow(ctx interface{}, viewerID interface{}, targetID interface{}, limit interface{}) *FollowService_GetFollowersYouKnow_Call {
+	return &FollowService_GetFollowersYouKnow_Call{Call: _e.mock.On("GetFollowersYouKnow", ctx, viewerID, targetID, limit)}
+}
+
+func (_c *FollowService_GetFollowersYouKnow_Call) Run(run func(ctx context.Context, viewerID int64, targetID int64, limit int32)) *FollowService_GetFollowersYouKnow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_GetFollowersYouKnow_Call) Return(_a0 []*model.User, _a1 int64, _a2 error) *FollowService_GetFollowersYouKnow_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowService_GetFollowersYouKnow_Call) RunAndReturn(run func(context.Context, int64, int64, int32) ([]*model.User, int64, error)) *FollowService_GetFollowersYouKnow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMutualFollows provides a mock function with given fields: ctx, userID, limit, page
+func (_m *FollowService) GetMutualFollows(ctx context.Context, userID int64, limit int32, page int32) ([]*model.User, int64, error) {
+	ret := _m.Called(ctx, userID, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMutualFollows")
+	}
+
+	var r0 []*model.User
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]*model.User, int64, error)); ok {
+		return rf(ctx, userID, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []*model.User); ok {
+		r0 = rf(ctx, userID, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
+		r1 = rf(ctx, userID, limit, page)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
+		r2 = rf(ctx, userID, limit, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowService_GetMutualFollows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMutualFollows'
+type FollowService_GetMutualFollows_Call struct {
+	*mock.Call
+}
+
+// GetMutualFollows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - limit int32
+//   - page int32
+func (_e *FollowService_Expecter) GetMutualFollows(ctx interface{}, userID interface{}, limit interface{}, page interface{}) *FollowService_GetMutualFollows_Call {
+	return &FollowService_GetMutualFollows_Call{Call: _e.mock.On("GetMutualFollows", ctx, userID, limit, page)}
+}
+
+func (_c *FollowService_GetMutualFollows_Call) Run(run func(ctx context.Context, userID int64, limit int32, page int32)) *FollowService_GetMutualFollows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_GetMutualFollows_Call) Return(_a0 []*model.User, _a1 int64, _a2 error) *FollowService_GetMutualFollows_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowService_GetMutualFollows_Call) RunAndReturn(run func(context.Context, int64, int32, int32) ([]*model.User, int64, error)) *FollowService_GetMutualFollows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRelationHistory provides a mock function with given fields: ctx, userID, otherUserID, limit, page
+func (_m *FollowService) GetRelationHistory(ctx context.Context, userID int64, otherUserID *int64, limit int32, page int32) ([]model.RelationHistoryEntry, int64, error) {
+	ret := _m.Called(ctx, userID, otherUserID, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelationHistory")
+	}
+
+	var r0 []model.RelationHistoryEntry
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int64, int32, int32) ([]model.RelationHistoryEntry, int64, error)); ok {
+		return rf(ctx, userID, otherUserID, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *int64, int32, int32) []model.RelationHistoryEntry); ok {
+		r0 = rf(ctx, userID, otherUserID, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.RelationHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *int64, int32, int32) int64); ok {
+		r1 = rf(ctx, userID, otherUserID, limit, page)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, *int64, int32, int32) error); ok {
+		r2 = rf(ctx, userID, otherUserID, limit, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowService_GetRelationHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRelationHistory'
+type FollowService_GetRelationHistory_Call struct {
+	*mock.Call
+}
+
+// GetRelationHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - otherUserID *int64
+//   - limit int32
+//   - page int32
+func (_e *FollowService_Expecter) GetRelationHistory(ctx interface{}, userID interface{}, otherUserID interface{}, limit interface{}, page interface{}) *FollowService_GetRelationHistory_Call {
+	return &FollowService_GetRelationHistory_Call{Call: _e.mock.On("GetRelationHistory", ctx, userID, otherUserID, limit, page)}
+}
+
+func (_c *FollowService_GetRelationHistory_Call) Run(run func(ctx context.Context, userID int64, otherUserID *int64, limit int32, page int32)) *FollowService_GetRelationHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*int64), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_GetRelationHistory_Call) Return(_a0 []model.RelationHistoryEntry, _a1 int64, _a2 error) *FollowService_GetRelationHistory_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowService_GetRelationHistory_Call) RunAndReturn(run func(context.Context, int64, *int64, int32, int32) ([]model.RelationHistoryEntry, int64, error)) *FollowService_GetRelationHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRelationships provides a mock function with given fields: ctx, viewerID, targetIDs
+func (_m *FollowService) GetRelationships(ctx context.Context, viewerID int64, targetIDs []int64) ([]model.Relationship, error) {
+	ret := _m.Called(ctx, viewerID, targetIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRelationships")
+	}
+
+	var r0 []model.Relationship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]model.Relationship, error)); ok {
+		return rf(ctx, viewerID, targetIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) []model.Relationship); ok {
+		r0 = rf(ctx, viewerID, targetIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Relationship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, viewerID, targetIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_GetRelationships_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRelationships'
+type FollowService_GetRelationships_Call struct {
+	*mock.Call
+}
+
+// GetRelationships is a helper method to define mock.On call
+//   - ctx context.Context
+//   - viewerID int64
+//   - targetIDs []int64
+func (_e *FollowService_Expecter) GetRelationships(ctx interface{}, viewerID interface{}, targetIDs interface{}) *FollowService_GetRelationships_Call {
+	return &FollowService_GetRelationships_Call{Call: _e.mock.On("GetRelationships", ctx, viewerID, targetIDs)}
+}
+
+func (_c *FollowService_GetRelationships_Call) Run(run func(ctx context.Context, viewerID int64, targetIDs []int64)) *FollowService_GetRelationships_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_GetRelationships_Call) Return(_a0 []model.Relationship, _a1 error) *FollowService_GetRelationships_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_GetRelationships_Call) RunAndReturn(run func(context.Context, int64, []int64) ([]model.Relationship, error)) *FollowService_GetRelationships_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSuggestions provides a mock function with given fields: ctx, userID, limit
+func (_m *FollowService) GetSuggestions(ctx context.Context, userID int64, limit int32) ([]*model.SuggestedUser, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSuggestions")
+	}
+
+	var r0 []*model.SuggestedUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32) ([]*model.SuggestedUser, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32) []*model.SuggestedUser); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SuggestedUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int32) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_GetSuggestions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSuggestions'
+type FollowService_GetSuggestions_Call struct {
+	*mock.Call
+}
+
+// GetSuggestions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - limit int32
+func (_e *FollowService_Expecter) GetSuggestions(ctx interface{}, userID interface{}, limit interface{}) *FollowService_GetSuggestions_Call {
+	return &FollowService_GetSuggestions_Call{Call: _e.mock.On("GetSuggestions", ctx, userID, limit)}
+}
+
+func (_c *FollowService_GetSuggestions_Call) Run(run func(ctx context.Context, userID int64, limit int32)) *FollowService_GetSuggestions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_GetSuggestions_Call) Return(_a0 []*model.SuggestedUser, _a1 error) *FollowService_GetSuggestions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_GetSuggestions_Call) RunAndReturn(run func(context.Context, int64, int32) ([]*model.SuggestedUser, error)) *FollowService_GetSuggestions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsAudienceMember provides a mock function with given fields: ctx, ownerID, listID, userID
+func (_m *FollowService) IsAudienceMember(ctx context.Context, ownerID int64, listID int64, userID int64) (bool, error) {
+	ret := _m.Called(ctx, ownerID, listID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAudienceMember")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) (bool, error)); ok {
+		return rf(ctx, ownerID, listID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int64) bool); ok {
+		r0 = rf(ctx, ownerID, listID, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int64) error); ok {
+		r1 = rf(ctx, ownerID, listID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_IsAudienceMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsAudienceMember'
+type FollowService_IsAudienceMember_Call struct {
+	*mock.Call
+}
+
+// IsAudienceMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID int64
+//   - listID int64
+//   - userID int64
+func (_e *FollowService_Expecter) IsAudienceMember(ctx interface{}, ownerID interface{}, listID interface{}, userID interface{}) *FollowService_IsAudienceMember_Call {
+	return &FollowService_IsAudienceMember_Call{Call: _e.mock.On("IsAudienceMember", ctx, ownerID, listID, userID)}
+}
+
+func (_c *FollowService_IsAudienceMember_Call) Run(run func(ctx context.Context, ownerID int64, listID int64, userID int64)) *FollowService_IsAudienceMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_IsAudienceMember_Call) Return(_a0 bool, _a1 error) *FollowService_IsAudienceMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_IsAudienceMember_Call) RunAndReturn(run func(context.Context, int64, int64, int64) (bool, error)) *FollowService_IsAudienceMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsMutual provides a mock function with given fields: ctx, userID, otherUserID
+func (_m *FollowService) IsMutual(ctx context.Context, userID int64, otherUserID int64) (bool, error) {
+	ret := _m.Called(ctx, userID, otherUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsMutual")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) (bool, error)); ok {
+		return rf(ctx, userID, otherUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) bool); ok {
+		r0 = rf(ctx, userID, otherUserID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, userID, otherUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_IsMutual_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsMutual'
+type FollowService_IsMutual_Call struct {
+	*mock.Call
+}
+
+// IsMutual is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - otherUserID int64
+func (_e *FollowService_Expecter) IsMutual(ctx interface{}, userID interface{}, otherUserID interface{}) *FollowService_IsMutual_Call {
+	return &FollowService_IsMutual_Call{Call: _e.mock.On("IsMutual", ctx, userID, otherUserID)}
+}
+
+func (_c *FollowService_IsMutual_Call) Run(run func(ctx context.Context, userID int64, otherUserID int64)) *FollowService_IsMutual_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_IsMutual_Call) Return(_a0 bool, _a1 error) *FollowService_IsMutual_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_IsMutual_Call) RunAndReturn(run func(context.Context, int64, int64) (bool, error)) *FollowService_IsMutual_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAudienceLists provides a mock function with given fields: ctx, ownerID
+func (_m *FollowService) ListAudienceLists(ctx context.Context, ownerID int64) ([]model.AudienceList, error) {
+	ret := _m.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAudienceLists")
+	}
+
+	var r0 []model.AudienceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]model.AudienceList, error)); ok {
+		return rf(ctx, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []model.AudienceList); ok {
+		r0 = rf(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AudienceList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_ListAudienceLists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAudienceLists'
+type FollowService_ListAudienceLists_Call struct {
+	*mock.Call
+}
+
+// ListAudienceLists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID int64
+func (_e *FollowService_Expecter) ListAudienceLists(ctx interface{}, ownerID interface{}) *FollowService_ListAudienceLists_Call {
+	return &FollowService_ListAudienceLists_Call{Call: _e.mock.On("ListAudienceLists", ctx, ownerID)}
+}
+
+func (_c *FollowService_ListAudienceLists_Call) Run(run func(ctx context.Context, ownerID int64)) *FollowService_ListAudienceLists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_ListAudienceLists_Call) Return(_a0 []model.AudienceList, _a1 error) *FollowService_ListAudienceLists_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_ListAudienceLists_Call) RunAndReturn(run func(context.Context, int64) ([]model.AudienceList, error)) *FollowService_ListAudienceLists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAudienceMembers provides a mock function with given fields: ctx, ownerID, listID, limit, page
+func (_m *FollowService) ListAudienceMembers(ctx context.Context, ownerID int64, listID int64, limit int32, page int32) ([]int64, int64, error) {
+	ret := _m.Called(ctx, ownerID, listID, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAudienceMembers")
+	}
+
+	var r0 []int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32, int32) ([]int64, int64, error)); ok {
+		return rf(ctx, ownerID, listID, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32, int32) []int64); ok {
+		r0 = rf(ctx, ownerID, listID, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, int32, int32) int64); ok {
+		r1 = rf(ctx, ownerID, listID, limit, page)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, int32, int32) error); ok {
+		r2 = rf(ctx, ownerID, listID, limit, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowService_ListAudienceMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAudienceMembers'
+type FollowService_ListAudienceMembers_Call struct {
+	*mock.Call
+}
+
+// ListAudienceMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID int64
+//   - listID int64
+//   - limit int32
+//   - page int32
+func (_e *FollowService_Expecter) ListAudienceMembers(ctx interface{}, ownerID interface{}, listID interface{}, limit interface{}, page interface{}) *FollowService_ListAudienceMembers_Call {
+	return &FollowService_ListAudienceMembers_Call{Call: _e.mock.On("ListAudienceMembers", ctx, ownerID, listID, limit, page)}
+}
+
+func (_c *FollowService_ListAudienceMembers_Call) Run(run func(ctx context.Context, ownerID int64, listID int64, limit int32, page int32)) *FollowService_ListAudienceMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_ListAudienceMembers_Call) Return(_a0 []int64, _a1 int64, _a2 error) *FollowService_ListAudienceMembers_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowService_ListAudienceMembers_Call) RunAndReturn(run func(context.Context, int64, int64, int32, int32) ([]int64, int64, error)) *FollowService_ListAudienceMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFollowedTargets provides a mock function with given fields: ctx, followerID, targetType, limit, page
+func (_m *FollowService) ListFollowedTargets(ctx context.Context, followerID int64, targetType model.TargetType, limit int32, page int32) ([]int64, int64, error) {
+	ret := _m.Called(ctx, followerID, targetType, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowedTargets")
+	}
+
+	var r0 []int64
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TargetType, int32, int32) ([]int64, int64, error)); ok {
+		return rf(ctx, followerID, targetType, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.TargetType, int32, int32) []int64); ok {
+		r0 = rf(ctx, followerID, targetType, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.TargetType, int32, int32) int64); ok {
+		r1 = rf(ctx, followerID, targetType, limit, page)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, model.TargetType, int32, int32) error); ok {
+		r2 = rf(ctx, followerID, targetType, limit, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowService_ListFollowedTargets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollowedTargets'
+type FollowService_ListFollowedTargets_Call struct {
+	*mock.Call
+}
+
+// ListFollowedTargets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - targetType model.TargetType
+//   - limit int32
+//   - page int32
+func (_e *FollowService_Expecter) ListFollowedTargets(ctx interface{}, followerID interface{}, targetType interface{}, limit interface{}, page interface{}) *FollowService_ListFollowedTargets_Call {
+	return &FollowService_ListFollowedTargets_Call{Call: _e.mock.On("ListFollowedTargets", ctx, followerID, targetType, limit, page)}
+}
+
+func (_c *FollowService_ListFollowedTargets_Call) Run(run func(ctx context.Context, followerID int64, targetType model.TargetType, limit int32, page int32)) *FollowService_ListFollowedTargets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.TargetType), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_ListFollowedTargets_Call) Return(_a0 []int64, _a1 int64, _a2 error) *FollowService_ListFollowedTargets_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowService_ListFollowedTargets_Call) RunAndReturn(run func(context.Context, int64, model.TargetType, int32, int32) ([]int64, int64, error)) *FollowService_ListFollowedTargets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFollowees provides a mock function with given fields: ctx, followerID, opts, limit, page
+func (_m *FollowService) ListFollowees(ctx context.Context, followerID int64, opts model.FollowListOptions, limit int32, page int32) (*model.FollowList, error) {
+	ret := _m.Called(ctx, followerID, opts, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowees")
+	}
+
+	var r0 *model.FollowList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowListOptions, int32, int32) (*model.FollowList, error)); ok {
+		return rf(ctx, followerID, opts, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowListOptions, int32, int32) *model.FollowList); ok {
+		r0 = rf(ctx, followerID, opts, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FollowList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.FollowListOptions, int32, int32) error); ok {
+		r1 = rf(ctx, followerID, opts, limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_ListFollowees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollowees'
+type FollowService_ListFollowees_Call struct {
+	*mock.Call
+}
+
+// ListFollowees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - opts model.FollowListOptions
+//   - limit int32
+//   - page int32
+func (_e *FollowService_Expecter) ListFollowees(ctx interface{}, followerID interface{}, opts interface{}, limit interface{}, page interface{}) *FollowService_ListFollowees_Call {
+	return &FollowService_ListFollowees_Call{Call: _e.mock.On("ListFollowees", ctx, followerID, opts, limit, page)}
+}
+
+func (_c *FollowService_ListFollowees_Call) Run(run func(ctx context.Context, followerID int64, opts model.FollowListOptions, limit int32, page int32)) *FollowService_ListFollowees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.FollowListOptions), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_ListFollowees_Call) Return(_a0 *model.FollowList, _a1 error) *FollowService_ListFollowees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_ListFollowees_Call) RunAndReturn(run func(context.Context, int64, model.FollowListOptions, int32, int32) (*model.FollowList, error)) *FollowService_ListFollowees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFollowers provides a mock function with given fields: ctx, followeeID, opts, limit, page
+func (_m *FollowService) ListFollowers(ctx context.Context, followeeID int64, opts model.FollowListOptions, limit int32, page int32) (*model.FollowList, error) {
+	ret := _m.Called(ctx, followeeID, opts, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowers")
+	}
+
+	var r0 *model.FollowList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowListOptions, int32, int32) (*model.FollowList, error)); ok {
+		return rf(ctx, followeeID, opts, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowListOptions, int32, int32) *model.FollowList); ok {
+		r0 = rf(ctx, followeeID, opts, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FollowList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, model.FollowListOptions, int32, int32) error); ok {
+		r1 = rf(ctx, followeeID, opts, limit, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_ListFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFollowers'
+type FollowService_ListFollowers_Call struct {
+	*mock.Call
+}
+
+// ListFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followeeID int64
+//   - opts model.FollowListOptions
+//   - limit int32
+//   - page int32
+func (_e *FollowService_Expecter) ListFollowers(ctx interface{}, followeeID interface{}, opts interface{}, limit interface{}, page interface{}) *FollowService_ListFollowers_Call {
+	return &FollowService_ListFollowers_Call{Call: _e.mock.On("ListFollowers", ctx, followeeID, opts, limit, page)}
+}
+
+func (_c *FollowService_ListFollowers_Call) Run(run func(ctx context.Context, followeeID int64, opts model.FollowListOptions, limit int32, page int32)) *FollowService_ListFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.FollowListOptions), args[3].(int32), args[4].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_ListFollowers_Call) Return(_a0 *model.FollowList, _a1 error) *FollowService_ListFollowers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_ListFollowers_Call) RunAndReturn(run func(context.Context, int64, model.FollowListOptions, int32, int32) (*model.FollowList, error)) *FollowService_ListFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMuted provides a mock function with given fields: ctx, followerID, limit, page
+func (_m *FollowService) ListMuted(ctx context.Context, followerID int64, limit int32, page int32) ([]model.Mute, int64, error) {
+	ret := _m.Called(ctx, followerID, limit, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMuted")
+	}
+
+	var r0 []model.Mute
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]model.Mute, int64, error)); ok {
+		return rf(ctx, followerID, limit, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []model.Mute); ok {
+		r0 = rf(ctx, followerID, limit, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Mute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
+		r1 = rf(ctx, followerID, limit, page)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
+		r2 = rf(ctx, followerID, limit, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// FollowService_ListMuted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMuted'
+type FollowService_ListMuted_Call struct {
+	*mock.Call
+}
+
+// ListMuted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - limit int32
+//   - page int32
+func (_e *FollowService_Expecter) ListMuted(ctx interface{}, followerID interface{}, limit interface{}, page interface{}) *FollowService_ListMuted_Call {
+	return &FollowService_ListMuted_Call{Call: _e.mock.On("ListMuted", ctx, followerID, limit, page)}
+}
+
+func (_c *FollowService_ListMuted_Call) Run(run func(ctx context.Context, followerID int64, limit int32, page int32)) *FollowService_ListMuted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int32), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_ListMuted_Call) Return(_a0 []model.Mute, _a1 int64, _a2 error) *FollowService_ListMuted_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *FollowService_ListMuted_Call) RunAndReturn(run func(context.Context, int64, int32, int32) ([]model.Mute, int64, error)) *FollowService_ListMuted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mute provides a mock function with given fields: ctx, followerID, followeeID, expiresAt
+func (_m *FollowService) Mute(ctx context.Context, followerID int64, followeeID int64, expiresAt *time.Time) (model.Mute, error) {
+	ret := _m.Called(ctx, followerID, followeeID, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mute")
+	}
+
+	var r0 model.Mute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *time.Time) (model.Mute, error)); ok {
+		return rf(ctx, followerID, followeeID, expiresAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, *time.Time) model.Mute); ok {
+		r0 = rf(ctx, followerID, followeeID, expiresAt)
+	} else {
+		r0 = ret.Get(0).(model.Mute)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, *time.Time) error); ok {
+		r1 = rf(ctx, followerID, followeeID, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_Mute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mute'
+type FollowService_Mute_Call struct {
+	*mock.Call
+}
+
+// Mute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - followeeID int64
+//   - expiresAt *time.Time
+func (_e *FollowService_Expecter) Mute(ctx interface{}, followerID interface{}, followeeID interface{}, expiresAt interface{}) *FollowService_Mute_Call {
+	return &FollowService_Mute_Call{Call: _e.mock.On("Mute", ctx, followerID, followeeID, expiresAt)}
+}
+
+func (_c *FollowService_Mute_Call) Run(run func(ctx context.Context, followerID int64, followeeID int64, expiresAt *time.Time)) *FollowService_Mute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *FollowService_Mute_Call) Return(_a0 model.Mute, _a1 error) *FollowService_Mute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_Mute_Call) RunAndReturn(run func(context.Context, int64, int64, *time.Time) (model.Mute, error)) *FollowService_Mute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAudienceMembers provides a mock function with given fields: ctx, ownerID, listID, memberIDs
+func (_m *FollowService) RemoveAudienceMembers(ctx context.Context, ownerID int64, listID int64, memberIDs []int64) ([]int64, error) {
+	ret := _m.Called(ctx, ownerID, listID, memberIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAudienceMembers")
+	}
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64) ([]int64, error)); ok {
+		return rf(ctx, ownerID, listID, memberIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []int64) []int64); ok {
+		r0 = rf(ctx, ownerID, listID, memberIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []int64) error); ok {
+		r1 = rf(ctx, ownerID, listID, memberIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowService_RemoveAudienceMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAudienceMembers'
+type FollowService_RemoveAudienceMembers_Call struct {
+	*mock.Call
+}
+
+// RemoveAudienceMembers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID int64
+//   - listID int64
+//   - memberIDs []int64
+func (_e *FollowService_Expecter) RemoveAudienceMembers(ctx interface{}, ownerID interface{}, listID interface{}, memberIDs interface{}) *FollowService_RemoveAudienceMembers_Call {
+	return &FollowService_RemoveAudienceMembers_Call{Call: _e.mock.On("RemoveAudienceMembers", ctx, ownerID, listID, memberIDs)}
+}
+
+func (_c *FollowService_RemoveAudienceMembers_Call) Run(run func(ctx context.Context, ownerID int64, listID int64, memberIDs []int64)) *FollowService_RemoveAudienceMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_RemoveAudienceMembers_Call) Return(_a0 []int64, _a1 error) *FollowService_RemoveAudienceMembers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_RemoveAudienceMembers_Call) RunAndReturn(run func(context.Context, int64, int64, []int64) ([]int64, error)) *FollowService_RemoveAudienceMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFollower provides a mock function with given fields: ctx, ownerID, followerID
+func (_m *FollowService) RemoveFollower(ctx context.Context, ownerID int64, followerID int64) error {
+	ret := _m.Called(ctx, ownerID, followerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFollower")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
-		r0 = rf(ctx, followerID, followeeID)
+		r0 = rf(ctx, ownerID, followerID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -40,168 +1693,323 @@ func (_m *FollowService) Follow(ctx context.Context, followerID int64, followeeI
 	return r0
 }
 
-// FollowService_Follow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Follow'
-type FollowService_Follow_Call struct {
+// FollowService_RemoveFollower_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFollower'
+type FollowService_RemoveFollower_Call struct {
 	*mock.Call
 }
 
-// Follow is a helper method to define mock.On call
+// RemoveFollower is a helper method to define mock.On call
 //   - ctx context.Context
+//   - ownerID int64
 //   - followerID int64
-//   - followeeID int64
-func (_e *FollowService_Expecter) Follow(ctx interface{}, followerID interface{}, followeeID interface{}) *FollowService_Follow_Call {
-	return &FollowService_Follow_Call{Call: _e.mock.On("Follow", ctx, followerID, followeeID)}
+func (_e *FollowService_Expecter) RemoveFollower(ctx interface{}, ownerID interface{}, followerID interface{}) *FollowService_RemoveFollower_Call {
+	return &FollowService_RemoveFollower_Call{Call: _e.mock.On("RemoveFollower", ctx, ownerID, followerID)}
 }
 
-func (_c *FollowService_Follow_Call) Run(run func(ctx context.Context, followerID int64, followeeID int64)) *FollowService_Follow_Call {
+func (_c *FollowService_RemoveFollower_Call) Run(run func(ctx context.Context, ownerID int64, followerID int64)) *FollowService_RemoveFollower_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *FollowService_Follow_Call) Return(_a0 error) *FollowService_Follow_Call {
+func (_c *FollowService_RemoveFollower_Call) Return(_a0 error) *FollowService_RemoveFollower_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *FollowService_Follow_Call) RunAndReturn(run func(context.Context, int64, int64) error) *FollowService_Follow_Call {
+func (_c *FollowService_RemoveFollower_Call) RunAndReturn(run func(context.Context, int64, int64) error) *FollowService_RemoveFollower_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFollowees provides a mock function with given fields: ctx, followerID, limit, page
-func (_m *FollowService) GetFollowees(ctx context.Context, followerID int64, limit int32, page int32) ([]*model.User, int64, error) {
-	ret := _m.Called(ctx, followerID, limit, page)
+// RemoveFollowers provides a mock function with given fields: ctx, ownerID, followerIDs
+func (_m *FollowService) RemoveFollowers(ctx context.Context, ownerID int64, followerIDs []int64) ([]int64, error) {
+	ret := _m.Called(ctx, ownerID, followerIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowees")
+		panic("no return value specified for RemoveFollowers")
 	}
 
-	var r0 []*model.User
-	var r1 int64
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]*model.User, int64, error)); ok {
-		return rf(ctx, followerID, limit, page)
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) ([]int64, error)); ok {
+		return rf(ctx, ownerID, followerIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []*model.User); ok {
-		r0 = rf(ctx, followerID, limit, page)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []int64) []int64); ok {
+		r0 = rf(ctx, ownerID, followerIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.User)
+			r0 = ret.Get(0).([]int64)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
-		r1 = rf(ctx, followerID, limit, page)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, []int64) error); ok {
+		r1 = rf(ctx, ownerID, followerIDs)
 	} else {
-		r1 = ret.Get(1).(int64)
+		r1 = ret.Error(1)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
-		r2 = rf(ctx, followerID, limit, page)
+	return r0, r1
+}
+
+// FollowService_RemoveFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFollowers'
+type FollowService_RemoveFollowers_Call struct {
+	*mock.Call
+}
+
+// RemoveFollowers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID int64
+//   - followerIDs []int64
+func (_e *FollowService_Expecter) RemoveFollowers(ctx interface{}, ownerID interface{}, followerIDs interface{}) *FollowService_RemoveFollowers_Call {
+	return &FollowService_RemoveFollowers_Call{Call: _e.mock.On("RemoveFollowers", ctx, ownerID, followerIDs)}
+}
+
+func (_c *FollowService_RemoveFollowers_Call) Run(run func(ctx context.Context, ownerID int64, followerIDs []int64)) *FollowService_RemoveFollowers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_RemoveFollowers_Call) Return(_a0 []int64, _a1 error) *FollowService_RemoveFollowers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_RemoveFollowers_Call) RunAndReturn(run func(context.Context, int64, []int64) ([]int64, error)) *FollowService_RemoveFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameAudienceList provides a mock function with given fields: ctx, ownerID, listID, name
+func (_m *FollowService) RenameAudienceList(ctx context.Context, ownerID int64, listID int64, name string) (model.AudienceList, error) {
+	ret := _m.Called(ctx, ownerID, listID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameAudienceList")
+	}
+
+	var r0 model.AudienceList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) (model.AudienceList, error)); ok {
+		return rf(ctx, ownerID, listID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string) model.AudienceList); ok {
+		r0 = rf(ctx, ownerID, listID, name)
 	} else {
-		r2 = ret.Error(2)
+		r0 = ret.Get(0).(model.AudienceList)
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, string) error); ok {
+		r1 = rf(ctx, ownerID, listID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// FollowService_GetFollowees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowees'
-type FollowService_GetFollowees_Call struct {
+// FollowService_RenameAudienceList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameAudienceList'
+type FollowService_RenameAudienceList_Call struct {
 	*mock.Call
 }
 
-// GetFollowees is a helper method to define mock.On call
+// RenameAudienceList is a helper method to define mock.On call
 //   - ctx context.Context
-//   - followerID int64
-//   - limit int32
-//   - page int32
-func (_e *FollowService_Expecter) GetFollowees(ctx interface{}, followerID interface{}, limit interface{}, page interface{}) *FollowService_GetFollowees_Call {
-	return &FollowService_GetFollowees_Call{Call: _e.mock.On("GetFollowees", ctx, followerID, limit, page)}
+//   - ownerID int64
+//   - listID int64
+//   - name string
+func (_e *FollowService_Expecter) RenameAudienceList(ctx interface{}, ownerID interface{}, listID interface{}, name interface{}) *FollowService_RenameAudienceList_Call {
+	return &FollowService_RenameAudienceList_Call{Call: _e.mock.On("RenameAudienceList", ctx, ownerID, listID, name)}
 }
 
-func (_c *FollowService_GetFollowees_Call) Run(run func(ctx context.Context, followerID int64, limit int32, page int32)) *FollowService_GetFollowees_Call {
+func (_c *FollowService_RenameAudienceList_Call) Run(run func(ctx context.Context, ownerID int64, listID int64, name string)) *FollowService_RenameAudienceList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int32), args[3].(int32))
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *FollowService_GetFollowees_Call) Return(_a0 []*model.User, _a1 int64, _a2 error) *FollowService_GetFollowees_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *FollowService_RenameAudienceList_Call) Return(_a0 model.AudienceList, _a1 error) *FollowService_RenameAudienceList_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *FollowService_GetFollowees_Call) RunAndReturn(run func(context.Context, int64, int32, int32) ([]*model.User, int64, error)) *FollowService_GetFollowees_Call {
+func (_c *FollowService_RenameAudienceList_Call) RunAndReturn(run func(context.Context, int64, int64, string) (model.AudienceList, error)) *FollowService_RenameAudienceList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFollowers provides a mock function with given fields: ctx, followeeID, limit, page
-func (_m *FollowService) GetFollowers(ctx context.Context, followeeID int64, limit int32, page int32) ([]*model.User, int64, error) {
-	ret := _m.Called(ctx, followeeID, limit, page)
+// SearchFollowees provides a mock function with given fields: ctx, userID, query, limit
+func (_m *FollowService) SearchFollowees(ctx context.Context, userID int64, query string, limit int32) ([]*model.ProfileMatch, error) {
+	ret := _m.Called(ctx, userID, query, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFollowers")
+		panic("no return value specified for SearchFollowees")
 	}
 
-	var r0 []*model.User
-	var r1 int64
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) ([]*model.User, int64, error)); ok {
-		return rf(ctx, followeeID, limit, page)
+	var r0 []*model.ProfileMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int32) ([]*model.ProfileMatch, error)); ok {
+		return rf(ctx, userID, query, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64, int32, int32) []*model.User); ok {
-		r0 = rf(ctx, followeeID, limit, page)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int32) []*model.ProfileMatch); ok {
+		r0 = rf(ctx, userID, query, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.User)
+			r0 = ret.Get(0).([]*model.ProfileMatch)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64, int32, int32) int64); ok {
-		r1 = rf(ctx, followeeID, limit, page)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int32) error); ok {
+		r1 = rf(ctx, userID, query, limit)
 	} else {
-		r1 = ret.Get(1).(int64)
+		r1 = ret.Error(1)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, int64, int32, int32) error); ok {
-		r2 = rf(ctx, followeeID, limit, page)
+	return r0, r1
+}
+
+// FollowService_SearchFollowees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchFollowees'
+type FollowService_SearchFollowees_Call struct {
+	*mock.Call
+}
+
+// SearchFollowees is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - query string
+//   - limit int32
+func (_e *FollowService_Expecter) SearchFollowees(ctx interface{}, userID interface{}, query interface{}, limit interface{}) *FollowService_SearchFollowees_Call {
+	return &FollowService_SearchFollowees_Call{Call: _e.mock.On("SearchFollowees", ctx, userID, query, limit)}
+}
+
+func (_c *FollowService_SearchFollowees_Call) Run(run func(ctx context.Context, userID int64, query string, limit int32)) *FollowService_SearchFollowees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *FollowService_SearchFollowees_Call) Return(_a0 []*model.ProfileMatch, _a1 error) *FollowService_SearchFollowees_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FollowService_SearchFollowees_Call) RunAndReturn(run func(context.Context, int64, string, int32) ([]*model.ProfileMatch, error)) *FollowService_SearchFollowees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchFollowers provides a mock function with given fields: ctx, userID, query, limit
+func (_m *FollowService) SearchFollowers(ctx context.Context, userID int64, query string, limit int32) ([]*model.ProfileMatch, error) {
+	ret := _m.Called(ctx, userID, query, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchFollowers")
+	}
+
+	var r0 []*model.ProfileMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int32) ([]*model.ProfileMatch, error)); ok {
+		return rf(ctx, userID, query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int32) []*model.ProfileMatch); ok {
+		r0 = rf(ctx, userID, query, limit)
 	} else {
-		r2 = ret.Error(2)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ProfileMatch)
+		}
 	}
 
-	return r0, r1, r2
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, int32) error); ok {
+		r1 = rf(ctx, userID, query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// FollowService_GetFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFollowers'
-type FollowService_GetFollowers_Call struct {
+// FollowService_SearchFollowers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchFollowers'
+type FollowService_SearchFollowers_Call struct {
 	*mock.Call
 }
 
-// GetFollowers is a helper method to define mock.On call
+// SearchFollowers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - followeeID int64
+//   - userID int64
+//   - query string
 //   - limit int32
-//   - page int32
-func (_e *FollowService_Expecter) GetFollowers(ctx interface{}, followeeID interface{}, limit interface{}, page interface{}) *FollowService_GetFollowers_Call {
-	return &FollowService_GetFollowers_Call{Call: _e.mock.On("GetFollowers", ctx, followeeID, limit, page)}
+func (_e *FollowService_Expecter) SearchFollowers(ctx interface{}, userID interface{}, query interface{}, limit interface{}) *FollowService_SearchFollowers_Call {
+	return &FollowService_SearchFollowers_Call{Call: _e.mock.On("SearchFollowers", ctx, userID, query, limit)}
 }
 
-func (_c *FollowService_GetFollowers_Call) Run(run func(ctx context.Context, followeeID int64, limit int32, page int32)) *FollowService_GetFollowers_Call {
+func (_c *FollowService_SearchFollowers_Call) Run(run func(ctx context.Context, userID int64, query string, limit int32)) *FollowService_SearchFollowers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(int32), args[3].(int32))
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int32))
 	})
 	return _c
 }
 
-func (_c *FollowService_GetFollowers_Call) Return(_a0 []*model.User, _a1 int64, _a2 error) *FollowService_GetFollowers_Call {
-	_c.Call.Return(_a0, _a1, _a2)
+func (_c *FollowService_SearchFollowers_Call) Return(_a0 []*model.ProfileMatch, _a1 error) *FollowService_SearchFollowers_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *FollowService_GetFollowers_Call) RunAndReturn(run func(context.Context, int64, int32, int32) ([]*model.User, int64, error)) *FollowService_GetFollowers_Call {
+func (_c *FollowService_SearchFollowers_Call) RunAndReturn(run func(context.Context, int64, string, int32) ([]*model.ProfileMatch, error)) *FollowService_SearchFollowers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamFollowerIDs provides a mock function with given fields: ctx, userID, cursor, chunkSize, send
+func (_m *FollowService) StreamFollowerIDs(ctx context.Context, userID int64, cursor int64, chunkSize int32, send func(followerIDs []int64, nextCursor int64) error) error {
+	ret := _m.Called(ctx, userID, cursor, chunkSize, send)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamFollowerIDs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, int32, func(followerIDs []int64, nextCursor int64) error) error); ok {
+		r0 = rf(ctx, userID, cursor, chunkSize, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowService_StreamFollowerIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamFollowerIDs'
+type FollowService_StreamFollowerIDs_Call struct {
+	*mock.Call
+}
+
+// StreamFollowerIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int64
+//   - cursor int64
+//   - chunkSize int32
+//   - send func(followerIDs []int64, nextCursor int64) error
+func (_e *FollowService_Expecter) StreamFollowerIDs(ctx interface{}, userID interface{}, cursor interface{}, chunkSize interface{}, send interface{}) *FollowService_StreamFollowerIDs_Call {
+	return &FollowService_StreamFollowerIDs_Call{Call: _e.mock.On("StreamFollowerIDs", ctx, userID, cursor, chunkSize, send)}
+}
+
+func (_c *FollowService_StreamFollowerIDs_Call) Run(run func(ctx context.Context, userID int64, cursor int64, chunkSize int32, send func(followerIDs []int64, nextCursor int64) error)) *FollowService_StreamFollowerIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(int32), args[4].(func(followerIDs []int64, nextCursor int64) error))
+	})
+	return _c
+}
+
+func (_c *FollowService_StreamFollowerIDs_Call) Return(_a0 error) *FollowService_StreamFollowerIDs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowService_StreamFollowerIDs_Call) RunAndReturn(run func(context.Context, int64, int64, int32, func(followerIDs []int64, nextCursor int64) error) error) *FollowService_StreamFollowerIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -254,6 +2062,102 @@ func (_c *FollowService_Unfollow_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
+// UnfollowTarget provides a mock function with given fields: ctx, followerID, target
+func (_m *FollowService) UnfollowTarget(ctx context.Context, followerID int64, target model.FollowTarget) error {
+	ret := _m.Called(ctx, followerID, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnfollowTarget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, model.FollowTarget) error); ok {
+		r0 = rf(ctx, followerID, target)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowService_UnfollowTarget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfollowTarget'
+type FollowService_UnfollowTarget_Call struct {
+	*mock.Call
+}
+
+// UnfollowTarget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - target model.FollowTarget
+func (_e *FollowService_Expecter) UnfollowTarget(ctx interface{}, followerID interface{}, target interface{}) *FollowService_UnfollowTarget_Call {
+	return &FollowService_UnfollowTarget_Call{Call: _e.mock.On("UnfollowTarget", ctx, followerID, target)}
+}
+
+func (_c *FollowService_UnfollowTarget_Call) Run(run func(ctx context.Context, followerID int64, target model.FollowTarget)) *FollowService_UnfollowTarget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(model.FollowTarget))
+	})
+	return _c
+}
+
+func (_c *FollowService_UnfollowTarget_Call) Return(_a0 error) *FollowService_UnfollowTarget_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowService_UnfollowTarget_Call) RunAndReturn(run func(context.Context, int64, model.FollowTarget) error) *FollowService_UnfollowTarget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmute provides a mock function with given fields: ctx, followerID, followeeID
+func (_m *FollowService) Unmute(ctx context.Context, followerID int64, followeeID int64) error {
+	ret := _m.Called(ctx, followerID, followeeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, followerID, followeeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FollowService_Unmute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmute'
+type FollowService_Unmute_Call struct {
+	*mock.Call
+}
+
+// Unmute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - followerID int64
+//   - followeeID int64
+func (_e *FollowService_Expecter) Unmute(ctx interface{}, followerID interface{}, followeeID interface{}) *FollowService_Unmute_Call {
+	return &FollowService_Unmute_Call{Call: _e.mock.On("Unmute", ctx, followerID, followeeID)}
+}
+
+func (_c *FollowService_Unmute_Call) Run(run func(ctx context.Context, followerID int64, followeeID int64)) *FollowService_Unmute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *FollowService_Unmute_Call) Return(_a0 error) *FollowService_Unmute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FollowService_Unmute_Call) RunAndReturn(run func(context.Context, int64, int64) error) *FollowService_Unmute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewFollowService creates a new instance of FollowService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewFollowService(t interface {
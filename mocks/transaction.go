@@ -5,10 +5,9 @@ package mocks
 import (
 	context "context"
 	outbox "pinstack-relation-service/internal/domain/ports/output/outbox"
+	repository "pinstack-relation-service/internal/domain/ports/output/repository"
 
 	mock "github.com/stretchr/testify/mock"
-
-	repository "pinstack-relation-service/internal/domain/ports/output/repository"
 )
 
 // Transaction is an autogenerated mock type for the Transaction type
@@ -24,6 +23,53 @@ func (_m *Transaction) EXPECT() *Transaction_Expecter {
 	return &Transaction_Expecter{mock: &_m.Mock}
 }
 
+// AudienceListRepository provides a mock function with no fields
+func (_m *Transaction) AudienceListRepository() repository.AudienceListRepository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AudienceListRepository")
+	}
+
+	var r0 repository.AudienceListRepository
+	if rf, ok := ret.Get(0).(func() repository.AudienceListRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.AudienceListRepository)
+		}
+	}
+
+	return r0
+}
+
+// Transaction_AudienceListRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AudienceListRepository'
+type Transaction_AudienceListRepository_Call struct {
+	*mock.Call
+}
+
+// AudienceListRepository is a helper method to define mock.On call
+func (_e *Transaction_Expecter) AudienceListRepository() *Transaction_AudienceListRepository_Call {
+	return &Transaction_AudienceListRepository_Call{Call: _e.mock.On("AudienceListRepository")}
+}
+
+func (_c *Transaction_AudienceListRepository_Call) Run(run func()) *Transaction_AudienceListRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Transaction_AudienceListRepository_Call) Return(_a0 repository.AudienceListRepository) *Transaction_AudienceListRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transaction_AudienceListRepository_Call) RunAndReturn(run func() repository.AudienceListRepository) *Transaction_AudienceListRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Commit provides a mock function with given fields: ctx
 func (_m *Transaction) Commit(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -70,6 +116,53 @@ func (_c *Transaction_Commit_Call) RunAndReturn(run func(context.Context) error)
 	return _c
 }
 
+// FollowActionRepository provides a mock function with no fields
+func (_m *Transaction) FollowActionRepository() repository.FollowActionRepository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FollowActionRepository")
+	}
+
+	var r0 repository.FollowActionRepository
+	if rf, ok := ret.Get(0).(func() repository.FollowActionRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.FollowActionRepository)
+		}
+	}
+
+	return r0
+}
+
+// Transaction_FollowActionRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FollowActionRepository'
+type Transaction_FollowActionRepository_Call struct {
+	*mock.Call
+}
+
+// FollowActionRepository is a helper method to define mock.On call
+func (_e *Transaction_Expecter) FollowActionRepository() *Transaction_FollowActionRepository_Call {
+	return &Transaction_FollowActionRepository_Call{Call: _e.mock.On("FollowActionRepository")}
+}
+
+func (_c *Transaction_FollowActionRepository_Call) Run(run func()) *Transaction_FollowActionRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Transaction_FollowActionRepository_Call) Return(_a0 repository.FollowActionRepository) *Transaction_FollowActionRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transaction_FollowActionRepository_Call) RunAndReturn(run func() repository.FollowActionRepository) *Transaction_FollowActionRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FollowRepository provides a mock function with no fields
 func (_m *Transaction) FollowRepository() repository.FollowRepository {
 	ret := _m.Called()
@@ -117,6 +210,100 @@ func (_c *Transaction_FollowRepository_Call) RunAndReturn(run func() repository.
 	return _c
 }
 
+// IdempotencyRepository provides a mock function with no fields
+func (_m *Transaction) IdempotencyRepository() repository.IdempotencyRepository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IdempotencyRepository")
+	}
+
+	var r0 repository.IdempotencyRepository
+	if rf, ok := ret.Get(0).(func() repository.IdempotencyRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.IdempotencyRepository)
+		}
+	}
+
+	return r0
+}
+
+// Transaction_IdempotencyRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IdempotencyRepository'
+type Transaction_IdempotencyRepository_Call struct {
+	*mock.Call
+}
+
+// IdempotencyRepository is a helper method to define mock.On call
+func (_e *Transaction_Expecter) IdempotencyRepository() *Transaction_IdempotencyRepository_Call {
+	return &Transaction_IdempotencyRepository_Call{Call: _e.mock.On("IdempotencyRepository")}
+}
+
+func (_c *Transaction_IdempotencyRepository_Call) Run(run func()) *Transaction_IdempotencyRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Transaction_IdempotencyRepository_Call) Return(_a0 repository.IdempotencyRepository) *Transaction_IdempotencyRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transaction_IdempotencyRepository_Call) RunAndReturn(run func() repository.IdempotencyRepository) *Transaction_IdempotencyRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MuteRepository provides a mock function with no fields
+func (_m *Transaction) MuteRepository() repository.MuteRepository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MuteRepository")
+	}
+
+	var r0 repository.MuteRepository
+	if rf, ok := ret.Get(0).(func() repository.MuteRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.MuteRepository)
+		}
+	}
+
+	return r0
+}
+
+// Transaction_MuteRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MuteRepository'
+type Transaction_MuteRepository_Call struct {
+	*mock.Call
+}
+
+// MuteRepository is a helper method to define mock.On call
+func (_e *Transaction_Expecter) MuteRepository() *Transaction_MuteRepository_Call {
+	return &Transaction_MuteRepository_Call{Call: _e.mock.On("MuteRepository")}
+}
+
+func (_c *Transaction_MuteRepository_Call) Run(run func()) *Transaction_MuteRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Transaction_MuteRepository_Call) Return(_a0 repository.MuteRepository) *Transaction_MuteRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transaction_MuteRepository_Call) RunAndReturn(run func() repository.MuteRepository) *Transaction_MuteRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // OutboxRepository provides a mock function with no fields
 func (_m *Transaction) OutboxRepository() outbox.OutboxRepository {
 	ret := _m.Called()
@@ -164,6 +351,53 @@ func (_c *Transaction_OutboxRepository_Call) RunAndReturn(run func() outbox.Outb
 	return _c
 }
 
+// RelationHistoryRepository provides a mock function with no fields
+func (_m *Transaction) RelationHistoryRepository() repository.RelationHistoryRepository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RelationHistoryRepository")
+	}
+
+	var r0 repository.RelationHistoryRepository
+	if rf, ok := ret.Get(0).(func() repository.RelationHistoryRepository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.RelationHistoryRepository)
+		}
+	}
+
+	return r0
+}
+
+// Transaction_RelationHistoryRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RelationHistoryRepository'
+type Transaction_RelationHistoryRepository_Call struct {
+	*mock.Call
+}
+
+// RelationHistoryRepository is a helper method to define mock.On call
+func (_e *Transaction_Expecter) RelationHistoryRepository() *Transaction_RelationHistoryRepository_Call {
+	return &Transaction_RelationHistoryRepository_Call{Call: _e.mock.On("RelationHistoryRepository")}
+}
+
+func (_c *Transaction_RelationHistoryRepository_Call) Run(run func()) *Transaction_RelationHistoryRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Transaction_RelationHistoryRepository_Call) Return(_a0 repository.RelationHistoryRepository) *Transaction_RelationHistoryRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Transaction_RelationHistoryRepository_Call) RunAndReturn(run func() repository.RelationHistoryRepository) *Transaction_RelationHistoryRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Rollback provides a mock function with given fields: ctx
 func (_m *Transaction) Rollback(ctx context.Context) error {
 	ret := _m.Called(ctx)